@@ -19,6 +19,12 @@ import (
 
 	_ "github.com/openshift/origin/test/extended"
 	_ "github.com/openshift/origin/test/extended/util/annotate/generated"
+
+	// monitor tests register themselves with the monitortestframework
+	_ "github.com/openshift/origin/pkg/monitortests/clusterversionoperator"
+	_ "github.com/openshift/origin/pkg/monitortests/containerrestarts"
+	_ "github.com/openshift/origin/pkg/monitortests/nodejournal"
+	_ "github.com/openshift/origin/pkg/monitortests/podsecurity"
 )
 
 func isDisabled(name string) bool {
@@ -80,6 +86,15 @@ func (s testSuites) TestSuites() []*ginkgo.TestSuite {
 	return copied
 }
 
+// storageTimeoutOverrides give storage tests, which provision, attach, and resize volumes, more time
+// than the default timeout of the suites they run in.
+var storageTimeoutOverrides = []ginkgo.TestTimeoutOverride{
+	{
+		Matches: func(name string) bool { return strings.Contains(name, "[sig-storage]") },
+		Timeout: 30 * time.Minute,
+	},
+}
+
 // staticSuites are all known test suites this binary should run
 var staticSuites = testSuites{
 	{
@@ -95,6 +110,7 @@ var staticSuites = testSuites{
 				return strings.Contains(name, "[Suite:openshift/conformance/")
 			},
 			Parallelism:         30,
+			TimeoutOverrides:    storageTimeoutOverrides,
 			SyntheticEventTests: ginkgo.JUnitForEventsFunc(synthetictests.StableSystemEventInvariants),
 		},
 		PreSuite: suiteWithProviderPreSuite,
@@ -113,6 +129,7 @@ var staticSuites = testSuites{
 			},
 			Parallelism:          30,
 			MaximumAllowedFlakes: 15,
+			TimeoutOverrides:     storageTimeoutOverrides,
 			SyntheticEventTests:  ginkgo.JUnitForEventsFunc(synthetictests.StableSystemEventInvariants),
 		},
 		PreSuite: suiteWithProviderPreSuite,
@@ -353,6 +370,7 @@ var staticSuites = testSuites{
 
 				return strings.Contains(name, "External Storage [Driver:") && !strings.Contains(name, "[Disruptive]")
 			},
+			TimeoutOverrides:    storageTimeoutOverrides,
 			SyntheticEventTests: ginkgo.JUnitForEventsFunc(synthetictests.StableSystemEventInvariants),
 		},
 		PreSuite: suiteWithKubeTestInitializationPreSuite,
@@ -513,6 +531,9 @@ func suiteWithInitializedProviderPreSuite(opt *runOptions) error {
 	if err != nil {
 		return err
 	}
+	if err := applyClusterProfile(config.Profile); err != nil {
+		return err
+	}
 	opt.config = config
 
 	opt.Provider = config.ToJSONString()
@@ -526,7 +547,19 @@ func suiteWithProviderPreSuite(opt *runOptions) error {
 	if err := suiteWithInitializedProviderPreSuite(opt); err != nil {
 		return err
 	}
-	opt.MatchFn = opt.config.MatchFn()
+	rules, err := loadSkipRules(opt)
+	if err != nil {
+		return err
+	}
+	// the skip labels are in the names of the tests already, unless the rules were overridden
+	configSkipReason := opt.config.SkipReasonFn()
+	skipReason := func(name string) string {
+		return configSkipReason(rules.Annotate(name))
+	}
+	opt.SkipReasonFn = skipReason
+	opt.MatchFn = func(name string) bool {
+		return len(skipReason(name)) == 0
+	}
 	return nil
 }
 
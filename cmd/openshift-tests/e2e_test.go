@@ -74,3 +74,26 @@ func TestSkippedUntil(t *testing.T) {
 		})
 	}
 }
+
+func TestStorageTimeoutOverrides(t *testing.T) {
+	for _, name := range []string{"openshift/conformance", "openshift/conformance/parallel", "openshift/csi"} {
+		var overrides int
+		for _, suite := range staticSuites {
+			if suite.Name != name {
+				continue
+			}
+			for _, override := range suite.TimeoutOverrides {
+				overrides++
+				if !override.Matches("[sig-storage] CSI Volumes should resize [Suite:openshift/conformance/parallel]") || override.Timeout != 30*time.Minute {
+					t.Errorf("%s: expected storage tests to get 30m, got %s", name, override.Timeout)
+				}
+				if override.Matches("[sig-cli] oc works [Suite:openshift/conformance/parallel]") {
+					t.Errorf("%s: expected other tests to keep the suite timeout", name)
+				}
+			}
+		}
+		if overrides != 1 {
+			t.Errorf("%s: expected one timeout override, got %d", name, overrides)
+		}
+	}
+}
@@ -0,0 +1,97 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"syscall"
+
+	"github.com/spf13/pflag"
+
+	testginkgo "github.com/openshift/origin/pkg/test/ginkgo"
+)
+
+// fleetLocalFlags are set by the fleet run for each cluster instead of being passed on from the command line.
+var fleetLocalFlags = map[string]bool{
+	"kubeconfig-dir": true,
+	"junit-dir":      true,
+}
+
+// fleetFileFlags name a file every cluster writes. Each cluster writes to the file with its name added
+// before the extension.
+var fleetFileFlags = map[string]bool{
+	"output-file":    true,
+	"test2json-file": true,
+}
+
+// fleetDirFlags name a directory every cluster reads or writes. Each cluster uses the directory named
+// after it inside it, the layout of the --junit-dir of a fleet run.
+var fleetDirFlags = map[string]bool{
+	"copy-results-to": true,
+	"resume-from":     true,
+}
+
+// fleetURLFlags name a destination every cluster uploads to. Each cluster writes under a prefix named
+// after it in an s3 destination, and posts to other destinations unchanged.
+var fleetURLFlags = map[string]bool{
+	"upload-results-to": true,
+}
+
+// fleetInteractiveFlags need the terminal or standard input, which the clusters cannot share.
+var fleetInteractiveFlags = []string{"live-status", "pause-on-failure", "step-through"}
+
+// runFleet runs suite against every cluster of --kubeconfig-dir with the other flags that were set.
+func runFleet(opt *runOptions, flags *pflag.FlagSet, suite string) error {
+	if opt.TestFile == "-" {
+		return fmt.Errorf("--kubeconfig-dir cannot read the tests to run from standard input, write them to a file")
+	}
+	for _, name := range fleetInteractiveFlags {
+		if flags.Changed(name) {
+			return fmt.Errorf("--%s cannot be used with --kubeconfig-dir", name)
+		}
+	}
+	fleet := &testginkgo.FleetOptions{
+		ClusterArgs:   func(cluster string) []string { return fleetRunArgs(flags, cluster) },
+		KubeconfigDir: opt.KubeconfigDir,
+		JUnitDir:      opt.JUnitDir,
+		ArtifactDir:   os.Getenv("ARTIFACT_DIR"),
+		Out:           opt.Out,
+		ErrOut:        opt.ErrOut,
+	}
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+	return fleet.Run(ctx, suite)
+}
+
+// fleetRunArgs returns the flags that were set on the command line, except those the fleet run sets for
+// each cluster, as arguments for the run command of cluster. Files and directories written by the run are
+// renamed for the cluster.
+func fleetRunArgs(flags *pflag.FlagSet, cluster string) []string {
+	var args []string
+	flags.Visit(func(flag *pflag.Flag) {
+		if fleetLocalFlags[flag.Name] {
+			return
+		}
+		if values, ok := flag.Value.(pflag.SliceValue); ok {
+			for _, value := range values.GetSlice() {
+				args = append(args, fmt.Sprintf("--%s=%s", flag.Name, value))
+			}
+			return
+		}
+		value := flag.Value.String()
+		switch {
+		case fleetFileFlags[flag.Name]:
+			ext := filepath.Ext(value)
+			value = fmt.Sprintf("%s-%s%s", strings.TrimSuffix(value, ext), cluster, ext)
+		case fleetDirFlags[flag.Name]:
+			value = filepath.Join(value, cluster)
+		case fleetURLFlags[flag.Name] && strings.HasPrefix(value, "s3://"):
+			value = strings.TrimSuffix(value, "/") + "/" + cluster
+		}
+		args = append(args, fmt.Sprintf("--%s=%s", flag.Name, value))
+	})
+	return args
+}
@@ -0,0 +1,71 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/spf13/pflag"
+)
+
+func Test_fleetRunArgs(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     []string
+		expected []string
+	}{
+		{
+			name:     "flags are passed on",
+			args:     []string{"--kubeconfig-dir=/clusters", "--junit-dir=/junit", "--max-parallel-tests=5", "--include-tier=blocking,informing", "--run", "sig-network", "-f", "/tests.txt"},
+			expected: []string{"--file=/tests.txt", "--include-tier=blocking", "--include-tier=informing", "--max-parallel-tests=5", "--run=sig-network"},
+		},
+		{
+			name:     "written files are named after the cluster",
+			args:     []string{"-o", "/out/run.log", "--test2json-file=/out/events"},
+			expected: []string{"--output-file=/out/run-cluster-a.log", "--test2json-file=/out/events-cluster-a"},
+		},
+		{
+			name:     "directories have a directory for each cluster",
+			args:     []string{"--copy-results-to=/results", "--resume-from=/previous"},
+			expected: []string{"--copy-results-to=/results/cluster-a", "--resume-from=/previous/cluster-a"},
+		},
+		{
+			name:     "s3 uploads have a prefix for each cluster",
+			args:     []string{"--upload-results-to=s3://bucket/runs/"},
+			expected: []string{"--upload-results-to=s3://bucket/runs/cluster-a"},
+		},
+		{
+			name:     "http uploads are passed on",
+			args:     []string{"--upload-results-to=https://results.example.com/upload"},
+			expected: []string{"--upload-results-to=https://results.example.com/upload"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opt := NewRunOptions(defaultTestImageMirrorLocation)
+			flags := pflag.NewFlagSet("run", pflag.ContinueOnError)
+			bindOptions(opt, flags)
+			flags.StringVar(&opt.KubeconfigDir, "kubeconfig-dir", opt.KubeconfigDir, "")
+			if err := flags.Parse(tt.args); err != nil {
+				t.Fatal(err)
+			}
+			if got := fleetRunArgs(flags, "cluster-a"); !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func Test_runFleetRejectsInteractiveFlags(t *testing.T) {
+	for _, name := range fleetInteractiveFlags {
+		opt := NewRunOptions(defaultTestImageMirrorLocation)
+		flags := pflag.NewFlagSet("run", pflag.ContinueOnError)
+		bindOptions(opt, flags)
+		flags.StringVar(&opt.KubeconfigDir, "kubeconfig-dir", opt.KubeconfigDir, "")
+		if err := flags.Parse([]string{"--kubeconfig-dir=/clusters", "--" + name}); err != nil {
+			t.Fatal(err)
+		}
+		if err := runFleet(opt, flags, "openshift/conformance"); err == nil || err.Error() != "--"+name+" cannot be used with --kubeconfig-dir" {
+			t.Errorf("expected --%s to be rejected, got %v", name, err)
+		}
+	}
+}
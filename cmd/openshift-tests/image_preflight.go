@@ -0,0 +1,232 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	kapierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/kubernetes/test/e2e/framework"
+	"k8s.io/utils/pointer"
+
+	"github.com/openshift/origin/pkg/test/ginkgo/junitapi"
+	exutil "github.com/openshift/origin/test/extended/util"
+	"github.com/openshift/origin/test/extended/util/image"
+)
+
+const (
+	// imagePreflightTestName reports whether the cluster could pull test images before the suite ran.
+	imagePreflightTestName = "[sig-arch] the cluster can pull test images from the test image repository"
+
+	// imagePreflightImage is pulled by the canary pod. Every test image repository must contain it.
+	imagePreflightImage = "registry.k8s.io/e2e-test-images/agnhost:2.43"
+
+	imagePreflightTimeout = 5 * time.Minute
+)
+
+// checkTestImageRepository launches a canary pod that pulls a test image from --from-repository
+// so that a mirror the cluster cannot reach fails the run before any test starts, instead of
+// failing every test that uses an image. If the pull fails and the default mirror can be pulled
+// from, the run falls back to the default mirror and the preflight test is reported as a flake.
+// A canary pod that cannot be created or scheduled says nothing about the mirror, so the run fails
+// without trying the default mirror. The result is written to --junit-dir as its own report.
+func checkTestImageRepository(opt *runOptions) error {
+	if opt.SkipImagePreflight || opt.DryRun {
+		return nil
+	}
+	config, err := framework.LoadConfig(true)
+	if err != nil {
+		return err
+	}
+	client, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+
+	repositories := []string{opt.FromRepository}
+	if opt.FromRepository != defaultTestImageMirrorLocation {
+		repositories = append(repositories, defaultTestImageMirrorLocation)
+	}
+	var testCases []*junitapi.JUnitTestCase
+	var pullErr error
+	for i, repository := range repositories {
+		pullSpec := testImagePullSpec(repository)
+		fmt.Fprintf(opt.Out, "Verifying that the cluster can pull test image %s\n", pullSpec)
+		start := time.Now()
+		pullErr = pullCanaryImage(ctx, client, pullSpec)
+		testCase := &junitapi.JUnitTestCase{
+			Name:     imagePreflightTestName,
+			Duration: time.Since(start).Seconds(),
+		}
+		testCases = append(testCases, testCase)
+		if pullErr == nil {
+			if i > 0 {
+				fmt.Fprintf(opt.ErrOut, "warning: Test images will be pulled from %s instead of %s\n", repository, opt.FromRepository)
+				opt.FromRepository = repository
+			}
+			break
+		}
+		if errors.As(pullErr, &imagePreflightError{}) {
+			testCase.FailureOutput = &junitapi.FailureOutput{
+				Output: fmt.Sprintf("unable to run a pod that pulls %s: %v", pullSpec, pullErr),
+			}
+			fmt.Fprintf(opt.ErrOut, "error: Unable to run a pod that pulls test image %s: %v\n", pullSpec, pullErr)
+			break
+		}
+		testCase.FailureOutput = &junitapi.FailureOutput{
+			Output: fmt.Sprintf("unable to pull %s: %v", pullSpec, pullErr),
+		}
+		fmt.Fprintf(opt.ErrOut, "error: Unable to pull test image %s: %v\n", pullSpec, pullErr)
+	}
+	if len(opt.JUnitDir) > 0 {
+		if err := writeJUnitTestCases(opt.JUnitDir, "openshift-tests-image-preflight", "junit_image_preflight", testCases...); err != nil {
+			fmt.Fprintf(opt.ErrOut, "error: Unable to write the image preflight report: %v\n", err)
+		}
+	}
+	if errors.As(pullErr, &imagePreflightError{}) {
+		return fmt.Errorf("the cluster cannot run the image preflight pod, check that workloads can be created and scheduled or pass --skip-image-preflight: %v", pullErr)
+	}
+	if pullErr != nil {
+		return fmt.Errorf("the cluster cannot pull test images, check --from-repository and the mirror configuration of the cluster: %v", pullErr)
+	}
+	return nil
+}
+
+// testImagePullSpec returns the pull spec of the canary image in the given test image repository.
+func testImagePullSpec(repository string) string {
+	return image.GetMappedImages(image.OriginalImages(), repository)[imagePreflightImage]
+}
+
+// canaryCreateBackoff spaces the attempts to create the canary pod while it is forbidden, as the
+// admission of a new namespace may not accept pods yet.
+var canaryCreateBackoff = wait.Backoff{Steps: 5, Duration: time.Second, Factor: 2}
+
+// pullCanaryImage runs a pod with the given image in a new namespace until the image is pulled,
+// and deletes the namespace. Failures to create or schedule the pod are returned as an
+// imagePreflightError, since they do not tell whether the image can be pulled.
+func pullCanaryImage(ctx context.Context, client kubernetes.Interface, pullSpec string) error {
+	ns, err := client.CoreV1().Namespaces().Create(ctx, &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{GenerateName: "e2e-image-preflight-"},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return imagePreflightError{fmt.Errorf("unable to create the namespace of the pod: %v", err)}
+	}
+	defer func() {
+		if err := client.CoreV1().Namespaces().Delete(context.Background(), ns.Name, metav1.DeleteOptions{}); err != nil {
+			framework.Logf("Unable to delete namespace %s: %v", ns.Name, err)
+		}
+	}()
+	// pods are rejected until the namespace is given its SCC range and default service account
+	if err := exutil.WaitForNamespaceSCCAnnotations(client.CoreV1(), ns.Name); err != nil {
+		return imagePreflightError{fmt.Errorf("namespace %s was not given its SCC annotations: %v", ns.Name, err)}
+	}
+	if err := exutil.WaitForServiceAccount(client.CoreV1().ServiceAccounts(ns.Name), "default"); err != nil {
+		return imagePreflightError{fmt.Errorf("namespace %s was not given its default service account: %v", ns.Name, err)}
+	}
+
+	var pod *corev1.Pod
+	err = retry.OnError(canaryCreateBackoff, kapierrs.IsForbidden, func() error {
+		pod, err = client.CoreV1().Pods(ns.Name).Create(ctx, newCanaryPod(pullSpec), metav1.CreateOptions{})
+		return err
+	})
+	if err != nil {
+		return imagePreflightError{fmt.Errorf("unable to create the pod: %v", err)}
+	}
+	var lastErr error
+	err = wait.PollImmediateWithContext(ctx, 2*time.Second, imagePreflightTimeout, func(ctx context.Context) (bool, error) {
+		pod, err = client.CoreV1().Pods(ns.Name).Get(ctx, pod.Name, metav1.GetOptions{})
+		if err != nil {
+			lastErr = err
+			return false, nil
+		}
+		return canaryImagePulled(pod)
+	})
+	if err == wait.ErrWaitTimeout {
+		if message, ok := canaryUnschedulable(pod); ok {
+			return imagePreflightError{fmt.Errorf("timed out waiting for the pod to be scheduled: %s", message)}
+		}
+		if lastErr != nil {
+			return fmt.Errorf("timed out waiting for the image to be pulled: %v", lastErr)
+		}
+		return fmt.Errorf("timed out waiting for the image to be pulled, pod is %s", pod.Status.Phase)
+	}
+	return err
+}
+
+// imagePreflightError is returned when the canary pod could not be created or scheduled, so the
+// image was not pulled at all.
+type imagePreflightError struct {
+	err error
+}
+
+func (e imagePreflightError) Error() string {
+	return e.err.Error()
+}
+
+func (e imagePreflightError) Unwrap() error {
+	return e.err
+}
+
+func newCanaryPod(pullSpec string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "image-preflight"},
+		Spec: corev1.PodSpec{
+			RestartPolicy:                 corev1.RestartPolicyNever,
+			TerminationGracePeriodSeconds: pointer.Int64(0),
+			SecurityContext: &corev1.PodSecurityContext{
+				RunAsNonRoot:   pointer.Bool(true),
+				SeccompProfile: &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeRuntimeDefault},
+			},
+			Containers: []corev1.Container{{
+				Name:            "canary",
+				Image:           pullSpec,
+				ImagePullPolicy: corev1.PullAlways,
+				Args:            []string{"pause"},
+				SecurityContext: &corev1.SecurityContext{
+					AllowPrivilegeEscalation: pointer.Bool(false),
+					Capabilities:             &corev1.Capabilities{Drop: []corev1.Capability{"ALL"}},
+				},
+			}},
+		},
+	}
+}
+
+// canaryImagePulled returns true once the container of the canary pod has started, or is waiting
+// on something other than its image, and an error if the image cannot be pulled.
+func canaryImagePulled(pod *corev1.Pod) (bool, error) {
+	for _, status := range pod.Status.ContainerStatuses {
+		if status.State.Running != nil || status.State.Terminated != nil {
+			return true, nil
+		}
+		waiting := status.State.Waiting
+		if waiting == nil {
+			continue
+		}
+		switch waiting.Reason {
+		case "ErrImagePull", "ImagePullBackOff", "InvalidImageName", "ErrImageNeverPull":
+			return false, fmt.Errorf("%s: %s", waiting.Reason, waiting.Message)
+		case "", "ContainerCreating", "PodInitializing":
+		default:
+			// the image was pulled, but the container could not start for another reason
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// canaryUnschedulable returns the message of the scheduler if the canary pod could not be scheduled.
+func canaryUnschedulable(pod *corev1.Pod) (string, bool) {
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == corev1.PodScheduled && condition.Status == corev1.ConditionFalse && condition.Reason == corev1.PodReasonUnschedulable {
+			return condition.Message, true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,180 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	securityv1 "github.com/openshift/api/security/v1"
+	corev1 "k8s.io/api/core/v1"
+	kapierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes/fake"
+	clienttesting "k8s.io/client-go/testing"
+)
+
+func TestCanaryImagePulled(t *testing.T) {
+	tests := []struct {
+		name       string
+		state      *corev1.ContainerState
+		wantPulled bool
+		wantErr    string
+	}{
+		{name: "no status yet"},
+		{name: "creating", state: &corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "ContainerCreating"}}},
+		{name: "running", state: &corev1.ContainerState{Running: &corev1.ContainerStateRunning{}}, wantPulled: true},
+		{name: "terminated", state: &corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{ExitCode: 1}}, wantPulled: true},
+		{
+			name:       "pulled but not started",
+			state:      &corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "CreateContainerConfigError"}},
+			wantPulled: true,
+		},
+		{
+			name:    "pull failed",
+			state:   &corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "ErrImagePull", Message: "manifest unknown"}},
+			wantErr: "ErrImagePull: manifest unknown",
+		},
+		{
+			name:    "pull backing off",
+			state:   &corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "ImagePullBackOff"}},
+			wantErr: "ImagePullBackOff",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			pod := &corev1.Pod{}
+			if test.state != nil {
+				pod.Status.ContainerStatuses = []corev1.ContainerStatus{{Name: "canary", State: *test.state}}
+			}
+			pulled, err := canaryImagePulled(pod)
+			if pulled != test.wantPulled {
+				t.Errorf("expected pulled %t, got %t", test.wantPulled, pulled)
+			}
+			if len(test.wantErr) == 0 && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if len(test.wantErr) > 0 && (err == nil || !strings.Contains(err.Error(), test.wantErr)) {
+				t.Errorf("expected error containing %q, got %v", test.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestCanaryUnschedulable(t *testing.T) {
+	pod := &corev1.Pod{}
+	if _, ok := canaryUnschedulable(pod); ok {
+		t.Errorf("expected a pod without conditions not to be unschedulable")
+	}
+	pod.Status.Conditions = []corev1.PodCondition{{
+		Type:    corev1.PodScheduled,
+		Status:  corev1.ConditionFalse,
+		Reason:  corev1.PodReasonUnschedulable,
+		Message: "0/3 nodes are available: 3 node(s) had untolerated taint",
+	}}
+	message, ok := canaryUnschedulable(pod)
+	if !ok || message != "0/3 nodes are available: 3 node(s) had untolerated taint" {
+		t.Errorf("expected the pod to be unschedulable, got %t %q", ok, message)
+	}
+	if pulled, err := canaryImagePulled(pod); pulled || err != nil {
+		t.Errorf("expected an unschedulable pod not to be reported as a pull failure, got %t %v", pulled, err)
+	}
+
+	pod.Status.Conditions[0].Status = corev1.ConditionTrue
+	if _, ok := canaryUnschedulable(pod); ok {
+		t.Errorf("expected a scheduled pod not to be unschedulable")
+	}
+}
+
+func TestTestImagePullSpec(t *testing.T) {
+	if pullSpec := testImagePullSpec(""); pullSpec != imagePreflightImage {
+		t.Errorf("expected the original image without a repository, got %s", pullSpec)
+	}
+	pullSpec := testImagePullSpec("private.com/test/repository")
+	if !strings.HasPrefix(pullSpec, "private.com/test/repository:e2e-1-") {
+		t.Errorf("expected the image in the repository, got %s", pullSpec)
+	}
+}
+
+func TestPullCanaryImage(t *testing.T) {
+	canaryCreateBackoff = wait.Backoff{Steps: 5, Duration: time.Millisecond}
+	defer func() { canaryCreateBackoff = wait.Backoff{Steps: 5, Duration: time.Second, Factor: 2} }()
+
+	running := corev1.ContainerStatus{Name: "canary", State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}}}
+	pullFailed := corev1.ContainerStatus{Name: "canary", State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "ErrImagePull", Message: "manifest unknown"}}}
+	tests := []struct {
+		name            string
+		forbiddenPods   int
+		createErr       error
+		status          corev1.ContainerStatus
+		wantErr         string
+		wantPreflight   bool
+		wantPodsCreated int
+	}{
+		{name: "pulled", status: running, wantPodsCreated: 1},
+		{name: "pulled once admission allows the pod", forbiddenPods: 2, status: running, wantPodsCreated: 1},
+		{name: "pull failed", status: pullFailed, wantErr: "ErrImagePull: manifest unknown", wantPodsCreated: 1},
+		{
+			name:          "pod rejected",
+			createErr:     kapierrs.NewBadRequest("pod is invalid"),
+			wantErr:       "unable to create the pod: pod is invalid",
+			wantPreflight: true,
+		},
+		{
+			name:          "pod forbidden",
+			forbiddenPods: 10,
+			wantErr:       "unable to create the pod",
+			wantPreflight: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			const namespace = "e2e-image-preflight-abcde"
+			client := fake.NewSimpleClientset(&corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "default"}})
+			client.PrependReactor("create", "namespaces", func(action clienttesting.Action) (bool, runtime.Object, error) {
+				// the fake client neither generates names nor runs the SCC allocation controller
+				ns := action.(clienttesting.CreateAction).GetObject().(*corev1.Namespace)
+				ns.Name = namespace
+				ns.Annotations = map[string]string{securityv1.UIDRangeAnnotation: "1000/10000"}
+				return false, nil, nil
+			})
+			forbidden, created := 0, 0
+			client.PrependReactor("create", "pods", func(action clienttesting.Action) (bool, runtime.Object, error) {
+				if forbidden < test.forbiddenPods {
+					forbidden++
+					return true, nil, kapierrs.NewForbidden(corev1.Resource("pods"), "image-preflight", errors.New("unable to validate against any security context constraint"))
+				}
+				if test.createErr != nil {
+					return true, nil, test.createErr
+				}
+				created++
+				return false, nil, nil
+			})
+			client.PrependReactor("get", "pods", func(action clienttesting.Action) (bool, runtime.Object, error) {
+				pod := newCanaryPod("image")
+				pod.Status.ContainerStatuses = []corev1.ContainerStatus{test.status}
+				return true, pod, nil
+			})
+
+			err := pullCanaryImage(context.Background(), client, "private.com/test/repository:e2e-1")
+			if len(test.wantErr) == 0 && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(test.wantErr) > 0 && (err == nil || !strings.Contains(err.Error(), test.wantErr)) {
+				t.Fatalf("expected error containing %q, got %v", test.wantErr, err)
+			}
+			if preflight := errors.As(err, &imagePreflightError{}); preflight != test.wantPreflight {
+				t.Errorf("expected a preflight error %t, got %v", test.wantPreflight, err)
+			}
+			if created != test.wantPodsCreated {
+				t.Errorf("expected %d pods to be created, got %d", test.wantPodsCreated, created)
+			}
+			if _, err := client.CoreV1().Namespaces().Get(context.Background(), namespace, metav1.GetOptions{}); !kapierrs.IsNotFound(err) {
+				t.Errorf("expected the namespace to be deleted, got %v", err)
+			}
+		})
+	}
+}
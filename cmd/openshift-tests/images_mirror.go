@@ -0,0 +1,206 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	configv1 "github.com/openshift/api/config/v1"
+	operatorv1alpha1 "github.com/openshift/api/operator/v1alpha1"
+	"github.com/openshift/library-go/pkg/image/reference"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// imageMirrorPolicyName is the name given to the generated mirror policies.
+	imageMirrorPolicyName = "openshift-tests-images"
+
+	imageContentSourcePolicyFile = "image-content-source-policy.yaml"
+	imageDigestMirrorSetFile     = "image-digest-mirror-set.yaml"
+	imageTagMirrorSetFile        = "image-tag-mirror-set.yaml"
+	imageSetConfigurationFile    = "imageset-configuration.yaml"
+)
+
+// imageMirrorMapping is a line of an 'oc image mirror' mapping.
+type imageMirrorMapping struct {
+	source      reference.DockerImageReference
+	destination reference.DockerImageReference
+}
+
+// parseImageMirrorMappings parses 'oc image mirror' mapping lines, sorted by source image.
+func parseImageMirrorMappings(lines []string) ([]imageMirrorMapping, error) {
+	var mappings []imageMirrorMapping
+	for _, line := range lines {
+		parts := strings.Fields(line)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid mirror mapping %q: expected SOURCE DESTINATION", line)
+		}
+		from, err := reference.Parse(parts[0])
+		if err != nil {
+			return nil, fmt.Errorf("invalid mirror source %q: %v", parts[0], err)
+		}
+		to, err := reference.Parse(parts[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid mirror destination %q: %v", parts[1], err)
+		}
+		mappings = append(mappings, imageMirrorMapping{source: from, destination: to})
+	}
+	sort.SliceStable(mappings, func(i, j int) bool { return mappings[i].source.Exact() < mappings[j].source.Exact() })
+	return mappings, nil
+}
+
+// imageMirrorRepositories returns, for every source repository, the sorted list of repositories it is
+// mirrored to. A mirror set only redirects a pull by tag to the same tag in the mirror, so when
+// sameTag is set only the mappings that keep the tag of the source image are included.
+func imageMirrorRepositories(mappings []imageMirrorMapping, sameTag bool) map[string][]string {
+	mirrors := make(map[string]sets.String)
+	for _, mapping := range mappings {
+		if sameTag && (len(mapping.source.Tag) == 0 || mapping.source.Tag != mapping.destination.Tag) {
+			continue
+		}
+		source := mapping.source.AsRepository().Exact()
+		if _, ok := mirrors[source]; !ok {
+			mirrors[source] = sets.NewString()
+		}
+		mirrors[source].Insert(mapping.destination.AsRepository().Exact())
+	}
+	repositories := make(map[string][]string, len(mirrors))
+	for source, targets := range mirrors {
+		repositories[source] = targets.List()
+	}
+	return repositories
+}
+
+// newImageContentSourcePolicy redirects digest pulls of each source repository to its mirrors, for
+// clusters that predate ImageDigestMirrorSet.
+func newImageContentSourcePolicy(repositories map[string][]string) *operatorv1alpha1.ImageContentSourcePolicy {
+	policy := &operatorv1alpha1.ImageContentSourcePolicy{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "operator.openshift.io/v1alpha1", Kind: "ImageContentSourcePolicy"},
+		ObjectMeta: metav1.ObjectMeta{Name: imageMirrorPolicyName},
+	}
+	for _, source := range sortedKeys(repositories) {
+		policy.Spec.RepositoryDigestMirrors = append(policy.Spec.RepositoryDigestMirrors, operatorv1alpha1.RepositoryDigestMirrors{
+			Source:  source,
+			Mirrors: repositories[source],
+		})
+	}
+	return policy
+}
+
+// newImageDigestMirrorSet redirects digest pulls of each source repository to its mirrors.
+func newImageDigestMirrorSet(repositories map[string][]string) *configv1.ImageDigestMirrorSet {
+	set := &configv1.ImageDigestMirrorSet{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "config.openshift.io/v1", Kind: "ImageDigestMirrorSet"},
+		ObjectMeta: metav1.ObjectMeta{Name: imageMirrorPolicyName},
+	}
+	for _, source := range sortedKeys(repositories) {
+		var mirrors []configv1.ImageMirror
+		for _, mirror := range repositories[source] {
+			mirrors = append(mirrors, configv1.ImageMirror(mirror))
+		}
+		set.Spec.ImageDigestMirrors = append(set.Spec.ImageDigestMirrors, configv1.ImageDigestMirrors{
+			Source:  source,
+			Mirrors: mirrors,
+		})
+	}
+	return set
+}
+
+// newImageTagMirrorSet redirects tag pulls of each source repository to its mirrors, which must hold
+// the same tags.
+func newImageTagMirrorSet(repositories map[string][]string) *configv1.ImageTagMirrorSet {
+	set := &configv1.ImageTagMirrorSet{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "config.openshift.io/v1", Kind: "ImageTagMirrorSet"},
+		ObjectMeta: metav1.ObjectMeta{Name: imageMirrorPolicyName},
+	}
+	for _, source := range sortedKeys(repositories) {
+		var mirrors []configv1.ImageMirror
+		for _, mirror := range repositories[source] {
+			mirrors = append(mirrors, configv1.ImageMirror(mirror))
+		}
+		set.Spec.ImageTagMirrors = append(set.Spec.ImageTagMirrors, configv1.ImageTagMirrors{
+			Source:  source,
+			Mirrors: mirrors,
+		})
+	}
+	return set
+}
+
+// newImageSetConfiguration returns an oc-mirror ImageSetConfiguration that copies every source image
+// to the repository and tag it is mapped to, which is where --from-repository expects to find it. The
+// repository is relative to the registry passed to oc-mirror.
+func newImageSetConfiguration(mappings []imageMirrorMapping) map[string]interface{} {
+	var additionalImages []interface{}
+	for _, mapping := range mappings {
+		image := map[string]interface{}{
+			"name":       mapping.source.Exact(),
+			"targetRepo": mapping.destination.RepositoryName(),
+		}
+		if len(mapping.destination.Tag) > 0 {
+			image["targetTag"] = mapping.destination.Tag
+		}
+		additionalImages = append(additionalImages, image)
+	}
+	return map[string]interface{}{
+		"apiVersion": "mirror.openshift.io/v2alpha1",
+		"kind":       "ImageSetConfiguration",
+		"mirror": map[string]interface{}{
+			"additionalImages": additionalImages,
+		},
+	}
+}
+
+// writeImageMirrorFiles writes the mirror mapping to path and, in the same directory, the
+// ImageContentSourcePolicy, ImageDigestMirrorSet, ImageTagMirrorSet and oc-mirror
+// ImageSetConfiguration describing the same mirror. The ImageTagMirrorSet is omitted when no image
+// keeps its tag in the mirror. It returns the paths of the files written.
+func writeImageMirrorFiles(path string, lines []string) ([]string, error) {
+	mappings, err := parseImageMirrorMappings(lines)
+	if err != nil {
+		return nil, err
+	}
+	repositories := imageMirrorRepositories(mappings, false)
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	if err := os.WriteFile(path, []byte(strings.Join(lines, "\n")+"\n"), 0644); err != nil {
+		return nil, err
+	}
+	manifests := map[string]interface{}{
+		imageContentSourcePolicyFile: newImageContentSourcePolicy(repositories),
+		imageDigestMirrorSetFile:     newImageDigestMirrorSet(repositories),
+		imageSetConfigurationFile:    newImageSetConfiguration(mappings),
+	}
+	// images mirrored from upstream get new tags, so there may be nothing to redirect by tag
+	if tagRepositories := imageMirrorRepositories(mappings, true); len(tagRepositories) > 0 {
+		manifests[imageTagMirrorSetFile] = newImageTagMirrorSet(tagRepositories)
+	}
+	written := []string{path}
+	for name, obj := range manifests {
+		data, err := yaml.Marshal(obj)
+		if err != nil {
+			return nil, fmt.Errorf("unable to serialize %s: %v", name, err)
+		}
+		manifestPath := filepath.Join(dir, name)
+		if err := os.WriteFile(manifestPath, data, 0644); err != nil {
+			return nil, err
+		}
+		written = append(written, manifestPath)
+	}
+	sort.Strings(written[1:])
+	return written, nil
+}
+
+func sortedKeys(m map[string][]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,136 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestImageMirrorRepositories(t *testing.T) {
+	tests := []struct {
+		name       string
+		lines      []string
+		wantImages []string
+		wantDigest map[string][]string
+		wantTag    map[string][]string
+		wantErr    bool
+	}{
+		{
+			name: "mirrored images share a source repository",
+			lines: []string{
+				"quay.io/openshift/community-e2e-images:e2e-2-b private.com/test/repository:e2e-2-b",
+				"quay.io/openshift/community-e2e-images:e2e-1-a private.com/test/repository:e2e-1-a",
+			},
+			wantImages: []string{
+				"quay.io/openshift/community-e2e-images:e2e-1-a",
+				"quay.io/openshift/community-e2e-images:e2e-2-b",
+			},
+			wantDigest: map[string][]string{
+				"quay.io/openshift/community-e2e-images": {"private.com/test/repository"},
+			},
+			wantTag: map[string][]string{
+				"quay.io/openshift/community-e2e-images": {"private.com/test/repository"},
+			},
+		},
+		{
+			name: "upstream images are only mirrored by digest",
+			lines: []string{
+				"docker.io/library/nginx:1.15 private.com/test/repository:e2e-3-c",
+				"registry.k8s.io/e2e-test-images/agnhost:2.43 private.com/test/repository:e2e-4-d",
+			},
+			wantImages: []string{
+				"docker.io/library/nginx:1.15",
+				"registry.k8s.io/e2e-test-images/agnhost:2.43",
+			},
+			wantDigest: map[string][]string{
+				"docker.io/library/nginx":                 {"private.com/test/repository"},
+				"registry.k8s.io/e2e-test-images/agnhost": {"private.com/test/repository"},
+			},
+			wantTag: map[string][]string{},
+		},
+		{
+			name:    "malformed line",
+			lines:   []string{"quay.io/openshift/community-e2e-images:e2e-1-a"},
+			wantErr: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mappings, err := parseImageMirrorMappings(test.lines)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if test.wantErr {
+				return
+			}
+			var images []string
+			for _, mapping := range mappings {
+				images = append(images, mapping.source.Exact())
+			}
+			if !reflect.DeepEqual(images, test.wantImages) {
+				t.Errorf("expected images %v, got %v", test.wantImages, images)
+			}
+			if repositories := imageMirrorRepositories(mappings, false); !reflect.DeepEqual(repositories, test.wantDigest) {
+				t.Errorf("expected digest mirrors %v, got %v", test.wantDigest, repositories)
+			}
+			if repositories := imageMirrorRepositories(mappings, true); !reflect.DeepEqual(repositories, test.wantTag) {
+				t.Errorf("expected tag mirrors %v, got %v", test.wantTag, repositories)
+			}
+		})
+	}
+}
+
+func TestWriteImageMirrorFiles(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "mirror", "mapping.txt")
+	lines := []string{
+		"quay.io/openshift/community-e2e-images:e2e-1-a private.com/test/repository:e2e-1-a",
+		"registry.k8s.io/e2e-test-images/agnhost:2.43 private.com/test/repository:e2e-4-d",
+	}
+	files, err := writeImageMirrorFiles(path, lines)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 5 || files[0] != path {
+		t.Fatalf("unexpected files written: %v", files)
+	}
+	for file, want := range map[string]string{
+		path: strings.Join(lines, "\n") + "\n",
+		filepath.Join(dir, "mirror", imageContentSourcePolicyFile): "source: quay.io/openshift/community-e2e-images",
+		filepath.Join(dir, "mirror", imageDigestMirrorSetFile):     "source: registry.k8s.io/e2e-test-images/agnhost",
+		filepath.Join(dir, "mirror", imageTagMirrorSetFile): `  imageTagMirrors:
+  - mirrors:
+    - private.com/test/repository
+    source: quay.io/openshift/community-e2e-images
+`,
+		filepath.Join(dir, "mirror", imageSetConfigurationFile): `  - name: registry.k8s.io/e2e-test-images/agnhost:2.43
+    targetRepo: test/repository
+    targetTag: e2e-4-d
+`,
+	} {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(string(data), want) {
+			t.Errorf("expected %s to contain %q, got:\n%s", file, want, data)
+		}
+	}
+	data, err := os.ReadFile(filepath.Join(dir, "mirror", imageTagMirrorSetFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(data), "agnhost") {
+		t.Errorf("expected images whose tag changes not to be mirrored by tag, got:\n%s", data)
+	}
+
+	files, err = writeImageMirrorFiles(filepath.Join(dir, "upstream", "mapping.txt"), lines[1:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 4 {
+		t.Errorf("expected no ImageTagMirrorSet when no image keeps its tag, got %v", files)
+	}
+}
@@ -0,0 +1,35 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	e2e "k8s.io/kubernetes/test/e2e/framework"
+
+	exutil "github.com/openshift/origin/test/extended/util"
+)
+
+// runWithNamespacePool fills the namespace pool with --namespace-pool-size namespaces, runs
+// the suite, and deletes the pool again. Failing to fill the pool is not fatal because specs
+// create their own namespaces when the pool is empty.
+func runWithNamespacePool(opt *runOptions, run func() error) error {
+	if opt.NamespacePoolSize <= 0 || opt.DryRun {
+		return run()
+	}
+	clientConfig, err := e2e.LoadConfig(true)
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+	fmt.Fprintf(os.Stderr, "Creating %d namespaces for the namespace pool\n", opt.NamespacePoolSize)
+	if err := exutil.FillNamespacePool(ctx, clientConfig, opt.NamespacePoolSize); err != nil {
+		fmt.Fprintf(os.Stderr, "error: Unable to fill the namespace pool: %v\n", err)
+	}
+	defer func() {
+		if err := exutil.DrainNamespacePool(ctx, clientConfig); err != nil {
+			fmt.Fprintf(os.Stderr, "error: Unable to delete the namespace pool: %v\n", err)
+		}
+	}()
+	return run()
+}
@@ -0,0 +1,19 @@
+package main
+
+import (
+	"github.com/openshift/origin/pkg/monitor/backenddisruption"
+	"github.com/openshift/origin/pkg/monitortestframework"
+	exutilcluster "github.com/openshift/origin/test/extended/util/cluster"
+)
+
+// applyClusterProfile disables the monitor tests and disruption backends that do not apply to the
+// topology of the profile. The tests of the profile are skipped by ClusterConfiguration.SkipReasonFn.
+func applyClusterProfile(profile exutilcluster.Profile) error {
+	settings, err := profile.Settings()
+	if err != nil {
+		return err
+	}
+	monitortestframework.Disable(settings.DisabledMonitorTests...)
+	backenddisruption.DisableBackends(settings.DisabledDisruptionBackends...)
+	return nil
+}
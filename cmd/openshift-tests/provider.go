@@ -10,6 +10,7 @@ import (
 
 	e2e "k8s.io/kubernetes/test/e2e/framework"
 
+	testginkgo "github.com/openshift/origin/pkg/test/ginkgo"
 	exutil "github.com/openshift/origin/test/extended/util"
 	exutilcluster "github.com/openshift/origin/test/extended/util/cluster"
 
@@ -62,7 +63,14 @@ func initializeTestFramework(context *e2e.TestContextType, config *exutilcluster
 	if err := exutil.InitTest(dryRun); err != nil {
 		return err
 	}
-	gomega.RegisterFailHandler(ginkgo.Fail)
+	if os.Getenv("TEST_GOROUTINES_ON_FAILURE") == "true" {
+		gomega.RegisterFailHandler(testginkgo.NewGoroutineDumpFailHandler(ginkgo.Fail, ginkgo.GinkgoWriter, []string{
+			"github.com/openshift/origin/test/",
+			"k8s.io/kubernetes/test/",
+		}))
+	} else {
+		gomega.RegisterFailHandler(ginkgo.Fail)
+	}
 
 	e2e.AfterReadingAllFlags(context)
 	context.DumpLogsOnFailure = true
@@ -0,0 +1,164 @@
+package main
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"time"
+
+	configv1 "github.com/openshift/api/config/v1"
+	configv1client "github.com/openshift/client-go/config/clientset/versioned"
+	"github.com/openshift/origin/pkg/test/ginkgo/junitapi"
+	"github.com/openshift/origin/test/e2e/upgrade"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/kubernetes/test/e2e/framework"
+)
+
+// rollbackTestName is the test that reports whether the cluster returned to its original version
+// after a failed upgrade.
+const rollbackTestName = "[sig-cluster-lifecycle] cluster rolls back to the original version after a failed upgrade"
+
+// recordRollbackVersion records the version of the cluster before the upgrade, so a failed upgrade
+// can be rolled back to it.
+func recordRollbackVersion(opt *runOptions) error {
+	config, err := framework.LoadConfig(true)
+	if err != nil {
+		return err
+	}
+	client, err := configv1client.NewForConfig(config)
+	if err != nil {
+		return err
+	}
+	current, err := upgrade.CurrentVersion(client)
+	if err != nil {
+		return fmt.Errorf("unable to record the version to roll back to: %v", err)
+	}
+	opt.rollbackTo = current
+	return nil
+}
+
+// verifyRollback rolls the cluster back to the version recorded before the upgrade and runs the
+// rollback suite against it. The results of the rollback phase are written to their own directory
+// under --junit-dir, so they are reported separately from the upgrade.
+func verifyRollback(ctx context.Context, opt *runOptions) error {
+	if opt.rollbackTo == nil {
+		return fmt.Errorf("the version before the upgrade was not recorded")
+	}
+	var junitDir string
+	if len(opt.JUnitDir) > 0 {
+		junitDir = filepath.Join(opt.JUnitDir, "rollback")
+		if err := os.MkdirAll(junitDir, 0755); err != nil {
+			return err
+		}
+	}
+
+	fmt.Fprintf(opt.Out, "Rolling back to %s to verify the rollback after a failed upgrade\n\n", opt.rollbackTo.Image)
+	start := time.Now()
+	err := rollback(ctx, opt)
+	testCase := &junitapi.JUnitTestCase{
+		Name:     rollbackTestName,
+		Duration: time.Since(start).Seconds(),
+	}
+	if err != nil {
+		testCase.FailureOutput = &junitapi.FailureOutput{Output: err.Error()}
+	}
+	if len(junitDir) > 0 {
+		if err := writeRollbackJUnit(junitDir, testCase); err != nil {
+			fmt.Fprintf(opt.ErrOut, "error: Unable to write the rollback report: %v\n", err)
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	args := []string{"run", opt.RollbackSuite}
+	if len(junitDir) > 0 {
+		args = append(args, "--junit-dir", junitDir)
+	}
+	if len(opt.Provider) > 0 {
+		args = append(args, "--provider", opt.Provider)
+	}
+	if len(opt.FromRepository) > 0 {
+		args = append(args, "--from-repository", opt.FromRepository)
+	}
+	fmt.Fprintf(opt.Out, "Running suite %s to verify the rollback\n\n", opt.RollbackSuite)
+	cmd := exec.CommandContext(ctx, os.Args[0], args...)
+	cmd.Stdout = opt.Out
+	cmd.Stderr = opt.ErrOut
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("suite %s failed after the rollback: %v", opt.RollbackSuite, err)
+	}
+	return nil
+}
+
+// upgradeFailed returns true if the cluster did not complete the upgrade away from the version
+// recorded before it, because the upgrade failed, is still in progress, or was aborted. A suite that
+// fails after the upgrade completed is not rolled back.
+func upgradeFailed(ctx context.Context, opt *runOptions) (bool, error) {
+	if opt.rollbackTo == nil {
+		return false, fmt.Errorf("the version before the upgrade was not recorded")
+	}
+	config, err := framework.LoadConfig(true)
+	if err != nil {
+		return false, err
+	}
+	client, err := configv1client.NewForConfig(config)
+	if err != nil {
+		return false, err
+	}
+	cv, err := client.ConfigV1().ClusterVersions().Get(ctx, "version", metav1.GetOptions{})
+	if err != nil {
+		return false, err
+	}
+	return !upgradeCompleted(cv.Status.History, *opt.rollbackTo), nil
+}
+
+// upgradeCompleted returns true if the latest update in history completed to a version other than from.
+func upgradeCompleted(history []configv1.UpdateHistory, from configv1.Update) bool {
+	return len(history) > 0 && history[0].State == configv1.CompletedUpdate && history[0].Image != from.Image
+}
+
+func rollback(ctx context.Context, opt *runOptions) error {
+	config, err := framework.LoadConfig(true)
+	if err != nil {
+		return err
+	}
+	client, err := configv1client.NewForConfig(config)
+	if err != nil {
+		return err
+	}
+	return upgrade.Rollback(ctx, client, *opt.rollbackTo, upgrade.RollbackTimeout)
+}
+
+func writeRollbackJUnit(dir string, testCase *junitapi.JUnitTestCase) error {
+	return writeJUnitTestCases(dir, "openshift-tests-rollback", "junit_rollback", testCase)
+}
+
+// writeJUnitTestCases writes the test cases of a phase that runs outside the suite as a report
+// named after prefix in dir.
+func writeJUnitTestCases(dir, suiteName, prefix string, testCases ...*junitapi.JUnitTestCase) error {
+	suite := &junitapi.JUnitTestSuite{
+		Name:      suiteName,
+		NumTests:  uint(len(testCases)),
+		TestCases: testCases,
+	}
+	for _, testCase := range testCases {
+		suite.Duration += testCase.Duration
+		if testCase.FailureOutput != nil {
+			suite.NumFailed++
+		}
+	}
+	out, err := xml.Marshal(suite)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	path := filepath.Join(dir, fmt.Sprintf("%s_%s.xml", prefix, time.Now().UTC().Format("20060102-150405")))
+	return ioutil.WriteFile(path, out, 0640)
+}
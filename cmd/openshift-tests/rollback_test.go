@@ -0,0 +1,84 @@
+package main
+
+import (
+	"encoding/xml"
+	"os"
+	"path/filepath"
+	"testing"
+
+	configv1 "github.com/openshift/api/config/v1"
+
+	"github.com/openshift/origin/pkg/test/ginkgo/junitapi"
+)
+
+func TestWriteRollbackJUnit(t *testing.T) {
+	tests := []struct {
+		name       string
+		failure    *junitapi.FailureOutput
+		wantFailed uint
+	}{
+		{name: "rollback completed"},
+		{name: "rollback failed", failure: &junitapi.FailureOutput{Output: "cluster did not complete rollback"}, wantFailed: 1},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			dir := t.TempDir()
+			if err := writeRollbackJUnit(dir, &junitapi.JUnitTestCase{Name: rollbackTestName, FailureOutput: test.failure}); err != nil {
+				t.Fatal(err)
+			}
+			files, err := filepath.Glob(filepath.Join(dir, "junit_rollback_*.xml"))
+			if err != nil || len(files) != 1 {
+				t.Fatalf("expected one report, got %v: %v", files, err)
+			}
+			data, err := os.ReadFile(files[0])
+			if err != nil {
+				t.Fatal(err)
+			}
+			suite := &junitapi.JUnitTestSuite{}
+			if err := xml.Unmarshal(data, suite); err != nil {
+				t.Fatal(err)
+			}
+			if suite.Name != "openshift-tests-rollback" || suite.NumTests != 1 || suite.NumFailed != test.wantFailed {
+				t.Errorf("unexpected suite: %#v", suite)
+			}
+			if len(suite.TestCases) != 1 || suite.TestCases[0].Name != rollbackTestName {
+				t.Errorf("unexpected test cases: %#v", suite.TestCases)
+			}
+		})
+	}
+}
+
+func TestUpgradeCompleted(t *testing.T) {
+	from := configv1.Update{Image: "release@sha256:from"}
+	tests := []struct {
+		name     string
+		history  []configv1.UpdateHistory
+		expected bool
+	}{
+		{
+			name:     "completed",
+			history:  []configv1.UpdateHistory{{State: configv1.CompletedUpdate, Image: "release@sha256:to"}, {State: configv1.CompletedUpdate, Image: from.Image}},
+			expected: true,
+		},
+		{
+			name:    "in progress or failed",
+			history: []configv1.UpdateHistory{{State: configv1.PartialUpdate, Image: "release@sha256:to"}, {State: configv1.CompletedUpdate, Image: from.Image}},
+		},
+		{
+			name: "aborted to the original version",
+			history: []configv1.UpdateHistory{
+				{State: configv1.CompletedUpdate, Image: from.Image},
+				{State: configv1.PartialUpdate, Image: "release@sha256:to"},
+				{State: configv1.CompletedUpdate, Image: from.Image},
+			},
+		},
+		{name: "no history"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := upgradeCompleted(test.history, from); got != test.expected {
+				t.Errorf("expected %t, got %t", test.expected, got)
+			}
+		})
+	}
+}
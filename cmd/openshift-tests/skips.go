@@ -0,0 +1,45 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"k8s.io/client-go/kubernetes"
+	e2e "k8s.io/kubernetes/test/e2e/framework"
+
+	"github.com/openshift/origin/test/extended/util/annotate/skips"
+)
+
+// loadSkipRules returns the skip rules embedded in the binary, overridden by the rules of
+// --skip-rules-file and then of --skip-rules-configmap.
+func loadSkipRules(opt *runOptions) (*skips.Rules, error) {
+	rules := skips.Default()
+	if len(opt.SkipRulesFile) > 0 {
+		override, err := skips.LoadFile(opt.SkipRulesFile)
+		if err != nil {
+			return nil, err
+		}
+		rules = rules.Override(override)
+	}
+	if len(opt.SkipRulesConfigMap) > 0 {
+		namespace, name, ok := strings.Cut(opt.SkipRulesConfigMap, "/")
+		if !ok || len(namespace) == 0 || len(name) == 0 {
+			return nil, fmt.Errorf("--skip-rules-configmap must have the form NAMESPACE/NAME")
+		}
+		clientConfig, err := e2e.LoadConfig(true)
+		if err != nil {
+			return nil, err
+		}
+		client, err := kubernetes.NewForConfig(clientConfig)
+		if err != nil {
+			return nil, err
+		}
+		override, err := skips.LoadConfigMap(context.Background(), client, namespace, name)
+		if err != nil {
+			return nil, fmt.Errorf("unable to load --skip-rules-configmap: %v", err)
+		}
+		rules = rules.Override(override)
+	}
+	return rules, nil
+}
@@ -90,6 +90,11 @@ func upgradeTestPreSuite(opt *runOptions) error {
 		if err := upgrade.GatherPreUpgradeResourceCounts(); err != nil {
 			return errors.Wrap(err, "error gathering preupgrade resource counts")
 		}
+		if len(opt.RollbackSuite) > 0 {
+			if err := recordRollbackVersion(opt); err != nil {
+				return err
+			}
+		}
 	}
 
 	// Upgrade test output is important for debugging because it shows linear progress
@@ -112,6 +117,7 @@ func upgradeTestPreTest() error {
 	}
 	parseUpgradeOptions(opt.TestOptions)
 	upgrade.SetToImage(opt.ToImage)
+	upgrade.SetHealthGates(opt.HealthGates)
 	switch opt.Suite {
 	case "none":
 		return filterUpgrade(upgrade.NoTests(), func(string) bool { return true })
@@ -150,6 +156,7 @@ type UpgradeOptions struct {
 	Suite       string
 	ToImage     string
 	TestOptions []string
+	HealthGates []upgrade.HealthGate `json:",omitempty"`
 }
 
 func (o *UpgradeOptions) ToEnv() string {
@@ -172,6 +179,8 @@ func filterUpgrade(tests []upgrades.Test, match func(string) bool) error {
 }
 
 func bindUpgradeOptions(opt *runOptions, flags *pflag.FlagSet) {
-	flags.StringVar(&opt.ToImage, "to-image", opt.ToImage, "Specify the image to test an upgrade to.")
+	flags.StringSliceVar(&opt.ToImages, "to-image", opt.ToImages, "Specify the image to test an upgrade to. Repeat to upgrade to each image in order.")
 	flags.StringSliceVar(&opt.TestOptions, "options", opt.TestOptions, "A set of KEY=VALUE options to control the test. See the help text.")
+	flags.StringVar(&opt.HealthGatesFile, "health-gates", opt.HealthGatesFile, "A YAML file of health gates that abort or pause each upgrade when their condition holds. See the help text.")
+	flags.StringVar(&opt.RollbackSuite, "verify-rollback-suite", opt.RollbackSuite, "If the upgrade fails or is aborted, roll the cluster back to its original version and run this suite against it.")
 }
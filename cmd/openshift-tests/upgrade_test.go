@@ -0,0 +1,43 @@
+package main
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/spf13/pflag"
+)
+
+func TestUpgradeChainEnv(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		want string
+	}{
+		{name: "single image", args: []string{"--to-image", "a"}, want: "a"},
+		{name: "repeated images", args: []string{"--to-image", "a", "--to-image", "b", "--to-image", "c"}, want: "a,b,c"},
+		{name: "comma separated images", args: []string{"--to-image", "a,b"}, want: "a,b"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			opt := NewRunOptions(defaultTestImageMirrorLocation)
+			flags := pflag.NewFlagSet("test", pflag.ContinueOnError)
+			bindUpgradeOptions(opt, flags)
+			if err := flags.Parse(test.args); err != nil {
+				t.Fatal(err)
+			}
+			opt.UpgradeSuite = "all"
+			var upgradeOptions UpgradeOptions
+			for _, env := range opt.AsEnv() {
+				if value := strings.TrimPrefix(env, "TEST_UPGRADE_OPTIONS="); value != env {
+					if err := json.Unmarshal([]byte(value), &upgradeOptions); err != nil {
+						t.Fatal(err)
+					}
+				}
+			}
+			if upgradeOptions.ToImage != test.want {
+				t.Errorf("expected images %q, got %q", test.want, upgradeOptions.ToImage)
+			}
+		})
+	}
+}
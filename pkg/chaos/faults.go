@@ -0,0 +1,466 @@
+package chaos
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/remotecommand"
+	"k8s.io/utils/pointer"
+
+	"github.com/openshift/origin/test/extended/util/image"
+)
+
+const (
+	// namespace holds the privileged pods that inject faults on the nodes.
+	namespace = "openshift-tests-chaos"
+
+	etcdNamespace = "openshift-etcd"
+
+	nodeDownTimeout    = 10 * time.Minute
+	nodeReadyTimeout   = 20 * time.Minute
+	etcdRestartTimeout = 10 * time.Minute
+	podRunningTimeout  = 5 * time.Minute
+)
+
+// clusterFaults injects faults into a cluster with privileged pods on its nodes.
+type clusterFaults struct {
+	client kubernetes.Interface
+	config *rest.Config
+}
+
+// setup creates the namespace of the pods that inject faults, which must allow privileged pods.
+func (c *clusterFaults) setup(ctx context.Context) error {
+	_, err := c.client.CoreV1().Namespaces().Create(ctx, &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: namespace,
+			Labels: map[string]string{
+				"pod-security.kubernetes.io/enforce":             "privileged",
+				"pod-security.kubernetes.io/audit":               "privileged",
+				"pod-security.kubernetes.io/warn":                "privileged",
+				"security.openshift.io/scc.podSecurityLabelSync": "false",
+			},
+		},
+	}, metav1.CreateOptions{})
+	if err != nil && !apierrors.IsAlreadyExists(err) {
+		return err
+	}
+	// the pods of the daemon set are created by its controller and admitted through their service account
+	_, err = c.client.RbacV1().RoleBindings(namespace).Create(ctx, &rbacv1.RoleBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: "privileged"},
+		RoleRef:    rbacv1.RoleRef{APIGroup: "rbac.authorization.k8s.io", Kind: "ClusterRole", Name: "system:openshift:scc:privileged"},
+		Subjects:   []rbacv1.Subject{{Kind: "ServiceAccount", Name: "default", Namespace: namespace}},
+	}, metav1.CreateOptions{})
+	if err != nil && !apierrors.IsAlreadyExists(err) {
+		return err
+	}
+	return nil
+}
+
+// teardown deletes the namespace of the pods that inject faults.
+func (c *clusterFaults) teardown() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	c.client.CoreV1().Namespaces().Delete(ctx, namespace, metav1.DeleteOptions{})
+}
+
+func (c *clusterFaults) inject(ctx context.Context, fault Fault, injected func(target string)) error {
+	switch fault.Type {
+	case NodeReboot:
+		return c.rebootNode(ctx, fault, injected)
+	case EtcdLeaderKill:
+		return c.killEtcdLeader(ctx, fault, injected)
+	case NetworkPartition:
+		return c.partitionNode(ctx, fault, injected)
+	}
+	return fmt.Errorf("unknown fault type %q", fault.Type)
+}
+
+// rebootNode reboots a node of the role of the fault and waits for it to go down and become ready.
+func (c *clusterFaults) rebootNode(ctx context.Context, fault Fault, injected func(target string)) error {
+	node, err := c.pickNode(ctx, fault.Role)
+	if err != nil {
+		return err
+	}
+	if _, err := c.client.CoreV1().Pods(namespace).Create(ctx, hostPod(fault, node.Name, "exec chroot /host systemctl reboot"), metav1.CreateOptions{}); err != nil {
+		return err
+	}
+	injected(node.Name)
+	if err := c.waitForNode(ctx, node.Name, false, nodeDownTimeout); err != nil {
+		return fmt.Errorf("node %s did not go down after the reboot: %v", node.Name, err)
+	}
+	if err := c.waitForNode(ctx, node.Name, true, nodeReadyTimeout); err != nil {
+		return fmt.Errorf("node %s did not become ready after the reboot: %v", node.Name, err)
+	}
+	return nil
+}
+
+// killEtcdLeader stops the etcd container of the leader and waits for the member to be restarted and
+// ready.
+func (c *clusterFaults) killEtcdLeader(ctx context.Context, fault Fault, injected func(target string)) error {
+	leader, err := c.etcdLeader(ctx)
+	if err != nil {
+		return err
+	}
+	restarts := containerRestarts(leader, "etcd")
+	script := `exec chroot /host /bin/bash -c 'crictl stop $(crictl ps --name "^etcd$" -q)'`
+	if _, err := c.client.CoreV1().Pods(namespace).Create(ctx, hostPod(fault, leader.Spec.NodeName, script), metav1.CreateOptions{}); err != nil {
+		return err
+	}
+	injected(leader.Spec.NodeName)
+	err = wait.PollImmediateWithContext(ctx, 5*time.Second, etcdRestartTimeout, func(ctx context.Context) (bool, error) {
+		pod, err := c.client.CoreV1().Pods(etcdNamespace).Get(ctx, leader.Name, metav1.GetOptions{})
+		if err != nil {
+			return false, nil
+		}
+		return containerRestarts(pod, "etcd") > restarts && podReady(pod), nil
+	})
+	if err != nil {
+		return fmt.Errorf("etcd member %s did not become ready after it was stopped: %v", leader.Name, err)
+	}
+	return nil
+}
+
+// partitionNode drops the traffic between a node of the role of the fault and the other nodes for the
+// duration of the fault. The rules are added by a daemon set on the node that removes them when the
+// duration has passed even if the node cannot reach the cluster, and the runner waits for the node to
+// be ready again.
+func (c *clusterFaults) partitionNode(ctx context.Context, fault Fault, injected func(target string)) error {
+	node, err := c.pickNode(ctx, fault.Role)
+	if err != nil {
+		return err
+	}
+	nodes, err := c.client.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	var peers []string
+	for _, peer := range nodes.Items {
+		if peer.Name != node.Name {
+			peers = append(peers, nodeAddresses(&peer)...)
+		}
+	}
+	if len(peers) == 0 {
+		return fmt.Errorf("node %s has no peers to partition it from", node.Name)
+	}
+
+	daemonSet := partitionDaemonSet(fault, node.Name, partitionScript(peers, fault.Duration.Duration))
+	if _, err := c.client.AppsV1().DaemonSets(namespace).Create(ctx, daemonSet, metav1.CreateOptions{}); err != nil {
+		return err
+	}
+	defer func() {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+		defer cancel()
+		c.client.AppsV1().DaemonSets(namespace).Delete(ctx, daemonSet.Name, metav1.DeleteOptions{PropagationPolicy: &foreground})
+	}()
+	err = wait.PollImmediateWithContext(ctx, 5*time.Second, podRunningTimeout, func(ctx context.Context) (bool, error) {
+		pods, err := c.client.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{LabelSelector: "chaos=" + daemonSet.Name})
+		if err != nil {
+			return false, nil
+		}
+		for _, pod := range pods.Items {
+			if pod.Status.Phase == corev1.PodRunning {
+				return true, nil
+			}
+		}
+		return false, nil
+	})
+	if err != nil {
+		return fmt.Errorf("the partition of node %s did not start: %v", node.Name, err)
+	}
+	injected(node.Name)
+
+	timer := time.NewTimer(fault.Duration.Duration)
+	select {
+	case <-ctx.Done():
+		timer.Stop()
+		return ctx.Err()
+	case <-timer.C:
+	}
+	if err := c.waitForNode(ctx, node.Name, true, nodeReadyTimeout); err != nil {
+		return fmt.Errorf("node %s did not become ready after the partition: %v", node.Name, err)
+	}
+	return nil
+}
+
+var foreground = metav1.DeletePropagationForeground
+
+// pickNode returns a random ready node with role, or of any role if role is empty.
+func (c *clusterFaults) pickNode(ctx context.Context, role string) (*corev1.Node, error) {
+	options := metav1.ListOptions{}
+	if len(role) > 0 {
+		options.LabelSelector = "node-role.kubernetes.io/" + role
+	}
+	nodes, err := c.client.CoreV1().Nodes().List(ctx, options)
+	if err != nil {
+		return nil, err
+	}
+	var ready []*corev1.Node
+	for i := range nodes.Items {
+		if nodeReady(&nodes.Items[i]) {
+			ready = append(ready, &nodes.Items[i])
+		}
+	}
+	if len(ready) == 0 {
+		return nil, fmt.Errorf("there is no ready node with role %q", role)
+	}
+	return ready[rand.Intn(len(ready))], nil
+}
+
+// waitForNode waits for the node to become ready, or not ready.
+func (c *clusterFaults) waitForNode(ctx context.Context, name string, ready bool, timeout time.Duration) error {
+	return wait.PollImmediateWithContext(ctx, 10*time.Second, timeout, func(ctx context.Context) (bool, error) {
+		node, err := c.client.CoreV1().Nodes().Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return false, nil
+		}
+		return nodeReady(node) == ready, nil
+	})
+}
+
+// etcdEndpointStatus is the part of the output of etcdctl endpoint status -w json used to find the
+// leader.
+type etcdEndpointStatus struct {
+	Endpoint string `json:"Endpoint"`
+	Status   struct {
+		Header struct {
+			MemberID uint64 `json:"member_id"`
+		} `json:"header"`
+		Leader uint64 `json:"leader"`
+	} `json:"Status"`
+}
+
+// etcdLeader returns the etcd pod of the leader, found by asking the members for their status.
+func (c *clusterFaults) etcdLeader(ctx context.Context) (*corev1.Pod, error) {
+	pods, err := c.client.CoreV1().Pods(etcdNamespace).List(ctx, metav1.ListOptions{LabelSelector: "app=etcd"})
+	if err != nil {
+		return nil, err
+	}
+	var running []*corev1.Pod
+	for i := range pods.Items {
+		if podReady(&pods.Items[i]) {
+			running = append(running, &pods.Items[i])
+		}
+	}
+	if len(running) == 0 {
+		return nil, fmt.Errorf("there is no ready etcd member")
+	}
+	out, err := c.exec(ctx, running[0], "etcdctl", "etcdctl", "endpoint", "status", "-w", "json")
+	if err != nil {
+		return nil, fmt.Errorf("unable to get the status of the etcd members: %v", err)
+	}
+	leaderIP, err := etcdLeaderIP(out)
+	if err != nil {
+		return nil, err
+	}
+	for _, pod := range running {
+		if pod.Status.PodIP == leaderIP {
+			return pod, nil
+		}
+	}
+	return nil, fmt.Errorf("there is no ready etcd pod for the leader at %s", leaderIP)
+}
+
+// etcdLeaderIP returns the IP of the endpoint of the leader in the output of etcdctl endpoint status.
+func etcdLeaderIP(out []byte) (string, error) {
+	var statuses []etcdEndpointStatus
+	if err := json.Unmarshal(out, &statuses); err != nil {
+		return "", fmt.Errorf("unable to parse the status of the etcd members: %v", err)
+	}
+	for _, status := range statuses {
+		if status.Status.Leader == 0 || status.Status.Header.MemberID != status.Status.Leader {
+			continue
+		}
+		endpoint, err := url.Parse(status.Endpoint)
+		if err != nil {
+			return "", fmt.Errorf("invalid etcd endpoint %q: %v", status.Endpoint, err)
+		}
+		return endpoint.Hostname(), nil
+	}
+	return "", fmt.Errorf("no etcd member is the leader")
+}
+
+func (c *clusterFaults) exec(ctx context.Context, pod *corev1.Pod, container string, command ...string) ([]byte, error) {
+	req := c.client.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Namespace(pod.Namespace).
+		Name(pod.Name).
+		SubResource("exec").
+		VersionedParams(&corev1.PodExecOptions{
+			Container: container,
+			Command:   command,
+			Stdout:    true,
+			Stderr:    true,
+		}, scheme.ParameterCodec)
+	executor, err := remotecommand.NewSPDYExecutor(c.config, "POST", req.URL())
+	if err != nil {
+		return nil, err
+	}
+	var stdout, stderr bytes.Buffer
+	if err := executor.StreamWithContext(ctx, remotecommand.StreamOptions{Stdout: &stdout, Stderr: &stderr}); err != nil {
+		return nil, fmt.Errorf("%v: %s", err, strings.TrimSpace(stderr.String()))
+	}
+	return stdout.Bytes(), nil
+}
+
+// partitionScript drops the traffic to and from peers, and restores it after duration. The rules are
+// added once per pod, so a restarted container does not partition the node again.
+func partitionScript(peers []string, duration time.Duration) string {
+	var add, remove []string
+	for _, peer := range peers {
+		iptables := "iptables"
+		if ip := net.ParseIP(peer); ip != nil && ip.To4() == nil {
+			iptables = "ip6tables"
+		}
+		add = append(add,
+			fmt.Sprintf("chroot /host %s -I INPUT -s %s -j DROP", iptables, peer),
+			fmt.Sprintf("chroot /host %s -I OUTPUT -d %s -j DROP", iptables, peer))
+		remove = append(remove,
+			fmt.Sprintf("chroot /host %s -D INPUT -s %s -j DROP", iptables, peer),
+			fmt.Sprintf("chroot /host %s -D OUTPUT -d %s -j DROP", iptables, peer))
+	}
+	return fmt.Sprintf(`[ -e /state/done ] && exec sleep infinity
+restore() {
+  %s
+  touch /state/done
+}
+trap 'restore; exit 0' TERM
+%s
+sleep %d &
+wait $!
+restore
+exec sleep infinity
+`, strings.Join(remove, " || true\n  ")+" || true", strings.Join(add, "\n"), int(duration.Seconds()))
+}
+
+// hostPod returns a privileged pod that runs script on node with the root of the host at /host.
+func hostPod(fault Fault, node, script string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: fault.Name + "-",
+			Labels:       map[string]string{"chaos": fault.Name},
+		},
+		Spec: corev1.PodSpec{
+			NodeName:      node,
+			RestartPolicy: corev1.RestartPolicyNever,
+			HostPID:       true,
+			HostNetwork:   true,
+			Tolerations:   []corev1.Toleration{{Operator: corev1.TolerationOpExists}},
+			Volumes:       []corev1.Volume{hostVolume()},
+			Containers:    []corev1.Container{hostContainer(script)},
+		},
+	}
+}
+
+// partitionDaemonSet returns a daemon set that runs script on node only.
+func partitionDaemonSet(fault Fault, node, script string) *appsv1.DaemonSet {
+	name := fault.Name
+	labels := map[string]string{"chaos": name}
+	container := hostContainer(script)
+	container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{Name: "state", MountPath: "/state"})
+	return &appsv1.DaemonSet{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels},
+		Spec: appsv1.DaemonSetSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: labels},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: labels},
+				Spec: corev1.PodSpec{
+					HostNetwork: true,
+					Tolerations: []corev1.Toleration{{Operator: corev1.TolerationOpExists}},
+					Affinity: &corev1.Affinity{
+						NodeAffinity: &corev1.NodeAffinity{
+							RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+								NodeSelectorTerms: []corev1.NodeSelectorTerm{{
+									MatchFields: []corev1.NodeSelectorRequirement{{
+										Key:      "metadata.name",
+										Operator: corev1.NodeSelectorOpIn,
+										Values:   []string{node},
+									}},
+								}},
+							},
+						},
+					},
+					TerminationGracePeriodSeconds: pointer.Int64(30),
+					Volumes: []corev1.Volume{
+						hostVolume(),
+						{Name: "state", VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}},
+					},
+					Containers: []corev1.Container{container},
+				},
+			},
+		},
+	}
+}
+
+func hostVolume() corev1.Volume {
+	return corev1.Volume{
+		Name:         "host",
+		VolumeSource: corev1.VolumeSource{HostPath: &corev1.HostPathVolumeSource{Path: "/"}},
+	}
+}
+
+func hostContainer(script string) corev1.Container {
+	return corev1.Container{
+		Name:    "chaos",
+		Image:   image.ShellImage(),
+		Command: []string{"/bin/bash", "-c", script},
+		SecurityContext: &corev1.SecurityContext{
+			Privileged: pointer.Bool(true),
+			RunAsUser:  pointer.Int64(0),
+		},
+		VolumeMounts:             []corev1.VolumeMount{{Name: "host", MountPath: "/host"}},
+		TerminationMessagePolicy: corev1.TerminationMessageFallbackToLogsOnError,
+	}
+}
+
+func nodeReady(node *corev1.Node) bool {
+	for _, condition := range node.Status.Conditions {
+		if condition.Type == corev1.NodeReady {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+func nodeAddresses(node *corev1.Node) []string {
+	var addresses []string
+	for _, address := range node.Status.Addresses {
+		if address.Type == corev1.NodeInternalIP {
+			addresses = append(addresses, address.Address)
+		}
+	}
+	return addresses
+}
+
+func podReady(pod *corev1.Pod) bool {
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == corev1.PodReady {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+func containerRestarts(pod *corev1.Pod, container string) int32 {
+	for _, status := range pod.Status.ContainerStatuses {
+		if status.Name == container {
+			return status.RestartCount
+		}
+	}
+	return 0
+}
@@ -0,0 +1,45 @@
+package chaos
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestEtcdLeaderIP(t *testing.T) {
+	status := `[
+{"Endpoint":"https://10.0.0.3:2379","Status":{"header":{"member_id":11},"leader":22}},
+{"Endpoint":"https://[fd00::4]:2379","Status":{"header":{"member_id":22},"leader":22}},
+{"Endpoint":"https://10.0.0.5:2379","Status":{"header":{"member_id":33},"leader":22}}
+]`
+	ip, err := etcdLeaderIP([]byte(status))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ip != "fd00::4" {
+		t.Errorf("expected the leader at fd00::4, got %s", ip)
+	}
+
+	if _, err := etcdLeaderIP([]byte(`[{"Endpoint":"https://10.0.0.3:2379","Status":{"header":{"member_id":11},"leader":0}}]`)); err == nil {
+		t.Errorf("expected an error without a leader")
+	}
+	if _, err := etcdLeaderIP([]byte(`not json`)); err == nil {
+		t.Errorf("expected an error for invalid output")
+	}
+}
+
+func TestPartitionScript(t *testing.T) {
+	script := partitionScript([]string{"10.0.0.3", "fd00::4"}, 2*time.Minute)
+	for _, expected := range []string{
+		"[ -e /state/done ] && exec sleep infinity",
+		"chroot /host iptables -I INPUT -s 10.0.0.3 -j DROP\n",
+		"chroot /host iptables -I OUTPUT -d 10.0.0.3 -j DROP\n",
+		"chroot /host ip6tables -I INPUT -s fd00::4 -j DROP\n",
+		"chroot /host ip6tables -D OUTPUT -d fd00::4 -j DROP || true\n",
+		"sleep 120 &",
+	} {
+		if !strings.Contains(script, expected) {
+			t.Errorf("expected the script to contain %q:\n%s", expected, script)
+		}
+	}
+}
@@ -0,0 +1,174 @@
+package chaos
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+
+	"github.com/openshift/origin/pkg/monitor"
+	"github.com/openshift/origin/pkg/monitor/monitorapi"
+)
+
+// Injection is one injection of a fault of the profile.
+type Injection struct {
+	Fault  string
+	Type   FaultType
+	Target string
+	From   time.Time
+	// To is zero while the fault is injected.
+	To time.Time
+}
+
+func (i Injection) String() string {
+	return fmt.Sprintf("%s (%s of %s)", i.Fault, i.Type, i.Target)
+}
+
+// injectFunc injects fault and returns when the cluster has recovered from it. It calls injected with
+// the target of the fault once the fault is injected, and not at all if the fault could not be.
+type injectFunc func(ctx context.Context, fault Fault, injected func(target string)) error
+
+// Injector injects the faults of a profile on their schedule and records every injection.
+type Injector struct {
+	profile *Profile
+
+	lock       sync.Mutex
+	injections []Injection
+
+	recorder monitor.Recorder
+	cleanup  func()
+	cancel   context.CancelFunc
+	wg       sync.WaitGroup
+	stopOnce sync.Once
+}
+
+// NewInjector returns an injector for the faults of profile. No fault is injected until Start.
+func NewInjector(profile *Profile) *Injector {
+	return &Injector{profile: profile}
+}
+
+// Start schedules the faults of the profile relative to now, and records their injections with
+// recorder. Faults are not injected after ctx is done or Stop is called.
+func (i *Injector) Start(ctx context.Context, restConfig *rest.Config, recorder monitor.Recorder) error {
+	client, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return err
+	}
+	c := &clusterFaults{client: client, config: restConfig}
+	if err := c.setup(ctx); err != nil {
+		return fmt.Errorf("unable to prepare the cluster for chaos: %v", err)
+	}
+	i.cleanup = c.teardown
+	i.start(ctx, recorder, c.inject)
+	return nil
+}
+
+func (i *Injector) start(ctx context.Context, recorder monitor.Recorder, inject injectFunc) {
+	// faults in progress are given ctx so Stop waits for the cluster to recover from them
+	scheduleCtx, cancel := context.WithCancel(ctx)
+	i.cancel = cancel
+	i.recorder = recorder
+	start := time.Now()
+	for _, fault := range i.profile.Faults {
+		i.wg.Add(1)
+		go i.schedule(scheduleCtx, ctx, start, fault, inject)
+	}
+}
+
+// Stop cancels the faults that have not been injected yet and waits for the cluster to recover from
+// those in progress. It may be called more than once.
+func (i *Injector) Stop() {
+	if i.cancel == nil {
+		return
+	}
+	i.stopOnce.Do(func() {
+		i.cancel()
+		i.wg.Wait()
+		if i.cleanup != nil {
+			i.cleanup()
+		}
+	})
+}
+
+func (i *Injector) schedule(ctx, injectCtx context.Context, start time.Time, fault Fault, inject injectFunc) {
+	defer i.wg.Done()
+	for n := 0; n < fault.Count; n++ {
+		at := start.Add(fault.After.Duration + time.Duration(n)*fault.Every.Duration)
+		timer := time.NewTimer(time.Until(at))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+		i.inject(injectCtx, fault, inject)
+	}
+}
+
+// inject injects fault and records the injection from the moment it is injected until the cluster has
+// recovered, or records the error if it could not be injected.
+func (i *Injector) inject(ctx context.Context, fault Fault, inject injectFunc) {
+	index, interval, target := -1, 0, ""
+	err := inject(ctx, fault, func(injectedTarget string) {
+		target = injectedTarget
+		now := time.Now()
+		i.lock.Lock()
+		defer i.lock.Unlock()
+		i.injections = append(i.injections, Injection{Fault: fault.Name, Type: fault.Type, Target: target, From: now})
+		index = len(i.injections) - 1
+		interval = i.recorder.StartInterval(now, monitorapi.Condition{
+			Level:   monitorapi.Warning,
+			Locator: locateInjection(fault, target),
+			Message: fmt.Sprintf("reason/ChaosInjected type/%s", fault.Type),
+		})
+	})
+	if index >= 0 {
+		now := time.Now()
+		i.lock.Lock()
+		i.injections[index].To = now
+		i.lock.Unlock()
+		i.recorder.EndInterval(interval, now)
+	}
+	if err != nil {
+		i.recorder.Record(monitorapi.Condition{
+			Level:   monitorapi.Error,
+			Locator: locateInjection(fault, target),
+			Message: fmt.Sprintf("reason/ChaosInjectionFailed type/%s %v", fault.Type, err),
+		})
+	}
+}
+
+func locateInjection(fault Fault, target string) string {
+	if len(target) == 0 {
+		return fmt.Sprintf("chaos/%s", fault.Name)
+	}
+	return fmt.Sprintf("chaos/%s node/%s", fault.Name, target)
+}
+
+// Injections returns the faults injected so far.
+func (i *Injector) Injections() []Injection {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	return append([]Injection(nil), i.injections...)
+}
+
+// Overlapping returns the injections in progress at any time between from and to, counting the settle
+// time of the profile after each injection ended.
+func (i *Injector) Overlapping(from, to time.Time) []Injection {
+	var overlapping []Injection
+	for _, injection := range i.Injections() {
+		end := injection.To
+		if end.IsZero() {
+			end = to
+		}
+		end = end.Add(i.profile.Settle.Duration)
+		if injection.From.After(to) || end.Before(from) {
+			continue
+		}
+		overlapping = append(overlapping, injection)
+	}
+	return overlapping
+}
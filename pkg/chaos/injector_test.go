@@ -0,0 +1,130 @@
+package chaos
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/openshift/origin/pkg/monitor"
+)
+
+func TestInjector(t *testing.T) {
+	profile := &Profile{
+		Faults: []Fault{
+			{Name: "reboot", Type: NodeReboot, Count: 3, Every: metav1.Duration{Duration: 10 * time.Millisecond}},
+			{Name: "kill", Type: EtcdLeaderKill, Count: 1},
+			{Name: "never", Type: NodeReboot, Count: 1, After: metav1.Duration{Duration: time.Hour}},
+		},
+	}
+	lock := sync.Mutex{}
+	counts := map[string]int{}
+	inject := func(ctx context.Context, fault Fault, injected func(target string)) error {
+		lock.Lock()
+		counts[fault.Name]++
+		lock.Unlock()
+		if fault.Name == "kill" {
+			return errors.New("no leader")
+		}
+		injected("node-a")
+		return nil
+	}
+
+	m := monitor.NewMonitor()
+	injector := NewInjector(profile)
+	injector.start(context.Background(), m, inject)
+	if err := waitFor(func() bool {
+		lock.Lock()
+		defer lock.Unlock()
+		return counts["reboot"] == 3 && counts["kill"] == 1
+	}); err != nil {
+		t.Fatal(err)
+	}
+	injector.Stop()
+	injector.Stop()
+
+	if counts["never"] != 0 {
+		t.Errorf("a fault was injected after the injector stopped")
+	}
+	injections := injector.Injections()
+	if len(injections) != 3 {
+		t.Fatalf("unexpected injections %v", injections)
+	}
+	for _, injection := range injections {
+		if injection.Fault != "reboot" || injection.Target != "node-a" || injection.To.IsZero() {
+			t.Errorf("unexpected injection %#v", injection)
+		}
+	}
+
+	var messages []string
+	for _, interval := range m.Intervals(time.Time{}, time.Time{}) {
+		messages = append(messages, interval.Locator+" "+interval.Message)
+	}
+	expected := []string{
+		"chaos/kill reason/ChaosInjectionFailed type/EtcdLeaderKill no leader",
+		"chaos/reboot node/node-a reason/ChaosInjected type/NodeReboot",
+		"chaos/reboot node/node-a reason/ChaosInjected type/NodeReboot",
+		"chaos/reboot node/node-a reason/ChaosInjected type/NodeReboot",
+	}
+	if len(messages) != len(expected) {
+		t.Fatalf("unexpected intervals %v", messages)
+	}
+	seen := map[string]int{}
+	for _, message := range messages {
+		seen[message]++
+	}
+	want := map[string]int{}
+	for _, message := range expected {
+		want[message]++
+	}
+	if !reflect.DeepEqual(seen, want) {
+		t.Errorf("expected intervals %v, got %v", expected, messages)
+	}
+}
+
+func TestInjectorOverlapping(t *testing.T) {
+	base := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	injector := NewInjector(&Profile{Settle: metav1.Duration{Duration: time.Minute}})
+	injector.injections = []Injection{
+		{Fault: "ended", From: base, To: base.Add(5 * time.Minute)},
+		{Fault: "in-progress", From: base.Add(20 * time.Minute)},
+	}
+	tests := []struct {
+		name     string
+		from, to time.Duration
+		expected []string
+	}{
+		{name: "before", from: -10 * time.Minute, to: -time.Minute},
+		{name: "during", from: 2 * time.Minute, to: 3 * time.Minute, expected: []string{"ended"}},
+		{name: "within settle", from: 5*time.Minute + 30*time.Second, to: 7 * time.Minute, expected: []string{"ended"}},
+		{name: "after settle", from: 7 * time.Minute, to: 10 * time.Minute},
+		{name: "in progress", from: 19 * time.Minute, to: 25 * time.Minute, expected: []string{"in-progress"}},
+		{name: "spanning", from: -time.Minute, to: time.Hour, expected: []string{"ended", "in-progress"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, injection := range injector.Overlapping(base.Add(tt.from), base.Add(tt.to)) {
+				got = append(got, injection.Fault)
+			}
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func waitFor(condition func() bool) error {
+	deadline := time.Now().Add(10 * time.Second)
+	for !condition() {
+		if time.Now().After(deadline) {
+			return errors.New("timed out waiting for the faults to be injected")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	return nil
+}
@@ -0,0 +1,129 @@
+// Package chaos injects faults into the cluster on a schedule while a suite runs, such as rebooting
+// a node, killing the etcd leader, or partitioning a node from the network. Every injection is
+// recorded as a monitor interval, and tests that fail while a fault is injected are reported as
+// failed as expected.
+package chaos
+
+import (
+	"fmt"
+	"io/ioutil"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+)
+
+// FaultType is a kind of fault the injector knows how to inject.
+type FaultType string
+
+const (
+	// NodeReboot reboots a node and waits for it to become ready again.
+	NodeReboot FaultType = "NodeReboot"
+	// EtcdLeaderKill stops the etcd member that is the leader and waits for it to become ready again.
+	EtcdLeaderKill FaultType = "EtcdLeaderKill"
+	// NetworkPartition drops the traffic between a node and the other nodes of the cluster for the
+	// duration of the fault.
+	NetworkPartition FaultType = "NetworkPartition"
+)
+
+// defaultSettle is how long after a fault ends the failures of tests are still attributed to it.
+const defaultSettle = time.Minute
+
+// Profile is the schedule of the faults injected during a run.
+type Profile struct {
+	// Settle is how long after a fault ends the failures of tests are still attributed to it, and
+	// defaults to one minute.
+	Settle metav1.Duration `json:"settle,omitempty"`
+	// Faults are injected independently of each other, so they may overlap.
+	Faults []Fault `json:"faults"`
+}
+
+// Fault is one fault of a profile and when it is injected.
+type Fault struct {
+	// Name identifies the fault in the intervals and test output, and must be unique in the profile.
+	Name string    `json:"name"`
+	Type FaultType `json:"type"`
+	// Role selects the nodes the fault may target by their node-role.kubernetes.io label, such as
+	// worker. One ready node is chosen at random for every injection. It is ignored by
+	// EtcdLeaderKill, which targets the control plane node of the leader.
+	Role string `json:"role,omitempty"`
+	// After is how long after the start of the run the fault is first injected.
+	After metav1.Duration `json:"after"`
+	// Every, if set, is the time between the start of consecutive injections of the fault.
+	Every metav1.Duration `json:"every,omitempty"`
+	// Count is the number of times the fault is injected, and defaults to one.
+	Count int `json:"count,omitempty"`
+	// Duration is how long a NetworkPartition lasts.
+	Duration metav1.Duration `json:"duration,omitempty"`
+}
+
+// ParseProfile parses a profile in YAML or JSON and applies its defaults.
+func ParseProfile(data []byte) (*Profile, error) {
+	profile := &Profile{}
+	if err := yaml.UnmarshalStrict(data, profile); err != nil {
+		return nil, err
+	}
+	if err := profile.validate(); err != nil {
+		return nil, err
+	}
+	if profile.Settle.Duration == 0 {
+		profile.Settle.Duration = defaultSettle
+	}
+	for i := range profile.Faults {
+		if profile.Faults[i].Count == 0 {
+			profile.Faults[i].Count = 1
+		}
+	}
+	return profile, nil
+}
+
+// LoadProfile reads the profile at path.
+func LoadProfile(path string) (*Profile, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	profile, err := ParseProfile(data)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse chaos profile %s: %v", path, err)
+	}
+	return profile, nil
+}
+
+func (p *Profile) validate() error {
+	if len(p.Faults) == 0 {
+		return fmt.Errorf("at least one fault must be specified")
+	}
+	if p.Settle.Duration < 0 {
+		return fmt.Errorf("settle must not be negative")
+	}
+	names := map[string]bool{}
+	for i, fault := range p.Faults {
+		if len(fault.Name) == 0 {
+			return fmt.Errorf("fault %d has no name", i)
+		}
+		if names[fault.Name] {
+			return fmt.Errorf("fault %q is specified more than once", fault.Name)
+		}
+		names[fault.Name] = true
+		switch fault.Type {
+		case NodeReboot, EtcdLeaderKill:
+			if fault.Duration.Duration != 0 {
+				return fmt.Errorf("fault %q: duration is only supported by %s", fault.Name, NetworkPartition)
+			}
+		case NetworkPartition:
+			if fault.Duration.Duration <= 0 {
+				return fmt.Errorf("fault %q: %s requires a duration", fault.Name, NetworkPartition)
+			}
+		default:
+			return fmt.Errorf("fault %q: unknown type %q, must be one of %s, %s, or %s", fault.Name, fault.Type, NodeReboot, EtcdLeaderKill, NetworkPartition)
+		}
+		if fault.After.Duration < 0 || fault.Every.Duration < 0 || fault.Count < 0 {
+			return fmt.Errorf("fault %q: after, every, and count must not be negative", fault.Name)
+		}
+		if fault.Count > 1 && fault.Every.Duration == 0 {
+			return fmt.Errorf("fault %q: a count greater than one requires every", fault.Name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+package chaos
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseProfile(t *testing.T) {
+	profile, err := ParseProfile([]byte(`
+faults:
+- name: reboot-worker
+  type: NodeReboot
+  role: worker
+  after: 10m
+  every: 30m
+  count: 2
+- name: partition
+  type: NetworkPartition
+  after: 1h
+  duration: 2m
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if profile.Settle.Duration != time.Minute {
+		t.Errorf("expected the default settle time, got %s", profile.Settle.Duration)
+	}
+	if profile.Faults[0].Count != 2 || profile.Faults[0].Every.Duration != 30*time.Minute || profile.Faults[1].Count != 1 {
+		t.Errorf("unexpected faults %#v", profile.Faults)
+	}
+
+	tests := []struct {
+		name    string
+		profile string
+		wantErr string
+	}{
+		{name: "empty", profile: `faults: []`, wantErr: "at least one fault"},
+		{name: "unknown field", profile: "faults:\n- name: a\n  type: NodeReboot\n  target: x", wantErr: "unknown field"},
+		{name: "unnamed", profile: "faults:\n- type: NodeReboot", wantErr: "fault 0 has no name"},
+		{name: "duplicate", profile: "faults:\n- name: a\n  type: NodeReboot\n- name: a\n  type: EtcdLeaderKill", wantErr: `fault "a" is specified more than once`},
+		{name: "unknown type", profile: "faults:\n- name: a\n  type: Meteor", wantErr: `unknown type "Meteor"`},
+		{name: "partition without duration", profile: "faults:\n- name: a\n  type: NetworkPartition", wantErr: "requires a duration"},
+		{name: "reboot with duration", profile: "faults:\n- name: a\n  type: NodeReboot\n  duration: 1m", wantErr: "duration is only supported"},
+		{name: "repeated without every", profile: "faults:\n- name: a\n  type: NodeReboot\n  count: 2", wantErr: "requires every"},
+		{name: "negative", profile: "faults:\n- name: a\n  type: NodeReboot\n  after: -1m", wantErr: "must not be negative"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseProfile([]byte(tt.profile))
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
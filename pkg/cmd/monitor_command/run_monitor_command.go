@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"net"
 	"os"
 	"os/signal"
 	"path/filepath"
@@ -11,10 +12,12 @@ import (
 	"time"
 
 	"github.com/openshift/origin/pkg/monitor"
+	"github.com/openshift/origin/pkg/monitortestframework"
 	"github.com/openshift/origin/test/extended/util/disruption/controlplane"
 	"github.com/openshift/origin/test/extended/util/disruption/externalservice"
 	"github.com/openshift/origin/test/extended/util/disruption/frontends"
 	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 	"k8s.io/kubectl/pkg/util/templates"
 )
@@ -24,6 +27,10 @@ import (
 type RunMonitorOptions struct {
 	Out, ErrOut io.Writer
 	ArtifactDir string
+	// ServeAddress, if set, is the address at which the intervals collected so far are served
+	ServeAddress string
+	// WatchResources are group/version/resource strings of additional resources to record intervals for
+	WatchResources []string
 
 	AdditionalEventIntervalRecorders []monitor.StartEventIntervalRecorderFunc
 
@@ -54,6 +61,15 @@ func NewRunMonitorCommand(ioStreams genericclioptions.IOStreams) *cobra.Command
 		Long: templates.LongDesc(`
 		Run a continuous verification process
 
+		If --serve is set, the intervals collected so far are served over HTTP at that address
+		while the monitor runs. / shows a timeline of the intervals that reloads itself, and
+		/intervals returns them as JSON, selected by the locator, source, from, and to query
+		parameters.
+
+		Each --watch-resource, for example machine.openshift.io/v1beta1/machines, adds the
+		creation, deletion, and status condition changes of the objects of that resource to
+		the intervals, like cluster operators.
+
 		`),
 
 		SilenceUsage:  true,
@@ -65,6 +81,12 @@ func NewRunMonitorCommand(ioStreams genericclioptions.IOStreams) *cobra.Command
 	cmd.Flags().StringVar(&monitorOpt.ArtifactDir,
 		"artifact-dir", monitorOpt.ArtifactDir,
 		"The directory where monitor events will be stored.")
+	cmd.Flags().StringVar(&monitorOpt.ServeAddress,
+		"serve", monitorOpt.ServeAddress,
+		"If set, serve the intervals collected so far over HTTP at this address, for example localhost:8080.")
+	cmd.Flags().StringArrayVar(&monitorOpt.WatchResources,
+		"watch-resource", monitorOpt.WatchResources,
+		"A resource, as group/version/resource, whose object creations, deletions, and status condition changes are recorded. May be repeated.")
 	return cmd
 }
 
@@ -90,14 +112,43 @@ func (opt *RunMonitorOptions) Run() error {
 	}()
 	signal.Notify(abortCh, syscall.SIGINT, syscall.SIGTERM)
 
+	recorders := append(opt.AdditionalEventIntervalRecorders, monitortestframework.StartCollectionFuncs()...)
+	if len(opt.WatchResources) > 0 {
+		var gvrs []schema.GroupVersionResource
+		for _, resource := range opt.WatchResources {
+			gvr, err := monitor.ParseWatchResource(resource)
+			if err != nil {
+				return fmt.Errorf("--watch-resource: %v", err)
+			}
+			gvrs = append(gvrs, gvr)
+		}
+		recorders = append(recorders, monitor.StartCustomResourceMonitoring(gvrs))
+	}
+
 	restConfig, err := monitor.GetMonitorRESTConfig()
 	if err != nil {
 		return err
 	}
-	m, err := monitor.Start(ctx, restConfig, opt.AdditionalEventIntervalRecorders)
+	// listen before starting the monitor, so an address in use is reported right away
+	var listener net.Listener
+	if len(opt.ServeAddress) > 0 {
+		listener, err = net.Listen("tcp", opt.ServeAddress)
+		if err != nil {
+			return err
+		}
+	}
+	m, err := monitor.Start(ctx, restConfig, recorders)
 	if err != nil {
 		return err
 	}
+	if listener != nil {
+		fmt.Fprintf(opt.ErrOut, "Serving intervals at http://%s/\n", listener.Addr())
+		go func() {
+			if err := serve(ctx, listener, m); err != nil {
+				fmt.Fprintf(opt.ErrOut, "error: Unable to serve intervals: %v\n", err)
+			}
+		}()
+	}
 
 	go func() {
 		ticker := time.NewTicker(100 * time.Millisecond)
@@ -0,0 +1,52 @@
+package monitor_command
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/openshift/origin/pkg/monitor/intervalquery"
+)
+
+// liveTimelineRefresh is how often the live timeline reloads itself to show new intervals.
+const liveTimelineRefresh = 10 * time.Second
+
+// newServeMux serves the intervals collected so far by source. /intervals answers the same queries
+// tests make of a running suite, and / renders the timeline of every interval, reloading itself
+// every refresh so it can be watched while the monitor runs.
+func newServeMux(source intervalquery.Source, refresh time.Duration) *http.ServeMux {
+	mux := http.NewServeMux()
+	mux.Handle("/intervals", intervalquery.NewHandler(source))
+	mux.HandleFunc("/", func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/" {
+			http.NotFound(w, req)
+			return
+		}
+		html, err := renderHTML(source.Intervals(time.Time{}, time.Time{}))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		meta := fmt.Sprintf("<head>\n    <meta http-equiv=\"refresh\" content=\"%d\">", int(refresh.Seconds()))
+		html = bytes.Replace(html, []byte("<head>"), []byte(meta), 1)
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.Write(html)
+	})
+	return mux
+}
+
+// serve answers requests for the intervals of source on listener until ctx is done.
+func serve(ctx context.Context, listener net.Listener, source intervalquery.Source) error {
+	server := &http.Server{Handler: newServeMux(source, liveTimelineRefresh)}
+	go func() {
+		<-ctx.Done()
+		server.Close()
+	}()
+	if err := server.Serve(listener); err != http.ErrServerClosed {
+		return err
+	}
+	return nil
+}
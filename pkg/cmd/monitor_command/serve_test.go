@@ -0,0 +1,59 @@
+package monitor_command
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/openshift/origin/pkg/monitor/monitorapi"
+)
+
+type fakeSource monitorapi.Intervals
+
+func (s fakeSource) Intervals(from, to time.Time) monitorapi.Intervals {
+	return monitorapi.Intervals(s)
+}
+
+func TestServeMux(t *testing.T) {
+	start := time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC)
+	source := fakeSource{
+		{Condition: monitorapi.Condition{Level: monitorapi.Error, Locator: "disruption/kube-api connection/new", Message: "disrupted"}, From: start, To: start.Add(10 * time.Second)},
+	}
+	server := httptest.NewServer(newServeMux(source, 5*time.Second))
+	defer server.Close()
+
+	tests := []struct {
+		path     string
+		status   int
+		contains []string
+	}{
+		{path: "/", status: http.StatusOK, contains: []string{`<meta http-equiv="refresh" content="5">`, "disruption/kube-api connection/new"}},
+		{path: "/intervals?source=disruption", status: http.StatusOK, contains: []string{"disruption/kube-api connection/new"}},
+		{path: "/intervals?from=yesterday", status: http.StatusBadRequest},
+		{path: "/missing", status: http.StatusNotFound},
+	}
+	for _, test := range tests {
+		t.Run(test.path, func(t *testing.T) {
+			resp, err := http.Get(server.URL + test.path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer resp.Body.Close()
+			body, err := ioutil.ReadAll(resp.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if resp.StatusCode != test.status {
+				t.Fatalf("expected status %d, got %d: %s", test.status, resp.StatusCode, body)
+			}
+			for _, s := range test.contains {
+				if !strings.Contains(string(body), s) {
+					t.Errorf("expected the response to contain %q", s)
+				}
+			}
+		})
+	}
+}
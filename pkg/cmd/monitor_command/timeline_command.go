@@ -2,7 +2,6 @@ package monitor_command
 
 import (
 	"archive/zip"
-	"bytes"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -18,7 +17,6 @@ import (
 	"github.com/openshift/origin/pkg/monitor/intervalcreation"
 	"github.com/openshift/origin/pkg/monitor/monitorapi"
 	monitorserialization "github.com/openshift/origin/pkg/monitor/serialization"
-	"github.com/openshift/origin/test/extended/testdata"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -34,6 +32,10 @@ type TimelineOptions struct {
 	Namespaces      []string
 	OutputType      string
 	EndDate         string
+	// ChartSpec is the name of a chart spec preset or the path of a chart spec file
+	ChartSpec string
+
+	chartSpec *intervalcreation.ChartSpec
 
 	KnownRenderers map[string]RenderFunc
 	KnownTimelines map[string]monitorapi.EventIntervalMatchesFunc
@@ -103,12 +105,20 @@ func (o *TimelineOptions) Bind(flagset *pflag.FlagSet) error {
 	flagset.StringVar(&o.TimelineType, "type", o.TimelineType, "type of timeline to produce: "+strings.Join(sets.StringKeySet(o.KnownTimelines).List(), ","))
 	flagset.StringVar(&o.PodResourceFilename, "known-pods", o.PodResourceFilename, "resource-pods_<timestamp>.zip filename from openshift-tests.")
 	flagset.StringSliceVarP(&o.LocatorMatchers, "locator", "l", o.LocatorMatchers, "key=value selector for monitor event locators (where value is a regex).  for instance -lpod=openshift-etcd-installer.  The same key listed multiple times means an OR.  Each separate key is logically ANDed.  Precede value with a dash for anti-match")
+	flagset.StringVar(&o.ChartSpec, "chart-spec", o.ChartSpec, fmt.Sprintf("Show only the intervals selected by this chart spec, grouped into the rows it describes, usually with --type=everything. Either a preset (%s) or the path of a YAML file in the format of pkg/monitor/intervalcreation/chartspecs.", strings.Join(intervalcreation.ChartSpecPresetNames(), ", ")))
 	flagset.StringVarP(&o.EndDate, "end-date", "e", o.EndDate, fmt.Sprintf("End date (default is one hour after latest event) in RFC3399 format in UTC timezone: %s", time.RFC3339))
 
 	return nil
 }
 
 func (o *TimelineOptions) Complete() error {
+	if len(o.ChartSpec) > 0 {
+		spec, err := intervalcreation.LoadChartSpec(o.ChartSpec)
+		if err != nil {
+			return fmt.Errorf("--chart-spec: %v", err)
+		}
+		o.chartSpec = spec
+	}
 	return nil
 }
 
@@ -170,6 +180,18 @@ func (o *TimelineOptions) ToTimeline() *Timeline {
 		endDateTime = nil
 	}
 
+	renderer := o.KnownRenderers[o.OutputType]
+	timelineFilter := o.KnownTimelines[o.TimelineType]
+	if o.chartSpec != nil {
+		if o.OutputType == "html" {
+			renderer = chartSpecHTMLRenderer(o.chartSpec)
+		}
+		typeFilter, specFilter := timelineFilter, o.chartSpec.Matches()
+		timelineFilter = func(eventInterval monitorapi.EventInterval) bool {
+			return typeFilter(eventInterval) && specFilter(eventInterval)
+		}
+	}
+
 	return &Timeline{
 		MonitorEventFilename: o.MonitorEventFilename,
 		PodResourceFilename:  o.PodResourceFilename,
@@ -179,8 +201,8 @@ func (o *TimelineOptions) ToTimeline() *Timeline {
 		Namespaces:            o.Namespaces,
 		EndDate:               endDateTime,
 
-		Renderer:       o.KnownRenderers[o.OutputType],
-		TimelineFilter: o.KnownTimelines[o.TimelineType],
+		Renderer:       renderer,
+		TimelineFilter: timelineFilter,
 		IOStreams:      o.IOStreams,
 	}
 }
@@ -260,17 +282,14 @@ func (o *Timeline) Run() error {
 }
 
 func renderHTML(events monitorapi.Intervals) ([]byte, error) {
-	eventIntervalsJSON, err := monitorserialization.EventsIntervalsToJSON(events)
-	if err != nil {
-		return nil, err
+	return intervalcreation.RenderChartHTML("Timeline", events, nil)
+}
 
+// chartSpecHTMLRenderer renders the intervals arranged by the chart spec.
+func chartSpecHTMLRenderer(spec *intervalcreation.ChartSpec) RenderFunc {
+	return func(events monitorapi.Intervals) ([]byte, error) {
+		return intervalcreation.RenderChartHTML(fmt.Sprintf("Timeline - %s", spec.Name), events, spec)
 	}
-	e2eChartTemplate := testdata.MustAsset("e2echart/non-spyglass-e2e-chart-template.html")
-	e2eChartTitle := "Timeline"
-	e2eChartHTML := bytes.ReplaceAll(e2eChartTemplate, []byte("EVENT_INTERVAL_TITLE_GOES_HERE"), []byte(e2eChartTitle))
-	e2eChartHTML = bytes.ReplaceAll(e2eChartHTML, []byte("EVENT_INTERVAL_JSON_GOES_HERE"), eventIntervalsJSON)
-
-	return e2eChartHTML, nil
 }
 
 func loadKnownPods(filename string) (monitorapi.ResourcesMap, error) {
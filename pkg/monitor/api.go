@@ -7,7 +7,6 @@ import (
 	"sync"
 	"time"
 
-	configclientset "github.com/openshift/client-go/config/clientset/versioned"
 	"github.com/openshift/origin/pkg/monitor/apiserveravailability"
 	"github.com/openshift/origin/pkg/monitor/monitorapi"
 	corev1 "k8s.io/api/core/v1"
@@ -21,7 +20,17 @@ import (
 	"k8s.io/klog/v2"
 )
 
+// GetMonitorRESTConfig returns the configuration of the default kubeconfig. Clients created from it pick
+// up new credentials when the kubeconfig changes, see WithKubeconfigReload.
 func GetMonitorRESTConfig() (*rest.Config, error) {
+	clusterConfig, err := loadMonitorRESTConfig()
+	if err != nil {
+		return nil, err
+	}
+	return WithKubeconfigReload(clusterConfig, loadMonitorRESTConfig)
+}
+
+func loadMonitorRESTConfig() (*rest.Config, error) {
 	cfg := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(clientcmd.NewDefaultClientConfigLoadingRules(), &clientcmd.ConfigOverrides{})
 	clusterConfig, err := cfg.ClientConfig()
 	if err != nil {
@@ -39,10 +48,6 @@ func Start(ctx context.Context, restConfig *rest.Config, additionalEventInterval
 	if err != nil {
 		return nil, err
 	}
-	configClient, err := configclientset.NewForConfig(restConfig)
-	if err != nil {
-		return nil, err
-	}
 
 	for _, additionalEventIntervalRecorder := range additionalEventIntervalRecorders {
 		if err := additionalEventIntervalRecorder(ctx, m, restConfig); err != nil {
@@ -61,9 +66,6 @@ func Start(ctx context.Context, restConfig *rest.Config, additionalEventInterval
 	startNodeMonitoring(ctx, m, client)
 	startEventMonitoring(ctx, m, client)
 
-	// add interval creation at the same point where we add the monitors
-	startClusterOperatorMonitoring(ctx, m, configClient)
-
 	m.StartSampling(ctx)
 	return m, nil
 }
@@ -9,6 +9,7 @@ import (
 	"io/ioutil"
 	"net"
 	"net/http"
+	"net/http/httptrace"
 	"regexp"
 	"sync"
 	"time"
@@ -73,12 +74,31 @@ type BackendSampler struct {
 	//	good enough for CI
 	httpClientErr error
 
-	// runningLock
-	runningLock sync.Mutex
-	// stopRunning is a context cancel for the localContext used to run
-	stopRunning context.CancelFunc
+	sampleRunner
+}
+
+// sampledBackend is checked once a second by the disruption sampler, which records the edges of its
+// availability into the monitorRecorder.  BackendSampler checks HTTP endpoints, UDPEchoBackendSampler
+// and GRPCHealthBackendSampler check services that don't speak HTTP.
+type sampledBackend interface {
+	GetDisruptionBackendName() string
+	GetLocator() string
+	GetConnectionType() monitorapi.BackendConnectionType
+	// CheckConnection returns an identifier for the request, if there is one, and an error if the backend
+	// was not available.
+	CheckConnection(ctx context.Context) (string, error)
+	getTimeout() time.Duration
 }
 
+// phasedBackend is implemented by backends that time the phases of their requests separately, which the
+// disruption sampler records as intervals when a phase degrades.
+type phasedBackend interface {
+	checkConnectionPhases(ctx context.Context) (string, *requestPhases, error)
+}
+
+// defaultSampleTimeout is used by samplers that were not given a timeout.
+const defaultSampleTimeout = 10 * time.Second
+
 type routeCoordinates struct {
 	// namespace containing the route
 	namespace string
@@ -211,7 +231,7 @@ func (b *BackendSampler) GetConnectionType() monitorapi.BackendConnectionType {
 
 func (b *BackendSampler) getTimeout() time.Duration {
 	if b.timeout == nil {
-		return 10 * time.Second
+		return defaultSampleTimeout
 	}
 	return *b.timeout
 }
@@ -256,10 +276,11 @@ func (b *BackendSampler) GetHTTPClient() (*http.Client, error) {
 		switch b.GetConnectionType() {
 		case monitorapi.NewConnectionType:
 			httpTransport = &http.Transport{
-				Dial: (&net.Dialer{
+				// DialContext rather than Dial, so the phases of the request are traced
+				DialContext: (&net.Dialer{
 					Timeout:   timeoutForPartOfRequest,
 					KeepAlive: -1, // this looks unnecessary to me, but it was set in other code.
-				}).Dial,
+				}).DialContext,
 				TLSClientConfig:       b.getTLSConfig(),
 				DisableKeepAlives:     true, // this prevents connections from being reused
 				TLSHandshakeTimeout:   timeoutForPartOfRequest,
@@ -271,9 +292,9 @@ func (b *BackendSampler) GetHTTPClient() (*http.Client, error) {
 
 		case monitorapi.ReusedConnectionType:
 			httpTransport = &http.Transport{
-				Dial: (&net.Dialer{
+				DialContext: (&net.Dialer{
 					Timeout: timeoutForPartOfRequest,
-				}).Dial,
+				}).DialContext,
 				TLSClientConfig:       b.getTLSConfig(),
 				TLSHandshakeTimeout:   timeoutForPartOfRequest,
 				IdleConnTimeout:       timeoutForPartOfRequest,
@@ -312,23 +333,31 @@ func (b *BackendSampler) GetHTTPClient() (*http.Client, error) {
 
 // CheckConnnection returns the audit request UID and an error if there was one.
 func (b *BackendSampler) CheckConnection(ctx context.Context) (string, error) {
+	uid, _, err := b.checkConnectionPhases(ctx)
+	return uid, err
+}
+
+// checkConnectionPhases returns the audit request UID, the timing of the phases of the request, and an error
+// if there was one. The phases are nil if the request was never sent.
+func (b *BackendSampler) checkConnectionPhases(ctx context.Context) (string, *requestPhases, error) {
 	httpClient, err := b.GetHTTPClient()
 	if err != nil {
-		return "", err
+		return "", nil, err
 	}
 
 	url, err := b.GetURL()
 	if err != nil {
-		return "", err
+		return "", nil, err
 	}
 
 	// this is longer than the http client timeout to avoid tripping, but is here to be sure we finish eventually
 	backstopContextTimeout := b.getTimeout() * 3 / 2 // (1.5)
 	requestContext, requestCancel := context.WithTimeout(ctx, backstopContextTimeout)
 	defer requestCancel()
-	req, err := http.NewRequestWithContext(requestContext, http.MethodGet, url, nil)
+	phases := newRequestPhases()
+	req, err := http.NewRequestWithContext(httptrace.WithClientTrace(requestContext, phases.trace()), http.MethodGet, url, nil)
 	if err != nil {
-		return "", err
+		return "", nil, err
 	}
 
 	uid := uuid.New().String()
@@ -337,7 +366,7 @@ func (b *BackendSampler) CheckConnection(ctx context.Context) (string, error) {
 	resp, getErr := httpClient.Do(req)
 	if requestContext.Err() == context.Canceled {
 		// this isn't an error, we were simply cancelled
-		return uid, nil
+		return uid, nil, nil
 	}
 
 	var body []byte
@@ -363,20 +392,65 @@ func (b *BackendSampler) CheckConnection(ctx context.Context) (string, error) {
 		}
 	}
 
-	return uid, sampleErr
+	phases.setError(sampleErr)
+	return uid, phases, sampleErr
 }
 
 // RunEndpointMonitoring sets up a client for the given BackendSampler, starts checking the endpoint, and recording
 // success/failure edges into the monitorRecorder, and blocks until the context is closed or the sampler is closed.
 func (b *BackendSampler) RunEndpointMonitoring(ctx context.Context, monitorRecorder Recorder, eventRecorder events.EventRecorder) error {
-	if b.isRunning() {
+	return b.sampleRunner.run(ctx, b, monitorRecorder, eventRecorder)
+}
+
+// StartEndpointMonitoring sets up a client for the given BackendSampler, starts checking the endpoint, and recording
+// success/failure edges into the monitorRecorder. Disabled backends are not checked.
+func (b *BackendSampler) StartEndpointMonitoring(ctx context.Context, monitorRecorder Recorder, eventRecorder events.EventRecorder) error {
+	if isBackendDisabled(b.disruptionBackendName) {
+		return nil
+	}
+	return b.sampleRunner.start(ctx, b, monitorRecorder, eventRecorder)
+}
+
+var (
+	disabledBackendsLock sync.Mutex
+	disabledBackends     = map[string]bool{}
+)
+
+// DisableBackends keeps StartEndpointMonitoring from checking the named disruption backends, for clusters
+// that do not serve them.
+func DisableBackends(names ...string) {
+	disabledBackendsLock.Lock()
+	defer disabledBackendsLock.Unlock()
+	for _, name := range names {
+		disabledBackends[name] = true
+	}
+}
+
+func isBackendDisabled(name string) bool {
+	disabledBackendsLock.Lock()
+	defer disabledBackendsLock.Unlock()
+	return disabledBackends[name]
+}
+
+// sampleRunner runs the disruption sampler for a backend, one run at a time.
+type sampleRunner struct {
+	// runningLock
+	runningLock sync.Mutex
+	// stopRunning is a context cancel for the localContext used to run
+	stopRunning context.CancelFunc
+}
+
+// run starts checking the backend and recording success/failure edges into the monitorRecorder, and blocks until
+// the context is closed or the runner is stopped.
+func (r *sampleRunner) run(ctx context.Context, backend sampledBackend, monitorRecorder Recorder, eventRecorder events.EventRecorder) error {
+	if r.isRunning() {
 		return fmt.Errorf("cannot monitor twice at the same time")
 	}
 
 	// the producer is wired from the original context so that a base cancel stops everything
 	producerContext, producerCancel := context.WithCancel(ctx)
 	defer producerCancel()
-	b.setCancelForRun(producerCancel) // used from .Stop later to stop monitoring
+	r.setCancelForRun(producerCancel) // used from .Stop later to stop monitoring
 
 	// the consumer context is separate from the original context, but stopped 15s after the producer context closes.
 	// this allows consumption to complete after the context is closed
@@ -384,7 +458,7 @@ func (b *BackendSampler) RunEndpointMonitoring(ctx context.Context, monitorRecor
 	defer consumerCancel()
 	go func() {
 		<-producerContext.Done()
-		consumptionGrace := b.getTimeout() * 2 // we need to wait longer than backstopContextTimeout to ensure we're finished producing
+		consumptionGrace := backend.getTimeout() * 2 // we need to wait longer than backstopContextTimeout to ensure we're finished producing
 		time.Sleep(consumptionGrace)
 		consumerCancel()
 	}()
@@ -408,7 +482,7 @@ func (b *BackendSampler) RunEndpointMonitoring(ctx context.Context, monitorRecor
 	}
 
 	interval := 1 * time.Second
-	disruptionSampler := newDisruptionSampler(b)
+	disruptionSampler := newDisruptionSampler(backend)
 	go disruptionSampler.produceSamples(producerContext, interval)
 	go disruptionSampler.consumeSamples(consumerContext, interval, monitorRecorder, eventRecorder)
 
@@ -424,36 +498,35 @@ func (b *BackendSampler) RunEndpointMonitoring(ctx context.Context, monitorRecor
 	return nil
 }
 
-func (b *BackendSampler) isRunning() bool {
-	b.runningLock.Lock()
-	defer b.runningLock.Unlock()
-	return b.stopRunning != nil
+func (r *sampleRunner) isRunning() bool {
+	r.runningLock.Lock()
+	defer r.runningLock.Unlock()
+	return r.stopRunning != nil
 }
 
-func (b *BackendSampler) setCancelForRun(cancelFunc context.CancelFunc) {
-	b.runningLock.Lock()
-	defer b.runningLock.Unlock()
-	b.stopRunning = cancelFunc
+func (r *sampleRunner) setCancelForRun(cancelFunc context.CancelFunc) {
+	r.runningLock.Lock()
+	defer r.runningLock.Unlock()
+	r.stopRunning = cancelFunc
 }
 
-func (b *BackendSampler) Stop() {
-	b.runningLock.Lock()
-	defer b.runningLock.Unlock()
-	if b.stopRunning != nil {
-		b.stopRunning()
+func (r *sampleRunner) Stop() {
+	r.runningLock.Lock()
+	defer r.runningLock.Unlock()
+	if r.stopRunning != nil {
+		r.stopRunning()
 	}
-	b.stopRunning = nil
+	r.stopRunning = nil
 }
 
-// StartEndpointMonitoring sets up a client for the given BackendSampler, starts checking the endpoint, and recording
-// success/failure edges into the monitorRecorder
-func (b *BackendSampler) StartEndpointMonitoring(ctx context.Context, monitorRecorder Recorder, eventRecorder events.EventRecorder) error {
+// start runs the sampler for the backend in the background.
+func (r *sampleRunner) start(ctx context.Context, backend sampledBackend, monitorRecorder Recorder, eventRecorder events.EventRecorder) error {
 	if monitorRecorder == nil {
 		return fmt.Errorf("monitor is required")
 	}
 
 	go func() {
-		err := b.RunEndpointMonitoring(ctx, monitorRecorder, eventRecorder)
+		err := r.run(ctx, backend, monitorRecorder, eventRecorder)
 		if err != nil {
 			utilruntime.HandleError(err)
 		}
@@ -463,13 +536,13 @@ func (b *BackendSampler) StartEndpointMonitoring(ctx context.Context, monitorRec
 }
 
 type disruptionSampler struct {
-	backendSampler *BackendSampler
+	backendSampler sampledBackend
 
 	lock           sync.Mutex
 	activeSamplers list.List
 }
 
-func newDisruptionSampler(backendSampler *BackendSampler) *disruptionSampler {
+func newDisruptionSampler(backendSampler sampledBackend) *disruptionSampler {
 	return &disruptionSampler{
 		backendSampler: backendSampler,
 		lock:           sync.Mutex{},
@@ -488,8 +561,16 @@ func (b *disruptionSampler) produceSamples(ctx context.Context, interval time.Du
 		// was actually 30s before.
 		currDisruptionSample := b.newSample(ctx)
 		go func() {
-			uid, sampleErr := b.backendSampler.CheckConnection(ctx)
+			var uid string
+			var phases *requestPhases
+			var sampleErr error
+			if phased, ok := b.backendSampler.(phasedBackend); ok {
+				uid, phases, sampleErr = phased.checkConnectionPhases(ctx)
+			} else {
+				uid, sampleErr = b.backendSampler.CheckConnection(ctx)
+			}
 			currDisruptionSample.setSampleError(sampleErr)
+			currDisruptionSample.setPhases(phases)
 			if sampleErr != nil {
 				// We'd like to include these UUIDs in the backend-disruption.json file but this is
 				// not possible without some work as we're basing everything off intervals today. There is
@@ -498,9 +579,10 @@ func (b *disruptionSampler) produceSamples(ctx context.Context, interval time.Du
 				// For now we will just log clearly the requests that failed and use this to correlate with the
 				// audit log manually.
 				logrus.WithFields(logrus.Fields{
-					"backend": b.backendSampler.disruptionBackendName,
-					"type":    b.backendSampler.connectionType,
+					"backend": b.backendSampler.GetDisruptionBackendName(),
+					"type":    b.backendSampler.GetConnectionType(),
 					"auditID": uid,
+					"phases":  phases.String(),
 				}).Errorf("disruption sample failed: %v", sampleErr)
 			}
 			close(currDisruptionSample.finished)
@@ -520,6 +602,7 @@ func (b *disruptionSampler) consumeSamples(ctx context.Context, interval time.Du
 	previousError := fmt.Errorf("never checked before")
 	previousIntervalID := -1
 	var previousSampleTime *time.Time
+	phaseIntervals := newPhaseIntervals(b.backendSampler)
 
 	// when we exit this function, we want to set a final duration of failure.  We don't actually know whether it ended
 	// or how long it took to ask
@@ -527,6 +610,9 @@ func (b *disruptionSampler) consumeSamples(ctx context.Context, interval time.Du
 		if previousIntervalID != -1 && previousSampleTime != nil {
 			monitorRecorder.EndInterval(previousIntervalID, previousSampleTime.Add(interval))
 		}
+		if previousSampleTime != nil {
+			phaseIntervals.end(previousSampleTime.Add(interval), monitorRecorder)
+		}
 	}()
 
 	for {
@@ -628,6 +714,8 @@ func (b *disruptionSampler) consumeSamples(ctx context.Context, interval time.Du
 			panic("math broke resulting in this weird error you need to find")
 		}
 
+		phaseIntervals.observe(currSample, monitorRecorder)
+
 		firstSample = false
 		previousError = currentError
 		t := currSampleTime // make sure we get a copy
@@ -666,6 +754,8 @@ type disruptionSample struct {
 	lock      sync.Mutex
 	startTime time.Time
 	sampleErr error
+	// phases is the timing of the phases of the request, for backends that time them
+	phases *requestPhases
 
 	finished chan struct{}
 }
@@ -687,3 +777,14 @@ func (s *disruptionSample) getSampleError() error {
 	defer s.lock.Unlock()
 	return s.sampleErr
 }
+
+func (s *disruptionSample) setPhases(phases *requestPhases) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.phases = phases
+}
+func (s *disruptionSample) getPhases() *requestPhases {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.phases
+}
@@ -354,3 +354,16 @@ func Test_disruptionSampler_consumeSamples(t *testing.T) {
 		})
 	}
 }
+
+func TestDisableBackends(t *testing.T) {
+	defer func() { disabledBackends = map[string]bool{} }()
+
+	DisableBackends("disabled")
+	// a recorder is required to start, so only disabled backends start without one
+	if err := NewSimpleBackend("host", "disabled", "/", monitorapi.NewConnectionType).StartEndpointMonitoring(context.Background(), nil, nil); err != nil {
+		t.Errorf("expected the disabled backend not to start, got %v", err)
+	}
+	if err := NewSimpleBackend("host", "enabled", "/", monitorapi.NewConnectionType).StartEndpointMonitoring(context.Background(), nil, nil); err == nil {
+		t.Errorf("expected the enabled backend to start")
+	}
+}
@@ -0,0 +1,184 @@
+package backenddisruption
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/tools/events"
+
+	"github.com/openshift/origin/pkg/monitor/monitorapi"
+)
+
+// GRPCHealthBackendSampler is used to monitor a gRPC server with the standard health checking protocol and ensure
+// that it is always serving.  With new connections every sample dials the server, with reused connections every
+// sample is sent over the same client connection.
+type GRPCHealthBackendSampler struct {
+	// locator is the string used to identify this in the monitorRecorder later on.
+	locator string
+	// disruptionBackendName is a shortname for humans to recognize the endpoint being connected to
+	disruptionBackendName string
+	// connectionType indicates what type of connection is being used.
+	connectionType monitorapi.BackendConnectionType
+
+	// hostGetter returns the host:port of the gRPC server
+	hostGetter HostGetter
+	// service is the name of the service to check, empty checks the server as a whole
+	service string
+	// timeout is how long a health check may take.
+	timeout time.Duration
+	// tlsConfig, if set, is used to connect to the server.  Otherwise the connection is not encrypted.
+	tlsConfig *tls.Config
+
+	// connLock guards conn
+	connLock sync.Mutex
+	// conn is the reused connection, if one is open
+	conn *grpc.ClientConn
+
+	sampleRunner
+}
+
+// NewGRPCHealthBackend constructs a GRPCHealthBackendSampler for the gRPC server at the host:port returned by
+// hostGetter.  An empty service checks the health of the server as a whole.
+func NewGRPCHealthBackend(hostGetter HostGetter, disruptionBackendName, service string, connectionType monitorapi.BackendConnectionType) *GRPCHealthBackendSampler {
+	return &GRPCHealthBackendSampler{
+		locator:               monitorapi.LocateDisruptionCheck(disruptionBackendName, connectionType),
+		disruptionBackendName: disruptionBackendName,
+		connectionType:        connectionType,
+		hostGetter:            hostGetter,
+		service:               service,
+		timeout:               defaultSampleTimeout,
+	}
+}
+
+// WithTLSConfig sets both the CA bundle for trusting the server and the client cert/key pair for identifying to the server
+func (b *GRPCHealthBackendSampler) WithTLSConfig(tlsConfig *tls.Config) *GRPCHealthBackendSampler {
+	b.tlsConfig = tlsConfig
+	return b
+}
+
+// WithTimeout sets how long a health check may take.
+func (b *GRPCHealthBackendSampler) WithTimeout(timeout time.Duration) *GRPCHealthBackendSampler {
+	b.timeout = timeout
+	return b
+}
+
+func (b *GRPCHealthBackendSampler) GetDisruptionBackendName() string {
+	return b.disruptionBackendName
+}
+
+func (b *GRPCHealthBackendSampler) GetLocator() string {
+	return b.locator
+}
+
+func (b *GRPCHealthBackendSampler) GetConnectionType() monitorapi.BackendConnectionType {
+	return b.connectionType
+}
+
+func (b *GRPCHealthBackendSampler) getTimeout() time.Duration {
+	return b.timeout
+}
+
+func (b *GRPCHealthBackendSampler) dial(ctx context.Context) (*grpc.ClientConn, error) {
+	host, err := b.hostGetter.GetHost()
+	if err != nil {
+		return nil, err
+	}
+	if len(host) == 0 {
+		return nil, fmt.Errorf("missing host")
+	}
+	transportCredentials := insecure.NewCredentials()
+	if b.tlsConfig != nil {
+		transportCredentials = credentials.NewTLS(b.tlsConfig)
+	}
+	return grpc.DialContext(ctx, host, grpc.WithTransportCredentials(transportCredentials))
+}
+
+// getConn returns the connection to check over and a function to release it when the check is done.
+func (b *GRPCHealthBackendSampler) getConn(ctx context.Context) (*grpc.ClientConn, func(), error) {
+	switch b.connectionType {
+	case monitorapi.NewConnectionType:
+		conn, err := b.dial(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+		return conn, func() { conn.Close() }, nil
+
+	case monitorapi.ReusedConnectionType:
+		b.connLock.Lock()
+		defer b.connLock.Unlock()
+		if b.conn == nil {
+			// the reused connection must outlive the context of the sample
+			conn, err := b.dial(context.Background())
+			if err != nil {
+				return nil, nil, err
+			}
+			b.conn = conn
+		}
+		return b.conn, func() {}, nil
+
+	default:
+		return nil, nil, fmt.Errorf("unrecognized connection type")
+	}
+}
+
+// CheckConnection returns an error if the health check failed or the server is not serving.
+func (b *GRPCHealthBackendSampler) CheckConnection(ctx context.Context) (string, error) {
+	conn, release, err := b.getConn(ctx)
+	if err != nil {
+		return "", err
+	}
+	defer release()
+
+	checkContext, checkCancel := context.WithTimeout(ctx, b.timeout)
+	defer checkCancel()
+	resp, err := healthpb.NewHealthClient(conn).Check(checkContext, &healthpb.HealthCheckRequest{Service: b.service})
+	if ctx.Err() == context.Canceled {
+		// this isn't an error, we were simply cancelled
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	if resp.Status != healthpb.HealthCheckResponse_SERVING {
+		return "", fmt.Errorf("health check returned %v", resp.Status)
+	}
+	return "", nil
+}
+
+// RunEndpointMonitoring starts checking the gRPC server and recording success/failure edges into the
+// monitorRecorder, and blocks until the context is closed or the sampler is stopped.
+func (b *GRPCHealthBackendSampler) RunEndpointMonitoring(ctx context.Context, monitorRecorder Recorder, eventRecorder events.EventRecorder) error {
+	defer b.closeConn()
+	return b.sampleRunner.run(ctx, b, monitorRecorder, eventRecorder)
+}
+
+// StartEndpointMonitoring starts checking the gRPC server and recording success/failure edges into the
+// monitorRecorder
+func (b *GRPCHealthBackendSampler) StartEndpointMonitoring(ctx context.Context, monitorRecorder Recorder, eventRecorder events.EventRecorder) error {
+	if monitorRecorder == nil {
+		return fmt.Errorf("monitor is required")
+	}
+	go func() {
+		if err := b.RunEndpointMonitoring(ctx, monitorRecorder, eventRecorder); err != nil {
+			utilruntime.HandleError(err)
+		}
+	}()
+	return nil
+}
+
+func (b *GRPCHealthBackendSampler) closeConn() {
+	b.connLock.Lock()
+	defer b.connLock.Unlock()
+	if b.conn != nil {
+		b.conn.Close()
+		b.conn = nil
+	}
+}
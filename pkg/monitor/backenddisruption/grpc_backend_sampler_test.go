@@ -0,0 +1,50 @@
+package backenddisruption
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	"github.com/openshift/origin/pkg/monitor/monitorapi"
+)
+
+func TestGRPCHealthBackendSampler_CheckConnection(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := grpc.NewServer()
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(server, healthServer)
+	go server.Serve(listener)
+	defer server.Stop()
+
+	for _, connectionType := range []monitorapi.BackendConnectionType{monitorapi.NewConnectionType, monitorapi.ReusedConnectionType} {
+		t.Run(string(connectionType), func(t *testing.T) {
+			healthServer.SetServingStatus("echo", healthpb.HealthCheckResponse_SERVING)
+			sampler := NewGRPCHealthBackend(NewSimpleHostGetter(listener.Addr().String()), "grpc-echo", "echo", connectionType).WithTimeout(2 * time.Second)
+			defer sampler.closeConn()
+
+			for i := 0; i < 2; i++ {
+				if _, err := sampler.CheckConnection(context.Background()); err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+			}
+
+			healthServer.SetServingStatus("echo", healthpb.HealthCheckResponse_NOT_SERVING)
+			if _, err := sampler.CheckConnection(context.Background()); err == nil {
+				t.Error("expected an error when the service is not serving")
+			}
+		})
+	}
+
+	unknown := NewGRPCHealthBackend(NewSimpleHostGetter(listener.Addr().String()), "grpc-echo", "missing", monitorapi.NewConnectionType).WithTimeout(2 * time.Second)
+	if _, err := unknown.CheckConnection(context.Background()); err == nil {
+		t.Error("expected an error for an unknown service")
+	}
+}
@@ -0,0 +1,201 @@
+package backenddisruption
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net/http/httptrace"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/openshift/origin/pkg/monitor/monitorapi"
+)
+
+// RequestPhase is a part of an HTTP request that is timed separately, so a slow or failing phase can be
+// told apart from the others. A router outage and a flaky cloud DNS both make requests fail, but only
+// the latter makes the DNS phase fail.
+type RequestPhase string
+
+const (
+	// DNSPhase resolves the host of the backend.
+	DNSPhase RequestPhase = "dns"
+	// ConnectPhase opens the TCP connection.
+	ConnectPhase RequestPhase = "connect"
+	// TLSPhase performs the TLS handshake.
+	TLSPhase RequestPhase = "tls"
+	// HTTPPhase lasts from the request being written until the first byte of the response, and any failure
+	// after the connection is established, including an unexpected response, is attributed to it.
+	HTTPPhase RequestPhase = "http"
+
+	DisruptionPhaseDegradedEventReason = "DisruptionPhaseDegraded"
+)
+
+// requestPhaseOrder is the order in which the phases of a request happen.
+var requestPhaseOrder = []RequestPhase{DNSPhase, ConnectPhase, TLSPhase, HTTPPhase}
+
+// requestPhaseThresholds is how long a phase may take before it is considered degraded.
+var requestPhaseThresholds = map[RequestPhase]time.Duration{
+	DNSPhase:     time.Second,
+	ConnectPhase: time.Second,
+	TLSPhase:     time.Second,
+	HTTPPhase:    2 * time.Second,
+}
+
+// requestPhases times the phases of a single request. Reused connections skip the phases before
+// HTTPPhase. The trace callbacks may be called concurrently.
+type requestPhases struct {
+	lock     sync.Mutex
+	started  map[RequestPhase]time.Time
+	finished map[RequestPhase]time.Duration
+	// failed is the phase the request failed in, if it failed
+	failed RequestPhase
+}
+
+func newRequestPhases() *requestPhases {
+	return &requestPhases{
+		started:  map[RequestPhase]time.Time{},
+		finished: map[RequestPhase]time.Duration{},
+	}
+}
+
+func (p *requestPhases) start(phase RequestPhase) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if _, ok := p.started[phase]; !ok {
+		p.started[phase] = time.Now()
+	}
+}
+
+func (p *requestPhases) finish(phase RequestPhase) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if start, ok := p.started[phase]; ok {
+		p.finished[phase] = time.Since(start)
+	}
+}
+
+// trace returns the hooks that time the phases of the request.
+func (p *requestPhases) trace() *httptrace.ClientTrace {
+	return &httptrace.ClientTrace{
+		DNSStart: func(httptrace.DNSStartInfo) { p.start(DNSPhase) },
+		DNSDone: func(info httptrace.DNSDoneInfo) {
+			if info.Err == nil {
+				p.finish(DNSPhase)
+			}
+		},
+		// with several addresses, connections are attempted until one succeeds
+		ConnectStart: func(string, string) { p.start(ConnectPhase) },
+		ConnectDone: func(_, _ string, err error) {
+			if err == nil {
+				p.finish(ConnectPhase)
+			}
+		},
+		TLSHandshakeStart: func() { p.start(TLSPhase) },
+		TLSHandshakeDone: func(_ tls.ConnectionState, err error) {
+			if err == nil {
+				p.finish(TLSPhase)
+			}
+		},
+		WroteRequest:         func(httptrace.WroteRequestInfo) { p.start(HTTPPhase) },
+		GotFirstResponseByte: func() { p.finish(HTTPPhase) },
+	}
+}
+
+// setError attributes the error of the request to the first phase that started and did not finish, or
+// to HTTPPhase if every phase that started finished.
+func (p *requestPhases) setError(err error) {
+	if err == nil {
+		return
+	}
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.failed = HTTPPhase
+	for _, phase := range requestPhaseOrder {
+		_, started := p.started[phase]
+		_, finished := p.finished[phase]
+		if started && !finished {
+			p.failed = phase
+			return
+		}
+	}
+}
+
+// degraded returns a description of every phase of the request that failed or took longer than its
+// threshold, by phase.
+func (p *requestPhases) degraded() map[RequestPhase]string {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	degraded := map[RequestPhase]string{}
+	for _, phase := range requestPhaseOrder {
+		if phase == p.failed {
+			degraded[phase] = "failed"
+			continue
+		}
+		if duration, ok := p.finished[phase]; ok && duration > requestPhaseThresholds[phase] {
+			degraded[phase] = fmt.Sprintf("took %s, longer than %s", duration.Round(time.Millisecond), requestPhaseThresholds[phase])
+		}
+	}
+	return degraded
+}
+
+// String lists the duration of every phase that finished and the phase that failed, for logging.
+func (p *requestPhases) String() string {
+	if p == nil {
+		return ""
+	}
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	var parts []string
+	for _, phase := range requestPhaseOrder {
+		if duration, ok := p.finished[phase]; ok {
+			parts = append(parts, fmt.Sprintf("%s/%s", phase, duration.Round(time.Millisecond)))
+		}
+	}
+	if len(p.failed) > 0 {
+		parts = append(parts, fmt.Sprintf("failed/%s", p.failed))
+	}
+	return strings.Join(parts, " ")
+}
+
+// phaseIntervals records an interval for every phase of the requests to a backend while it is degraded.
+type phaseIntervals struct {
+	backend sampledBackend
+	open    map[RequestPhase]int
+}
+
+func newPhaseIntervals(backend sampledBackend) *phaseIntervals {
+	return &phaseIntervals{backend: backend, open: map[RequestPhase]int{}}
+}
+
+// observe starts an interval for every phase of the sample that became degraded and ends the interval
+// of every phase that recovered. Samples without timed phases are ignored.
+func (i *phaseIntervals) observe(sample *disruptionSample, monitorRecorder Recorder) {
+	phases := sample.getPhases()
+	if phases == nil {
+		return
+	}
+	degraded := phases.degraded()
+	for _, phase := range requestPhaseOrder {
+		description, isDegraded := degraded[phase]
+		intervalID, isOpen := i.open[phase]
+		switch {
+		case isDegraded && !isOpen:
+			i.open[phase] = monitorRecorder.StartInterval(sample.startTime, monitorapi.Condition{
+				Level:   monitorapi.Warning,
+				Locator: monitorapi.LocateDisruptionPhase(i.backend.GetDisruptionBackendName(), i.backend.GetConnectionType(), string(phase)),
+				Message: fmt.Sprintf("reason/%s phase/%s %s", DisruptionPhaseDegradedEventReason, phase, description),
+			})
+		case !isDegraded && isOpen:
+			monitorRecorder.EndInterval(intervalID, sample.startTime)
+			delete(i.open, phase)
+		}
+	}
+}
+
+// end ends the intervals of the phases that are still degraded.
+func (i *phaseIntervals) end(t time.Time, monitorRecorder Recorder) {
+	for phase, intervalID := range i.open {
+		monitorRecorder.EndInterval(intervalID, t)
+		delete(i.open, phase)
+	}
+}
@@ -0,0 +1,97 @@
+package backenddisruption
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/openshift/origin/pkg/monitor/monitorapi"
+)
+
+func TestRequestPhases_checkConnection(t *testing.T) {
+	testServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer testServer.Close()
+
+	backend := NewSimpleBackend(testServer.URL, "backend", "/", monitorapi.NewConnectionType)
+	_, phases, err := backend.checkConnectionPhases(context.Background())
+	if err == nil {
+		t.Fatal("expected the request to fail")
+	}
+	for _, phase := range []RequestPhase{ConnectPhase, TLSPhase, HTTPPhase} {
+		if _, ok := phases.finished[phase]; !ok {
+			t.Errorf("expected phase %s to be timed, got %s", phase, phases)
+		}
+	}
+	if phases.failed != HTTPPhase {
+		t.Errorf("expected the unexpected response to fail the http phase, got %q", phases.failed)
+	}
+}
+
+func TestRequestPhases_setError(t *testing.T) {
+	now := time.Now()
+	phases := &requestPhases{
+		started:  map[RequestPhase]time.Time{DNSPhase: now, ConnectPhase: now, TLSPhase: now},
+		finished: map[RequestPhase]time.Duration{DNSPhase: 1500 * time.Millisecond, ConnectPhase: 10 * time.Millisecond},
+	}
+	phases.setError(fmt.Errorf("tls: handshake timeout"))
+
+	want := map[RequestPhase]string{
+		DNSPhase: "took 1.5s, longer than 1s",
+		TLSPhase: "failed",
+	}
+	if got := phases.degraded(); !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+	if got, want := phases.String(), "dns/1.5s connect/10ms failed/tls"; got != want {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+}
+
+func TestPhaseIntervals(t *testing.T) {
+	now := time.Now()
+	sample := func(offset time.Duration, dns time.Duration) *disruptionSample {
+		s := newDisruptionSample(now.Add(offset))
+		s.setPhases(&requestPhases{
+			started:  map[RequestPhase]time.Time{DNSPhase: now, HTTPPhase: now},
+			finished: map[RequestPhase]time.Duration{DNSPhase: dns, HTTPPhase: 10 * time.Millisecond},
+		})
+		return s
+	}
+
+	monitor := newSimpleMonitor()
+	phaseIntervals := newPhaseIntervals(NewSimpleBackend("host", "ingress-to-console", "/", monitorapi.NewConnectionType))
+	phaseIntervals.observe(sample(0, 10*time.Millisecond), monitor)
+	phaseIntervals.observe(sample(time.Second, 3*time.Second), monitor)
+	phaseIntervals.observe(sample(2*time.Second, 4*time.Second), monitor)
+	// samples of backends that do not time their phases are ignored
+	phaseIntervals.observe(newDisruptionSample(now.Add(3*time.Second)), monitor)
+	phaseIntervals.observe(sample(4*time.Second, 10*time.Millisecond), monitor)
+	phaseIntervals.observe(sample(5*time.Second, 2*time.Second), monitor)
+	phaseIntervals.end(now.Add(6*time.Second), monitor)
+
+	intervals := monitor.Intervals(time.Time{}, time.Time{})
+	if len(intervals) != 2 {
+		t.Fatalf("expected two intervals, got %v", intervals)
+	}
+	for i, want := range []struct {
+		from, to time.Duration
+	}{{time.Second, 4 * time.Second}, {5 * time.Second, 6 * time.Second}} {
+		interval := intervals[i]
+		if interval.From.Sub(now) != want.from || interval.To.Sub(now) != want.to {
+			t.Errorf("unexpected bounds of %v", interval)
+		}
+		if interval.Level != monitorapi.Warning || interval.Locator != "disruption-phase/ingress-to-console connection/new phase/dns" {
+			t.Errorf("unexpected interval %v", interval)
+		}
+		if !strings.HasPrefix(interval.Message, "reason/DisruptionPhaseDegraded phase/dns took ") {
+			t.Errorf("unexpected message %q", interval.Message)
+		}
+	}
+}
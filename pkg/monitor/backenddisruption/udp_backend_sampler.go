@@ -0,0 +1,175 @@
+package backenddisruption
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/tools/events"
+
+	"github.com/openshift/origin/pkg/monitor/monitorapi"
+)
+
+// UDPEchoBackendSampler is used to monitor a UDP echo service and ensure that it is always accessible.  Every sample
+// sends a unique payload and expects the same payload back.  With new connections every sample is sent from a new
+// socket, with reused connections every sample is sent from the same socket until it fails, so the samples follow
+// the same conntrack entry through the dataplane.
+type UDPEchoBackendSampler struct {
+	// locator is the string used to identify this in the monitorRecorder later on.
+	locator string
+	// disruptionBackendName is a shortname for humans to recognize the endpoint being connected to
+	disruptionBackendName string
+	// connectionType indicates what type of connection is being used.
+	connectionType monitorapi.BackendConnectionType
+
+	// hostGetter returns the host:port of the echo service
+	hostGetter HostGetter
+	// timeout is how long a sample waits for the echo.
+	timeout time.Duration
+
+	// connLock serializes samples over the reused connection
+	connLock sync.Mutex
+	// conn is the reused connection, if one is open
+	conn net.Conn
+
+	sampleRunner
+}
+
+// NewUDPEchoBackend constructs a UDPEchoBackendSampler for the echo service at the host:port returned by hostGetter.
+func NewUDPEchoBackend(hostGetter HostGetter, disruptionBackendName string, connectionType monitorapi.BackendConnectionType) *UDPEchoBackendSampler {
+	return &UDPEchoBackendSampler{
+		locator:               monitorapi.LocateDisruptionCheck(disruptionBackendName, connectionType),
+		disruptionBackendName: disruptionBackendName,
+		connectionType:        connectionType,
+		hostGetter:            hostGetter,
+		timeout:               defaultSampleTimeout,
+	}
+}
+
+// WithTimeout sets how long a sample waits for the echo.
+func (b *UDPEchoBackendSampler) WithTimeout(timeout time.Duration) *UDPEchoBackendSampler {
+	b.timeout = timeout
+	return b
+}
+
+func (b *UDPEchoBackendSampler) GetDisruptionBackendName() string {
+	return b.disruptionBackendName
+}
+
+func (b *UDPEchoBackendSampler) GetLocator() string {
+	return b.locator
+}
+
+func (b *UDPEchoBackendSampler) GetConnectionType() monitorapi.BackendConnectionType {
+	return b.connectionType
+}
+
+func (b *UDPEchoBackendSampler) getTimeout() time.Duration {
+	return b.timeout
+}
+
+func (b *UDPEchoBackendSampler) dial(ctx context.Context) (net.Conn, error) {
+	host, err := b.hostGetter.GetHost()
+	if err != nil {
+		return nil, err
+	}
+	if len(host) == 0 {
+		return nil, fmt.Errorf("missing host")
+	}
+	return (&net.Dialer{Timeout: b.timeout}).DialContext(ctx, "udp", host)
+}
+
+// CheckConnection returns the payload of the sample and an error if the echo was not received.
+func (b *UDPEchoBackendSampler) CheckConnection(ctx context.Context) (string, error) {
+	uid := uuid.New().String()
+
+	switch b.connectionType {
+	case monitorapi.NewConnectionType:
+		conn, err := b.dial(ctx)
+		if err != nil {
+			return uid, err
+		}
+		defer conn.Close()
+		return uid, b.echo(ctx, conn, uid)
+
+	case monitorapi.ReusedConnectionType:
+		b.connLock.Lock()
+		defer b.connLock.Unlock()
+		if b.conn == nil {
+			conn, err := b.dial(ctx)
+			if err != nil {
+				return uid, err
+			}
+			b.conn = conn
+		}
+		if err := b.echo(ctx, b.conn, uid); err != nil {
+			// the next sample opens a new connection
+			b.conn.Close()
+			b.conn = nil
+			return uid, err
+		}
+		return uid, nil
+
+	default:
+		return uid, fmt.Errorf("unrecognized connection type")
+	}
+}
+
+// echo sends the payload and waits for the same payload, discarding late replies to earlier samples.
+func (b *UDPEchoBackendSampler) echo(ctx context.Context, conn net.Conn, payload string) error {
+	deadline := time.Now().Add(b.timeout)
+	if ctxDeadline, ok := ctx.Deadline(); ok && ctxDeadline.Before(deadline) {
+		deadline = ctxDeadline
+	}
+	if err := conn.SetDeadline(deadline); err != nil {
+		return err
+	}
+	if _, err := conn.Write([]byte(payload)); err != nil {
+		return err
+	}
+	buf := make([]byte, 512)
+	for {
+		n, err := conn.Read(buf)
+		if err != nil {
+			return err
+		}
+		if bytes.Equal(buf[:n], []byte(payload)) {
+			return nil
+		}
+	}
+}
+
+// RunEndpointMonitoring starts checking the echo service and recording success/failure edges into the
+// monitorRecorder, and blocks until the context is closed or the sampler is stopped.
+func (b *UDPEchoBackendSampler) RunEndpointMonitoring(ctx context.Context, monitorRecorder Recorder, eventRecorder events.EventRecorder) error {
+	defer b.closeConn()
+	return b.sampleRunner.run(ctx, b, monitorRecorder, eventRecorder)
+}
+
+// StartEndpointMonitoring starts checking the echo service and recording success/failure edges into the
+// monitorRecorder
+func (b *UDPEchoBackendSampler) StartEndpointMonitoring(ctx context.Context, monitorRecorder Recorder, eventRecorder events.EventRecorder) error {
+	if monitorRecorder == nil {
+		return fmt.Errorf("monitor is required")
+	}
+	go func() {
+		if err := b.RunEndpointMonitoring(ctx, monitorRecorder, eventRecorder); err != nil {
+			utilruntime.HandleError(err)
+		}
+	}()
+	return nil
+}
+
+func (b *UDPEchoBackendSampler) closeConn() {
+	b.connLock.Lock()
+	defer b.connLock.Unlock()
+	if b.conn != nil {
+		b.conn.Close()
+		b.conn = nil
+	}
+}
@@ -0,0 +1,65 @@
+package backenddisruption
+
+import (
+	"context"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/openshift/origin/pkg/monitor/monitorapi"
+)
+
+// startUDPEchoServer echoes every packet until the test ends, or drops them while drop is true.
+func startUDPEchoServer(t *testing.T, drop *atomic.Bool) string {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	go func() {
+		buf := make([]byte, 512)
+		for {
+			n, addr, err := conn.ReadFrom(buf)
+			if err != nil {
+				return
+			}
+			if drop.Load() {
+				continue
+			}
+			conn.WriteTo(buf[:n], addr)
+		}
+	}()
+	return conn.LocalAddr().String()
+}
+
+func TestUDPEchoBackendSampler_CheckConnection(t *testing.T) {
+	for _, connectionType := range []monitorapi.BackendConnectionType{monitorapi.NewConnectionType, monitorapi.ReusedConnectionType} {
+		t.Run(string(connectionType), func(t *testing.T) {
+			drop := &atomic.Bool{}
+			host := startUDPEchoServer(t, drop)
+			sampler := NewUDPEchoBackend(NewSimpleHostGetter(host), "udp-echo", connectionType).WithTimeout(500 * time.Millisecond)
+			defer sampler.closeConn()
+
+			if sampler.GetLocator() != monitorapi.LocateDisruptionCheck("udp-echo", connectionType) {
+				t.Errorf("unexpected locator %s", sampler.GetLocator())
+			}
+			for i := 0; i < 2; i++ {
+				if _, err := sampler.CheckConnection(context.Background()); err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+			}
+			if connectionType == monitorapi.ReusedConnectionType && sampler.conn == nil {
+				t.Error("expected the connection to be reused")
+			}
+
+			drop.Store(true)
+			if _, err := sampler.CheckConnection(context.Background()); err == nil {
+				t.Error("expected an error when the echo is not received")
+			}
+			if sampler.conn != nil {
+				t.Error("expected a failed connection to be closed")
+			}
+		})
+	}
+}
@@ -0,0 +1,179 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/openshift/origin/pkg/monitor/monitorapi"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
+)
+
+// ParseWatchResource parses a resource given as group/version/resource. The group of
+// core resources is left empty, as in /v1/configmaps, or omitted, as in v1/configmaps.
+func ParseWatchResource(s string) (schema.GroupVersionResource, error) {
+	parts := strings.Split(s, "/")
+	switch {
+	case len(parts) == 2 && len(parts[0]) > 0 && len(parts[1]) > 0:
+		return schema.GroupVersionResource{Version: parts[0], Resource: parts[1]}, nil
+	case len(parts) == 3 && len(parts[1]) > 0 && len(parts[2]) > 0:
+		return schema.GroupVersionResource{Group: parts[0], Version: parts[1], Resource: parts[2]}, nil
+	default:
+		return schema.GroupVersionResource{}, fmt.Errorf("%q is not of the form group/version/resource", s)
+	}
+}
+
+// StartCustomResourceMonitoring returns a recorder that watches the given resources,
+// usually custom resources, and records their creation, deletion, and changes of the
+// conditions in their status, the way cluster operators are monitored.
+func StartCustomResourceMonitoring(gvrs []schema.GroupVersionResource) StartEventIntervalRecorderFunc {
+	return func(ctx context.Context, m Recorder, clusterConfig *rest.Config) error {
+		client, err := dynamic.NewForConfig(clusterConfig)
+		if err != nil {
+			return err
+		}
+		for _, gvr := range gvrs {
+			startCustomResourceMonitoring(ctx, m, client, gvr)
+		}
+		return nil
+	}
+}
+
+func startCustomResourceMonitoring(ctx context.Context, m Recorder, client dynamic.Interface, gvr schema.GroupVersionResource) {
+	informer := cache.NewSharedIndexInformer(
+		NewErrorRecordingListWatcher(m, &cache.ListWatch{
+			ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+				return client.Resource(gvr).List(ctx, options)
+			},
+			WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+				return client.Resource(gvr).Watch(ctx, options)
+			},
+		}),
+		&unstructured.Unstructured{},
+		time.Hour,
+		nil,
+	)
+
+	startTime := time.Now().UTC().Add(-time.Minute)
+	informer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc: func(obj interface{}) {
+				u, ok := obj.(*unstructured.Unstructured)
+				if !ok {
+					return
+				}
+				// filter out old objects so our monitor doesn't send a big chunk
+				// of creations
+				if u.GetCreationTimestamp().Time.Before(startTime) {
+					return
+				}
+				m.Record(monitorapi.Condition{
+					Level:   monitorapi.Info,
+					Locator: monitorapi.LocateCustomResource(gvr, u.GetNamespace(), u.GetName()),
+					Message: "created",
+				})
+			},
+			DeleteFunc: func(obj interface{}) {
+				if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+					obj = tombstone.Obj
+				}
+				u, ok := obj.(*unstructured.Unstructured)
+				if !ok {
+					return
+				}
+				m.Record(monitorapi.Condition{
+					Level:   monitorapi.Warning,
+					Locator: monitorapi.LocateCustomResource(gvr, u.GetNamespace(), u.GetName()),
+					Message: "deleted",
+				})
+			},
+			UpdateFunc: func(old, obj interface{}) {
+				u, ok := obj.(*unstructured.Unstructured)
+				if !ok {
+					return
+				}
+				oldU, ok := old.(*unstructured.Unstructured)
+				if !ok {
+					return
+				}
+				if u.GetUID() != oldU.GetUID() {
+					return
+				}
+				m.Record(customResourceConditionChanges(gvr, u, oldU)...)
+			},
+		},
+	)
+
+	go informer.Run(ctx.Done())
+}
+
+// customResourceCondition is the subset of a status condition shared by most resources.
+type customResourceCondition struct {
+	Type, Status, Reason, Message string
+}
+
+// customResourceConditions returns the conditions in the status of the object, ignoring
+// entries that are not objects with a type.
+func customResourceConditions(u *unstructured.Unstructured) []customResourceCondition {
+	items, _, _ := unstructured.NestedSlice(u.Object, "status", "conditions")
+	var conditions []customResourceCondition
+	for _, item := range items {
+		fields, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		var c customResourceCondition
+		c.Type, _, _ = unstructured.NestedString(fields, "type")
+		c.Status, _, _ = unstructured.NestedString(fields, "status")
+		c.Reason, _, _ = unstructured.NestedString(fields, "reason")
+		c.Message, _, _ = unstructured.NestedString(fields, "message")
+		if len(c.Type) == 0 {
+			continue
+		}
+		conditions = append(conditions, c)
+	}
+	return conditions
+}
+
+// customResourceConditionChanges returns a condition for every status condition of the
+// object whose status differs from the previous version of the object.
+func customResourceConditionChanges(gvr schema.GroupVersionResource, u, oldU *unstructured.Unstructured) []monitorapi.Condition {
+	previous := map[string]string{}
+	for _, c := range customResourceConditions(oldU) {
+		previous[c.Type] = c.Status
+	}
+	var conditions []monitorapi.Condition
+	for _, c := range customResourceConditions(u) {
+		if status, ok := previous[c.Type]; ok && status == c.Status {
+			continue
+		}
+		var msg string
+		switch {
+		case len(c.Reason) > 0 && len(c.Message) > 0:
+			msg = fmt.Sprintf("condition/%s status/%s reason/%s changed: %s", c.Type, c.Status, c.Reason, c.Message)
+		case len(c.Message) > 0:
+			msg = fmt.Sprintf("condition/%s status/%s changed: %s", c.Type, c.Status, c.Message)
+		default:
+			msg = fmt.Sprintf("condition/%s status/%s changed: ", c.Type, c.Status)
+		}
+		level := monitorapi.Warning
+		if (c.Type == "Degraded" && c.Status == "True") || (c.Type == "Available" && c.Status == "False") {
+			level = monitorapi.Error
+		}
+		conditions = append(conditions, monitorapi.Condition{
+			Level:   level,
+			Locator: monitorapi.LocateCustomResource(gvr, u.GetNamespace(), u.GetName()),
+			Message: msg,
+		})
+	}
+	return conditions
+}
@@ -0,0 +1,104 @@
+package monitor
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/openshift/origin/pkg/monitor/monitorapi"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestParseWatchResource(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    schema.GroupVersionResource
+		wantErr bool
+	}{
+		{in: "machine.openshift.io/v1beta1/machines", want: schema.GroupVersionResource{Group: "machine.openshift.io", Version: "v1beta1", Resource: "machines"}},
+		{in: "/v1/configmaps", want: schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}},
+		{in: "v1/configmaps", want: schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}},
+		{in: "machines", wantErr: true},
+		{in: "machine.openshift.io//machines", wantErr: true},
+		{in: "a/b/c/d", wantErr: true},
+	}
+	for _, test := range tests {
+		t.Run(test.in, func(t *testing.T) {
+			got, err := ParseWatchResource(test.in)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("expected error %t, got %v", test.wantErr, err)
+			}
+			if got != test.want {
+				t.Errorf("expected %v, got %v", test.want, got)
+			}
+		})
+	}
+}
+
+func withConditions(conditions ...interface{}) *unstructured.Unstructured {
+	u := &unstructured.Unstructured{Object: map[string]interface{}{}}
+	u.SetNamespace("openshift-machine-api")
+	u.SetName("worker-a")
+	if conditions != nil {
+		if err := unstructured.SetNestedSlice(u.Object, conditions, "status", "conditions"); err != nil {
+			panic(err)
+		}
+	}
+	return u
+}
+
+func TestCustomResourceConditionChanges(t *testing.T) {
+	gvr := schema.GroupVersionResource{Group: "machine.openshift.io", Version: "v1beta1", Resource: "machines"}
+	locator := "ns/openshift-machine-api resource/machines.machine.openshift.io name/worker-a"
+	ready := map[string]interface{}{"type": "Ready", "status": "True"}
+	notReady := map[string]interface{}{"type": "Ready", "status": "False", "reason": "Drained", "message": "node is drained"}
+	degraded := map[string]interface{}{"type": "Degraded", "status": "True", "message": "broken"}
+	tests := []struct {
+		name string
+		old  *unstructured.Unstructured
+		new  *unstructured.Unstructured
+		want []monitorapi.Condition
+	}{
+		{
+			name: "no conditions",
+			old:  withConditions(),
+			new:  withConditions(),
+		},
+		{
+			name: "unchanged",
+			old:  withConditions(ready),
+			new:  withConditions(ready),
+		},
+		{
+			name: "new condition",
+			old:  withConditions(),
+			new:  withConditions(ready),
+			want: []monitorapi.Condition{{Level: monitorapi.Warning, Locator: locator, Message: "condition/Ready status/True changed: "}},
+		},
+		{
+			name: "transition",
+			old:  withConditions(ready),
+			new:  withConditions(notReady),
+			want: []monitorapi.Condition{{Level: monitorapi.Warning, Locator: locator, Message: "condition/Ready status/False reason/Drained changed: node is drained"}},
+		},
+		{
+			name: "degraded",
+			old:  withConditions(ready),
+			new:  withConditions(ready, degraded),
+			want: []monitorapi.Condition{{Level: monitorapi.Error, Locator: locator, Message: "condition/Degraded status/True changed: broken"}},
+		},
+		{
+			name: "malformed conditions",
+			old:  withConditions(),
+			new:  withConditions("Ready", map[string]interface{}{"status": "True"}),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := customResourceConditionChanges(gvr, test.new, test.old); !reflect.DeepEqual(got, test.want) {
+				t.Errorf("expected %#v, got %#v", test.want, got)
+			}
+		})
+	}
+}
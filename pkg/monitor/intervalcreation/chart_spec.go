@@ -0,0 +1,212 @@
+package intervalcreation
+
+import (
+	"embed"
+	"fmt"
+	"io/ioutil"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/openshift/origin/pkg/monitor/monitorapi"
+)
+
+// chartSpecPresets are the chart specs shipped with openshift-tests, rendered for every run
+// and selectable by name in openshift-tests timeline --chart-spec.
+//
+//go:embed chartspecs/*.yaml
+var chartSpecPresets embed.FS
+
+// ChartSpec selects the intervals shown in an interval chart and arranges them in groups of
+// rows. The spec is embedded in the rendered chart, so the artifact shows how it was built.
+type ChartSpec struct {
+	// Name names the chart, e2e-timelines_<name>_<time>.html.
+	Name string `json:"name"`
+	// Groups are shown in order. An interval is shown in every group it matches, and intervals
+	// that match no group are left out of the chart.
+	Groups []ChartGroup `json:"groups"`
+}
+
+// ChartGroup selects the intervals of one group of rows. All of the fields that are set must
+// match an interval. Regular expressions must be valid in both Go and JavaScript.
+type ChartGroup struct {
+	Name string `json:"name"`
+	// Source is a locator key, such as disruption, alert, node, or pod, the interval must have.
+	Source string `json:"source,omitempty"`
+	// Locator and ExcludeLocator are regular expressions matched against the locator.
+	Locator        string `json:"locator,omitempty"`
+	ExcludeLocator string `json:"excludeLocator,omitempty"`
+	// Message is a regular expression matched against the message.
+	Message string `json:"message,omitempty"`
+	// Annotations maps keys of the key/value annotations of the message, like reason, to
+	// regular expressions their values must match.
+	Annotations map[string]string `json:"annotations,omitempty"`
+	// Level is Info, Warning, or Error.
+	Level string `json:"level,omitempty"`
+	// RowBy is the locator key whose value labels the rows, so intervals with the same value
+	// share a row. The whole locator labels the rows by default.
+	RowBy string `json:"rowBy,omitempty"`
+	// Order is "time" to order rows by their first interval (the default) or "label".
+	Order string `json:"order,omitempty"`
+}
+
+// ParseChartSpec parses and validates a YAML or JSON chart spec.
+func ParseChartSpec(data []byte) (*ChartSpec, error) {
+	spec := &ChartSpec{}
+	if err := yaml.UnmarshalStrict(data, spec); err != nil {
+		return nil, err
+	}
+	if len(spec.Name) == 0 {
+		return nil, fmt.Errorf("chart spec has no name")
+	}
+	if strings.ContainsAny(spec.Name, "/ ") {
+		return nil, fmt.Errorf("chart spec name %q may not contain slashes or spaces", spec.Name)
+	}
+	if len(spec.Groups) == 0 {
+		return nil, fmt.Errorf("chart spec %s has no groups", spec.Name)
+	}
+	for i, group := range spec.Groups {
+		if len(group.Name) == 0 {
+			return nil, fmt.Errorf("group %d of chart spec %s has no name", i, spec.Name)
+		}
+		if _, err := group.matcher(); err != nil {
+			return nil, fmt.Errorf("group %s of chart spec %s: %v", group.Name, spec.Name, err)
+		}
+		switch group.Order {
+		case "", "time", "label":
+		default:
+			return nil, fmt.Errorf("group %s of chart spec %s: order must be time or label, not %q", group.Name, spec.Name, group.Order)
+		}
+		switch group.Level {
+		case "", monitorapi.Info.String(), monitorapi.Warning.String(), monitorapi.Error.String():
+		default:
+			return nil, fmt.Errorf("group %s of chart spec %s: level must be Info, Warning, or Error, not %q", group.Name, spec.Name, group.Level)
+		}
+	}
+	return spec, nil
+}
+
+// LoadChartSpec returns the preset chart spec with the given name, or else parses the file
+// at the given path.
+func LoadChartSpec(nameOrPath string) (*ChartSpec, error) {
+	if data, err := chartSpecPresets.ReadFile(path.Join("chartspecs", nameOrPath+".yaml")); err == nil {
+		return ParseChartSpec(data)
+	}
+	data, err := ioutil.ReadFile(nameOrPath)
+	if err != nil {
+		return nil, fmt.Errorf("%q is neither a chart spec preset (%s) nor a readable file: %v", nameOrPath, strings.Join(ChartSpecPresetNames(), ", "), err)
+	}
+	return ParseChartSpec(data)
+}
+
+// ChartSpecPresetNames returns the names of the preset chart specs in order.
+func ChartSpecPresetNames() []string {
+	entries, err := chartSpecPresets.ReadDir("chartspecs")
+	if err != nil {
+		panic(err)
+	}
+	var names []string
+	for _, entry := range entries {
+		names = append(names, strings.TrimSuffix(entry.Name(), ".yaml"))
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ChartSpecPresets returns the preset chart specs ordered by name.
+func ChartSpecPresets() []*ChartSpec {
+	var specs []*ChartSpec
+	for _, name := range ChartSpecPresetNames() {
+		spec, err := LoadChartSpec(name)
+		if err != nil {
+			panic(fmt.Sprintf("invalid chart spec preset %s: %v", name, err))
+		}
+		specs = append(specs, spec)
+	}
+	return specs
+}
+
+// Matches returns a function matching the intervals shown in the chart.
+func (s *ChartSpec) Matches() monitorapi.EventIntervalMatchesFunc {
+	var matchers []monitorapi.EventIntervalMatchesFunc
+	for _, group := range s.Groups {
+		matcher, err := group.matcher()
+		if err != nil {
+			// validated by ParseChartSpec
+			panic(err)
+		}
+		matchers = append(matchers, matcher)
+	}
+	return func(eventInterval monitorapi.EventInterval) bool {
+		for _, matches := range matchers {
+			if matches(eventInterval) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+func (g ChartGroup) matcher() (monitorapi.EventIntervalMatchesFunc, error) {
+	compile := func(field, expr string) (*regexp.Regexp, error) {
+		if len(expr) == 0 {
+			return nil, nil
+		}
+		re, err := regexp.Compile(expr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %v", field, err)
+		}
+		return re, nil
+	}
+	locator, err := compile("locator", g.Locator)
+	if err != nil {
+		return nil, err
+	}
+	excludeLocator, err := compile("excludeLocator", g.ExcludeLocator)
+	if err != nil {
+		return nil, err
+	}
+	message, err := compile("message", g.Message)
+	if err != nil {
+		return nil, err
+	}
+	annotations := map[string]*regexp.Regexp{}
+	for key, expr := range g.Annotations {
+		if annotations[key], err = compile("annotation "+key, expr); err != nil {
+			return nil, err
+		}
+	}
+
+	return func(eventInterval monitorapi.EventInterval) bool {
+		if len(g.Source) > 0 {
+			if _, ok := monitorapi.LocatorParts(eventInterval.Locator)[g.Source]; !ok {
+				return false
+			}
+		}
+		if locator != nil && !locator.MatchString(eventInterval.Locator) {
+			return false
+		}
+		if excludeLocator != nil && excludeLocator.MatchString(eventInterval.Locator) {
+			return false
+		}
+		if message != nil && !message.MatchString(eventInterval.Message) {
+			return false
+		}
+		if len(g.Level) > 0 && eventInterval.Level.String() != g.Level {
+			return false
+		}
+		if len(annotations) > 0 {
+			messageAnnotations := monitorapi.AnnotationsFromMessage(eventInterval.Message)
+			for key, re := range annotations {
+				value, ok := messageAnnotations[key]
+				if !ok || (re != nil && !re.MatchString(value)) {
+					return false
+				}
+			}
+		}
+		return true
+	}, nil
+}
@@ -0,0 +1,135 @@
+package intervalcreation
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/openshift/origin/pkg/monitor/monitorapi"
+)
+
+func TestParseChartSpec(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    string
+		wantErr string
+	}{
+		{
+			name: "valid",
+			spec: `
+name: etcd
+groups:
+- name: etcd-pods
+  source: pod
+  locator: ns/openshift-etcd
+  annotations:
+    reason: ^Ready$
+  level: Warning
+  rowBy: pod
+  order: label
+`,
+		},
+		{name: "no name", spec: "groups:\n- name: a\n", wantErr: "has no name"},
+		{name: "name with slash", spec: "name: a/b\ngroups:\n- name: a\n", wantErr: "may not contain"},
+		{name: "no groups", spec: "name: a\n", wantErr: "has no groups"},
+		{name: "unnamed group", spec: "name: a\ngroups:\n- source: pod\n", wantErr: "has no name"},
+		{name: "invalid locator", spec: "name: a\ngroups:\n- name: a\n  locator: '('\n", wantErr: "invalid locator"},
+		{name: "invalid annotation", spec: "name: a\ngroups:\n- name: a\n  annotations:\n    reason: '('\n", wantErr: "invalid annotation reason"},
+		{name: "invalid order", spec: "name: a\ngroups:\n- name: a\n  order: size\n", wantErr: "order must be"},
+		{name: "invalid level", spec: "name: a\ngroups:\n- name: a\n  level: Critical\n", wantErr: "level must be"},
+		{name: "unknown field", spec: "name: a\ngroups:\n- name: a\n  namespace: b\n", wantErr: "unknown field"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := ParseChartSpec([]byte(test.spec))
+			switch {
+			case len(test.wantErr) == 0 && err != nil:
+				t.Fatalf("unexpected error: %v", err)
+			case len(test.wantErr) > 0 && (err == nil || !strings.Contains(err.Error(), test.wantErr)):
+				t.Fatalf("expected an error containing %q, got %v", test.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestChartSpecPresets(t *testing.T) {
+	names := ChartSpecPresetNames()
+	if len(names) == 0 {
+		t.Fatal("expected chart spec presets")
+	}
+	for i, spec := range ChartSpecPresets() {
+		if spec.Name != names[i] {
+			t.Errorf("preset %s.yaml is named %s", names[i], spec.Name)
+		}
+	}
+}
+
+func TestChartSpecMatches(t *testing.T) {
+	spec, err := ParseChartSpec([]byte(`
+name: test
+groups:
+- name: etcd-pods
+  source: pod
+  locator: ns/openshift-etcd( |$)
+  excludeLocator: pod/installer
+  annotations:
+    reason: ^(Ready|NotReady)$
+- name: errors
+  level: Error
+  message: timeout
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name     string
+		interval monitorapi.EventInterval
+		want     bool
+	}{
+		{
+			name:     "matching pod",
+			interval: interval(monitorapi.Info, "ns/openshift-etcd pod/etcd-master-0", "constructed/true reason/NotReady"),
+			want:     true,
+		},
+		{
+			name:     "other namespace",
+			interval: interval(monitorapi.Info, "ns/openshift-etcd-operator pod/etcd-operator-1", "reason/NotReady"),
+		},
+		{
+			name:     "excluded pod",
+			interval: interval(monitorapi.Info, "ns/openshift-etcd pod/installer-3-master-0", "reason/Ready"),
+		},
+		{
+			name:     "other reason",
+			interval: interval(monitorapi.Info, "ns/openshift-etcd pod/etcd-master-0", "reason/Created"),
+		},
+		{
+			name:     "no reason",
+			interval: interval(monitorapi.Info, "ns/openshift-etcd pod/etcd-master-0", "created"),
+		},
+		{
+			name:     "not a pod",
+			interval: interval(monitorapi.Info, "ns/openshift-etcd", "reason/Ready"),
+		},
+		{
+			name:     "error in second group",
+			interval: interval(monitorapi.Error, "disruption/kube-api connection/new", "i/o timeout"),
+			want:     true,
+		},
+		{
+			name:     "warning",
+			interval: interval(monitorapi.Warning, "disruption/kube-api connection/new", "i/o timeout"),
+		},
+	}
+	matches := spec.Matches()
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := matches(test.interval); got != test.want {
+				t.Errorf("expected %t, got %t", test.want, got)
+			}
+		})
+	}
+}
+
+func interval(level monitorapi.EventLevel, locator, message string) monitorapi.EventInterval {
+	return monitorapi.EventInterval{Condition: monitorapi.Condition{Level: level, Locator: locator, Message: message}}
+}
@@ -0,0 +1,83 @@
+package intervalcreation
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/openshift/origin/pkg/monitor/monitorapi"
+)
+
+// IntervalsFromEvents_CustomResourceConditions turns the condition changes recorded for the resources
+// watched with run-monitor --watch-resource into an interval for every status each condition held,
+// as is done for the conditions of cluster operators. A status lasts until the condition changes
+// again, the object is deleted, or the monitor ends. The status a condition held before its first
+// recorded change is unknown, so no interval is created for it.
+func IntervalsFromEvents_CustomResourceConditions(intervals monitorapi.Intervals, _ monitorapi.ResourcesMap, _, end time.Time) monitorapi.Intervals {
+	type conditionKey struct {
+		locator, conditionType string
+	}
+	ret := monitorapi.Intervals{}
+	open := map[conditionKey]monitorapi.EventInterval{}
+	closeInterval := func(key conditionKey, to time.Time) {
+		interval := open[key]
+		interval.To = to
+		ret = append(ret, interval)
+		delete(open, key)
+	}
+
+	for _, event := range intervals {
+		parts := monitorapi.LocatorParts(event.Locator)
+		if len(parts["resource"]) == 0 || len(parts["name"]) == 0 {
+			continue
+		}
+		if event.Message == "deleted" {
+			for key := range open {
+				if key.locator == event.Locator {
+					closeInterval(key, event.From)
+				}
+			}
+			continue
+		}
+		conditionType, message, ok := customResourceConditionFrom(event.Message)
+		if !ok {
+			continue
+		}
+		key := conditionKey{locator: event.Locator, conditionType: conditionType}
+		if _, ok := open[key]; ok {
+			closeInterval(key, event.From)
+		}
+		open[key] = monitorapi.EventInterval{
+			Condition: monitorapi.Condition{
+				Level:   event.Level,
+				Locator: event.Locator,
+				Message: message,
+			},
+			From: event.From,
+		}
+	}
+
+	for key := range open {
+		closeInterval(key, end)
+	}
+	sort.Sort(ret)
+	return ret
+}
+
+// customResourceConditionFrom returns the type of the condition whose change is recorded in message,
+// as in "condition/Ready status/False reason/Failed changed: the pod crashed", and the message of
+// the interval of its new status, or false if message records no condition change.
+func customResourceConditionFrom(message string) (string, string, bool) {
+	status, details, found := strings.Cut(message, " changed: ")
+	if !found {
+		status = strings.TrimSuffix(message, " changed:")
+	}
+	annotations := monitorapi.AnnotationsFromMessage(status)
+	if len(annotations["condition"]) == 0 || len(annotations["status"]) == 0 || !strings.HasPrefix(status, "condition/") {
+		return "", "", false
+	}
+	if len(details) > 0 {
+		status += ": " + details
+	}
+	return annotations["condition"], status, true
+}
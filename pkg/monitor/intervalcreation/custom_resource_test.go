@@ -0,0 +1,54 @@
+package intervalcreation
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/davecgh/go-spew/spew"
+
+	"github.com/openshift/origin/pkg/monitor/monitorapi"
+)
+
+func TestIntervalsFromEvents_CustomResourceConditions(t *testing.T) {
+	event := func(level monitorapi.EventLevel, locator, message, at string) monitorapi.EventInterval {
+		return monitorapi.EventInterval{
+			Condition: monitorapi.Condition{Level: level, Locator: locator, Message: message},
+			From:      timeFor(at),
+		}
+	}
+	machine := "ns/openshift-machine-api resource/machines.machine.openshift.io name/worker-1"
+	pool := "resource/machineconfigpools.machineconfiguration.openshift.io name/worker"
+	intervals := monitorapi.Intervals{
+		event(monitorapi.Info, machine, "created", "2021-03-29T15:55:00Z"),
+		event(monitorapi.Error, machine, "condition/Available status/False reason/Provisioning changed: waiting for the instance", "2021-03-29T15:56:00Z"),
+		event(monitorapi.Warning, pool, "condition/Updating status/True changed: ", "2021-03-29T15:56:05Z"),
+		event(monitorapi.Warning, machine, "condition/Available status/True changed: ", "2021-03-29T15:56:10Z"),
+		event(monitorapi.Warning, machine, "deleted", "2021-03-29T15:57:00Z"),
+		// not the condition change of a watched resource
+		event(monitorapi.Warning, "clusteroperator/network", "condition/Progressing status/True changed: ", "2021-03-29T15:56:00Z"),
+		event(monitorapi.Warning, "ns/openshift-foo resource/deployments name/foo", "reason/PodSecurityViolation user/admin would violate", "2021-03-29T15:56:00Z"),
+	}
+
+	actual := IntervalsFromEvents_CustomResourceConditions(intervals, nil, timeFor("2021-03-29T15:50:00Z"), timeFor("2021-03-29T16:00:00Z"))
+	expected := monitorapi.Intervals{
+		{
+			Condition: monitorapi.Condition{Level: monitorapi.Error, Locator: machine, Message: "condition/Available status/False reason/Provisioning: waiting for the instance"},
+			From:      timeFor("2021-03-29T15:56:00Z"),
+			To:        timeFor("2021-03-29T15:56:10Z"),
+		},
+		{
+			Condition: monitorapi.Condition{Level: monitorapi.Warning, Locator: pool, Message: "condition/Updating status/True"},
+			From:      timeFor("2021-03-29T15:56:05Z"),
+			To:        timeFor("2021-03-29T16:00:00Z"),
+		},
+		{
+			// the deletion ends the last status
+			Condition: monitorapi.Condition{Level: monitorapi.Warning, Locator: machine, Message: "condition/Available status/True"},
+			From:      timeFor("2021-03-29T15:56:10Z"),
+			To:        timeFor("2021-03-29T15:57:00Z"),
+		},
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatal(spew.Sdump(actual))
+	}
+}
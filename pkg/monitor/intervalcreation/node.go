@@ -174,10 +174,45 @@ func IntervalsFromAuditLogs(ctx context.Context, kubeClient kubernetes.Interface
 		// TODO report the error AND the best possible summary we have
 		return auditLogSummary, nil, err
 	}
+	ret = append(ret, podSecurityViolationIntervals(auditLogSummary.PodSecurityViolations(), beginning, end)...)
 
 	return auditLogSummary, ret, nil
 }
 
+// podSecurityViolationIntervals returns one interval per workload and violation, from the first to
+// the last violating request between beginning and end. Audit logs reach back before the run, so
+// older violations are dropped.
+func podSecurityViolationIntervals(violations []nodedetails.PodSecurityViolation, beginning, end time.Time) monitorapi.Intervals {
+	ret := monitorapi.Intervals{}
+	byCondition := map[monitorapi.Condition]int{}
+	for _, violation := range violations {
+		if violation.Time.Before(beginning) || violation.Time.After(end) {
+			continue
+		}
+		condition := monitorapi.Condition{
+			Level:   monitorapi.Warning,
+			Locator: monitorapi.LocatePodSecurityViolation(violation.Namespace, violation.Resource, violation.Name),
+			Message: monitorapi.ReasonedMessagef(monitorapi.PodSecurityReasonViolation, "user/%s %s", violation.User, violation.Violation),
+		}
+		if i, ok := byCondition[condition]; ok {
+			if violation.Time.Before(ret[i].From) {
+				ret[i].From = violation.Time
+			}
+			if violation.Time.After(ret[i].To) {
+				ret[i].To = violation.Time
+			}
+			continue
+		}
+		byCondition[condition] = len(ret)
+		ret = append(ret, monitorapi.EventInterval{
+			Condition: condition,
+			From:      violation.Time,
+			To:        violation.Time,
+		})
+	}
+	return ret
+}
+
 // eventsFromKubeletLogs returns the produced intervals.  Any errors during this creation are logged, but
 // not returned because this is a best effort step
 func eventsFromKubeletLogs(nodeName string, kubeletLog []byte) monitorapi.Intervals {
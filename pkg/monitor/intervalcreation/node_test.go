@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/openshift/origin/pkg/monitor/monitorapi"
+	"github.com/openshift/origin/pkg/monitor/nodedetails"
 	"github.com/stretchr/testify/assert"
 
 	monitorserialization "github.com/openshift/origin/pkg/monitor/serialization"
@@ -372,3 +373,49 @@ func Test_readinessFailure(t *testing.T) {
 		})
 	}
 }
+
+func TestPodSecurityViolationIntervals(t *testing.T) {
+	beginning := time.Date(2023, 4, 1, 10, 0, 0, 0, time.UTC)
+	end := beginning.Add(time.Hour)
+	violation := func(name, user string, at time.Time) nodedetails.PodSecurityViolation {
+		return nodedetails.PodSecurityViolation{
+			Namespace: "openshift-foo",
+			Resource:  "deployments",
+			Name:      name,
+			User:      user,
+			Violation: `would violate PodSecurity "restricted:latest": runAsNonRoot != true`,
+			Time:      at,
+		}
+	}
+
+	got := podSecurityViolationIntervals([]nodedetails.PodSecurityViolation{
+		violation("before", "admin", beginning.Add(-time.Minute)),
+		violation("foo", "admin", beginning.Add(20*time.Minute)),
+		violation("foo", "admin", beginning.Add(10*time.Minute)),
+		violation("foo", "operator", beginning.Add(30*time.Minute)),
+		violation("foo", "admin", beginning.Add(40*time.Minute)),
+		violation("after", "admin", end.Add(time.Minute)),
+	}, beginning, end)
+
+	want := monitorapi.Intervals{
+		{
+			Condition: monitorapi.Condition{
+				Level:   monitorapi.Warning,
+				Locator: "ns/openshift-foo resource/deployments name/foo",
+				Message: `reason/PodSecurityViolation user/admin would violate PodSecurity "restricted:latest": runAsNonRoot != true`,
+			},
+			From: beginning.Add(10 * time.Minute),
+			To:   beginning.Add(40 * time.Minute),
+		},
+		{
+			Condition: monitorapi.Condition{
+				Level:   monitorapi.Warning,
+				Locator: "ns/openshift-foo resource/deployments name/foo",
+				Message: `reason/PodSecurityViolation user/operator would violate PodSecurity "restricted:latest": runAsNonRoot != true`,
+			},
+			From: beginning.Add(30 * time.Minute),
+			To:   beginning.Add(30 * time.Minute),
+		},
+	}
+	assert.Equal(t, want, got)
+}
@@ -2,6 +2,7 @@ package intervalcreation
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"path/filepath"
@@ -21,6 +22,7 @@ type eventIntervalRenderer struct {
 	name           string
 	filenameBaseFn filenameBaseFunc
 	filter         monitorapi.EventIntervalMatchesFunc
+	chartSpec      *ChartSpec
 }
 
 func NewSpyglassEventIntervalRenderer(name string, filter monitorapi.EventIntervalMatchesFunc) eventIntervalRenderer {
@@ -43,6 +45,19 @@ func NewNonSpyglassEventIntervalRenderer(name string, filter monitorapi.EventInt
 	}
 }
 
+// NewChartSpecEventIntervalRenderer renders the intervals selected by the chart spec, grouped
+// into the rows it describes.
+func NewChartSpecEventIntervalRenderer(spec *ChartSpec) eventIntervalRenderer {
+	return eventIntervalRenderer{
+		name: spec.Name,
+		filenameBaseFn: func(timeSuffix string) string {
+			return fmt.Sprintf("e2e-timelines_%s%s", spec.Name, timeSuffix)
+		},
+		filter:    spec.Matches(),
+		chartSpec: spec,
+	}
+}
+
 func (r eventIntervalRenderer) WriteRunData(artifactDir string, _ monitorapi.ResourcesMap, events monitorapi.Intervals, timeSuffix string) error {
 	filenameBase := r.filenameBaseFn(timeSuffix)
 	return r.writeEventData(artifactDir, filenameBase, events, timeSuffix)
@@ -68,8 +83,11 @@ func (r eventIntervalRenderer) writeEventData(artifactDir, filenameBase string,
 		e2eChartTemplate = testdata.MustAsset("e2echart/non-spyglass-e2e-chart-template.html")
 	}
 	e2eChartTitle := fmt.Sprintf("Intervals - %s%s", r.name, timeSuffix)
-	e2eChartHTML := bytes.ReplaceAll(e2eChartTemplate, []byte("EVENT_INTERVAL_TITLE_GOES_HERE"), []byte(e2eChartTitle))
-	e2eChartHTML = bytes.ReplaceAll(e2eChartHTML, []byte("EVENT_INTERVAL_JSON_GOES_HERE"), eventIntervalsJSON)
+	e2eChartHTML, err := fillChartTemplate(e2eChartTemplate, e2eChartTitle, eventIntervalsJSON, r.chartSpec)
+	if err != nil {
+		errs = append(errs, err)
+		return utilerrors.NewAggregate(errs)
+	}
 	e2eChartHTMLPath := filepath.Join(artifactDir, fmt.Sprintf("%s.html", filenameBase))
 	if err := ioutil.WriteFile(e2eChartHTMLPath, e2eChartHTML, 0644); err != nil {
 		errs = append(errs, err)
@@ -78,6 +96,30 @@ func (r eventIntervalRenderer) writeEventData(artifactDir, filenameBase string,
 	return utilerrors.NewAggregate(errs)
 }
 
+// RenderChartHTML returns an interval chart of the intervals, arranged by the chart spec if
+// it is not nil.
+func RenderChartHTML(title string, events monitorapi.Intervals, spec *ChartSpec) ([]byte, error) {
+	eventIntervalsJSON, err := monitorserialization.EventsIntervalsToJSON(events)
+	if err != nil {
+		return nil, err
+	}
+	return fillChartTemplate(testdata.MustAsset("e2echart/non-spyglass-e2e-chart-template.html"), title, eventIntervalsJSON, spec)
+}
+
+func fillChartTemplate(e2eChartTemplate []byte, title string, eventIntervalsJSON []byte, spec *ChartSpec) ([]byte, error) {
+	chartSpecJSON := []byte("null")
+	if spec != nil {
+		var err error
+		if chartSpecJSON, err = json.Marshal(spec); err != nil {
+			return nil, err
+		}
+	}
+	e2eChartHTML := bytes.ReplaceAll(e2eChartTemplate, []byte("EVENT_INTERVAL_TITLE_GOES_HERE"), []byte(title))
+	e2eChartHTML = bytes.ReplaceAll(e2eChartHTML, []byte("EVENT_INTERVAL_JSON_GOES_HERE"), eventIntervalsJSON)
+	e2eChartHTML = bytes.ReplaceAll(e2eChartHTML, []byte("CHART_SPEC_GOES_HERE"), chartSpecJSON)
+	return e2eChartHTML, nil
+}
+
 func BelongsInEverything(eventInterval monitorapi.EventInterval) bool {
 	return true
 }
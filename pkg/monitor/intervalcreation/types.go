@@ -22,6 +22,7 @@ func defaultIntervalCreationFns() []simpleIntervalCreationFunc {
 		IntervalsFromEvents_OperatorAvailable,
 		IntervalsFromEvents_OperatorProgressing,
 		IntervalsFromEvents_OperatorDegraded,
+		IntervalsFromEvents_CustomResourceConditions,
 		IntervalsFromEvents_E2ETests,
 		IntervalsFromEvents_NodeChanges,
 		CreatePodIntervalsFromInstants,
@@ -0,0 +1,138 @@
+// Package intervalquery lets running tests query the intervals the monitor of the suite has
+// collected so far. Tests run in child processes of openshift-tests, so the parent serves the
+// intervals on the loopback interface and passes the address to every test in URLEnv.
+package intervalquery
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+
+	"github.com/openshift/origin/pkg/monitor/monitorapi"
+	monitorserialization "github.com/openshift/origin/pkg/monitor/serialization"
+)
+
+// URLEnv is the environment variable holding the address at which a test can query intervals.
+const URLEnv = "TEST_MONITOR_INTERVALS_URL"
+
+// Source provides the intervals collected so far, such as a running monitor.
+type Source interface {
+	Intervals(from, to time.Time) monitorapi.Intervals
+}
+
+// Query returns the intervals selected by q that the monitor of the suite has collected so far. It
+// returns an error if the test is not run by a suite with a monitor.
+func Query(ctx context.Context, q monitorapi.IntervalQuery) (monitorapi.Intervals, error) {
+	base := os.Getenv(URLEnv)
+	if len(base) == 0 {
+		return nil, fmt.Errorf("intervals can only be queried by tests run by a suite, %s is not set", URLEnv)
+	}
+	return queryURL(ctx, base, q)
+}
+
+func queryURL(ctx context.Context, base string, q monitorapi.IntervalQuery) (monitorapi.Intervals, error) {
+	values := url.Values{}
+	if len(q.Locator) > 0 {
+		values.Set("locator", q.Locator)
+	}
+	if len(q.Source) > 0 {
+		values.Set("source", q.Source)
+	}
+	if !q.From.IsZero() {
+		values.Set("from", q.From.UTC().Format(time.RFC3339Nano))
+	}
+	if !q.To.IsZero() {
+		values.Set("to", q.To.UTC().Format(time.RFC3339Nano))
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, base+"?"+values.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unable to query intervals: %s: %s", resp.Status, data)
+	}
+	return monitorserialization.EventsFromJSON(data)
+}
+
+// NewHandler serves the intervals of source selected by the query parameters locator, source,
+// from, and to.
+func NewHandler(source Source) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		q, err := parseQuery(req.URL.Query())
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		intervals, err := source.Intervals(time.Time{}, time.Time{}).Query(q)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		data, err := monitorserialization.EventsToJSON(intervals)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(data)
+	})
+}
+
+func parseQuery(values url.Values) (monitorapi.IntervalQuery, error) {
+	q := monitorapi.IntervalQuery{
+		Locator: values.Get("locator"),
+		Source:  values.Get("source"),
+	}
+	for _, param := range []struct {
+		name  string
+		value *time.Time
+	}{
+		{"from", &q.From},
+		{"to", &q.To},
+	} {
+		if s := values.Get(param.name); len(s) > 0 {
+			t, err := time.Parse(time.RFC3339Nano, s)
+			if err != nil {
+				return q, fmt.Errorf("invalid %s: %v", param.name, err)
+			}
+			*param.value = t
+		}
+	}
+	return q, nil
+}
+
+// Listen reserves a loopback address to serve intervals on and returns it with the URL to pass to
+// tests in URLEnv.
+func Listen() (net.Listener, string, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, "", err
+	}
+	return listener, fmt.Sprintf("http://%s/intervals", listener.Addr()), nil
+}
+
+// Serve answers interval queries on listener until ctx is done.
+func Serve(ctx context.Context, listener net.Listener, source Source) {
+	mux := http.NewServeMux()
+	mux.Handle("/intervals", NewHandler(source))
+	server := &http.Server{Handler: mux}
+	go func() {
+		<-ctx.Done()
+		server.Close()
+	}()
+	server.Serve(listener)
+}
@@ -0,0 +1,51 @@
+package intervalquery
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/openshift/origin/pkg/monitor/monitorapi"
+)
+
+type fakeSource monitorapi.Intervals
+
+func (s fakeSource) Intervals(from, to time.Time) monitorapi.Intervals {
+	return monitorapi.Intervals(s)
+}
+
+func TestQuery(t *testing.T) {
+	start := time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC)
+	source := fakeSource{
+		{Condition: monitorapi.Condition{Level: monitorapi.Error, Locator: "disruption/kube-api connection/new", Message: "disrupted"}, From: start, To: start.Add(10 * time.Second)},
+		{Condition: monitorapi.Condition{Level: monitorapi.Info, Locator: "ns/e2e-test pod/a", Message: "created"}, From: start.Add(time.Minute), To: start.Add(time.Minute)},
+	}
+	server := httptest.NewServer(NewHandler(source))
+	defer server.Close()
+
+	intervals, err := queryURL(context.Background(), server.URL, monitorapi.IntervalQuery{Source: "disruption", From: start.Add(5 * time.Second)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(intervals) != 1 || intervals[0].Locator != "disruption/kube-api connection/new" || intervals[0].Level != monitorapi.Error || !intervals[0].To.Equal(start.Add(10*time.Second)) {
+		t.Errorf("unexpected intervals %v", intervals)
+	}
+
+	intervals, err = queryURL(context.Background(), server.URL, monitorapi.IntervalQuery{From: start.Add(time.Hour)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(intervals) != 0 {
+		t.Errorf("expected no intervals after the window, got %v", intervals)
+	}
+
+	if _, err := queryURL(context.Background(), server.URL, monitorapi.IntervalQuery{Locator: "("}); err == nil {
+		t.Error("expected an error for an invalid locator")
+	}
+
+	t.Setenv(URLEnv, "")
+	if _, err := Query(context.Background(), monitorapi.IntervalQuery{}); err == nil {
+		t.Error("expected an error outside of a suite")
+	}
+}
@@ -0,0 +1,159 @@
+package monitor
+
+import (
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/httpstream"
+	utilnet "k8s.io/apimachinery/pkg/util/net"
+	"k8s.io/client-go/rest"
+	"k8s.io/klog/v2"
+)
+
+// kubeconfigReloadInterval is how often the kubeconfig is read again to pick up new credentials.
+const kubeconfigReloadInterval = 30 * time.Second
+
+// WithKubeconfigReload makes the clients created from config send their requests with the credentials
+// returned by load, which is called again every kubeconfigReloadInterval and whenever the server rejects
+// the credentials in use. Tests that rotate the certificate authority of the cluster or regenerate the
+// kubeconfig would otherwise leave the clients of the runner and the monitor failing with x509 errors
+// for the rest of the run. The host of config is kept.
+//
+// The upgrade round trippers of exec and port-forward hold the connection they upgrade, so they are not
+// replaced and keep the credentials of config.
+func WithKubeconfigReload(config *rest.Config, load func() (*rest.Config, error)) (*rest.Config, error) {
+	reloader, err := newKubeconfigReloader(config, load, kubeconfigReloadInterval)
+	if err != nil {
+		return nil, err
+	}
+	config = rest.CopyConfig(config)
+	config.Wrap(func(rt http.RoundTripper) http.RoundTripper {
+		if _, ok := rt.(httpstream.UpgradeRoundTripper); ok {
+			return rt
+		}
+		return reloader
+	})
+	return config, nil
+}
+
+// kubeconfigReloader is a round tripper that replaces the transport it sends requests with when the
+// credentials of the kubeconfig change.
+type kubeconfigReloader struct {
+	load     func() (*rest.Config, error)
+	interval time.Duration
+
+	lock        sync.Mutex
+	checkedAt   time.Time
+	fingerprint string
+	transport   http.RoundTripper
+}
+
+func newKubeconfigReloader(config *rest.Config, load func() (*rest.Config, error), interval time.Duration) (*kubeconfigReloader, error) {
+	transport, err := rest.TransportFor(config)
+	if err != nil {
+		return nil, err
+	}
+	return &kubeconfigReloader{
+		load:        load,
+		interval:    interval,
+		checkedAt:   time.Now(),
+		fingerprint: credentialsFingerprint(config),
+		transport:   transport,
+	}, nil
+}
+
+// current returns the transport for the credentials of the kubeconfig, reading the kubeconfig again if
+// force is set or it was last read more than interval ago. The last transport is kept while the
+// kubeconfig cannot be read, as it may be in the middle of being rewritten.
+func (r *kubeconfigReloader) current(force bool) http.RoundTripper {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if !force && time.Since(r.checkedAt) < r.interval {
+		return r.transport
+	}
+	r.checkedAt = time.Now()
+
+	config, err := r.load()
+	if err != nil {
+		klog.V(2).Infof("Unable to reload the kubeconfig, using the previous credentials: %v", err)
+		return r.transport
+	}
+	fingerprint := credentialsFingerprint(config)
+	if fingerprint == r.fingerprint {
+		return r.transport
+	}
+	transport, err := rest.TransportFor(config)
+	if err != nil {
+		klog.Errorf("Unable to use the reloaded kubeconfig, using the previous credentials: %v", err)
+		return r.transport
+	}
+	klog.Infof("The credentials of the kubeconfig changed, new requests use the new credentials")
+	utilnet.CloseIdleConnectionsFor(r.transport)
+	r.fingerprint, r.transport = fingerprint, transport
+	return transport
+}
+
+func (r *kubeconfigReloader) RoundTrip(req *http.Request) (*http.Response, error) {
+	// the transport adds the credentials of the kubeconfig, not those of the config that was wrapped
+	req = utilnet.CloneRequest(req)
+	req.Header.Del("Authorization")
+
+	transport := r.current(false)
+	resp, err := transport.RoundTrip(req)
+	if !isRejectedCredentials(resp, err) {
+		return resp, err
+	}
+	retry := r.current(true)
+	if retry == transport || (req.Body != nil && req.GetBody == nil) {
+		return resp, err
+	}
+	if req.GetBody != nil {
+		body, bodyErr := req.GetBody()
+		if bodyErr != nil {
+			return resp, err
+		}
+		req.Body = body
+	}
+	if resp != nil {
+		resp.Body.Close()
+	}
+	return retry.RoundTrip(req)
+}
+
+// isRejectedCredentials returns true if the request failed because the server or the client did not
+// trust the certificate of the other, or the server did not accept the client.
+func isRejectedCredentials(resp *http.Response, err error) bool {
+	if err == nil {
+		return resp.StatusCode == http.StatusUnauthorized
+	}
+	var unknownAuthority x509.UnknownAuthorityError
+	var invalid x509.CertificateInvalidError
+	var hostname x509.HostnameError
+	return errors.As(err, &unknownAuthority) || errors.As(err, &invalid) || errors.As(err, &hostname)
+}
+
+// credentialsFingerprint identifies the credentials of config, including the contents of the files they
+// are read from.
+func credentialsFingerprint(config *rest.Config) string {
+	h := sha256.New()
+	fmt.Fprintf(h, "%s\x00%s\x00%s\x00%s\x00%t\x00%s\x00", config.BearerToken, config.BearerTokenFile, config.Username, config.Password, config.Insecure, config.ServerName)
+	for _, data := range [][]byte{config.CAData, config.CertData, config.KeyData} {
+		h.Write(data)
+		h.Write([]byte{0})
+	}
+	for _, file := range []string{config.CAFile, config.CertFile, config.KeyFile} {
+		fmt.Fprintf(h, "%s\x00", file)
+		if len(file) > 0 {
+			data, _ := ioutil.ReadFile(file)
+			h.Write(data)
+		}
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
@@ -0,0 +1,149 @@
+package monitor
+
+import (
+	"bytes"
+	"context"
+	"encoding/pem"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+	remotecommandconsts "k8s.io/apimachinery/pkg/util/remotecommand"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/remotecommand"
+	remotecommandserver "k8s.io/kubernetes/pkg/kubelet/cri/streaming/remotecommand"
+)
+
+func TestWithKubeconfigReload(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Header.Get("Authorization") != "Bearer rotated" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+	caData := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+
+	tests := []struct {
+		name    string
+		initial *rest.Config
+	}{
+		{
+			name:    "rotated certificate authority",
+			initial: &rest.Config{Host: server.URL, BearerToken: "rotated"},
+		},
+		{
+			name:    "rotated token",
+			initial: &rest.Config{Host: server.URL, BearerToken: "expired", TLSClientConfig: rest.TLSClientConfig{CAData: caData}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			loads := 0
+			config, err := WithKubeconfigReload(tt.initial, func() (*rest.Config, error) {
+				loads++
+				return &rest.Config{Host: server.URL, BearerToken: "rotated", TLSClientConfig: rest.TLSClientConfig{CAData: caData}}, nil
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			client, err := rest.HTTPClientFor(config)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// the rejected request is retried with the reloaded kubeconfig
+			for i := 0; i < 2; i++ {
+				resp, err := client.Get(server.URL)
+				if err != nil {
+					t.Fatal(err)
+				}
+				resp.Body.Close()
+				if resp.StatusCode != http.StatusOK {
+					t.Fatalf("expected the request to succeed, got %s", resp.Status)
+				}
+			}
+			if loads != 1 {
+				t.Errorf("expected the kubeconfig to be reloaded once, got %d", loads)
+			}
+		})
+	}
+}
+
+func TestKubeconfigReloaderInterval(t *testing.T) {
+	initial := &rest.Config{Host: "https://api.example.com", BearerToken: "a"}
+	reloaded := &rest.Config{Host: "https://api.example.com", BearerToken: "a"}
+	loads := 0
+	r, err := newKubeconfigReloader(initial, func() (*rest.Config, error) {
+		loads++
+		return reloaded, nil
+	}, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	transport := r.current(false)
+	if loads != 0 {
+		t.Errorf("expected the kubeconfig not to be read before the interval, got %d reads", loads)
+	}
+	if r.current(true) != transport {
+		t.Errorf("expected unchanged credentials to keep the transport")
+	}
+	reloaded.BearerToken = "b"
+	if r.current(true) == transport {
+		t.Errorf("expected new credentials to replace the transport")
+	}
+	if loads != 2 {
+		t.Errorf("expected two reads, got %d", loads)
+	}
+}
+
+// fakeExecutor writes the command it runs to the standard output of the exec
+type fakeExecutor struct{}
+
+func (fakeExecutor) ExecInContainer(_ context.Context, _ string, _ types.UID, _ string, cmd []string, _ io.Reader, out, _ io.WriteCloser, _ bool, _ <-chan remotecommand.TerminalSize, _ time.Duration) error {
+	_, err := out.Write([]byte(cmd[0]))
+	return err
+}
+
+func TestWithKubeconfigReloadExec(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		opts, err := remotecommandserver.NewOptions(req)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		remotecommandserver.ServeExec(w, req, fakeExecutor{}, "pod", "", "container", req.URL.Query()["command"], opts, time.Minute, time.Minute, remotecommandconsts.SupportedStreamingProtocols)
+	}))
+	defer server.Close()
+	caData := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	initial := &rest.Config{Host: server.URL, BearerToken: "token", TLSClientConfig: rest.TLSClientConfig{CAData: caData}}
+
+	config, err := WithKubeconfigReload(initial, func() (*rest.Config, error) { return rest.CopyConfig(initial), nil })
+	if err != nil {
+		t.Fatal(err)
+	}
+	execURL, err := url.Parse(server.URL + "/exec?command=hello&output=1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	exec, err := remotecommand.NewSPDYExecutor(config, "POST", execURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stdout := &bytes.Buffer{}
+	if err := exec.StreamWithContext(context.TODO(), remotecommand.StreamOptions{Stdout: stdout}); err != nil {
+		t.Fatalf("expected the exec to succeed through the upgrade round tripper: %v", err)
+	}
+	if stdout.String() != "hello" {
+		t.Errorf("unexpected output %q", stdout.String())
+	}
+}
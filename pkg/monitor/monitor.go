@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/openshift/origin/pkg/monitor/monitorapi"
+	monitorserialization "github.com/openshift/origin/pkg/monitor/serialization"
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/runtime"
 )
@@ -23,6 +24,10 @@ type Monitor struct {
 	events         monitorapi.Intervals
 	unsortedEvents monitorapi.Intervals
 	samples        []*sample
+	// stream receives intervals as they are recorded, and streamIDs maps the started intervals
+	// in unsortedEvents to their id in the stream
+	stream    *monitorserialization.IntervalStreamWriter
+	streamIDs map[int]int64
 
 	recordedResourceLock sync.Mutex
 	recordedResources    monitorapi.ResourcesMap
@@ -185,6 +190,7 @@ func (m *Monitor) Record(conditions ...monitorapi.Condition) {
 			To:        t,
 		})
 	}
+	m.streamAddLocked(m.events[len(m.events)-len(conditions):]...)
 }
 
 // AddIntervals provides a mechanism to directly inject eventIntervals
@@ -192,6 +198,7 @@ func (m *Monitor) AddIntervals(eventIntervals ...monitorapi.EventInterval) {
 	m.lock.Lock()
 	defer m.lock.Unlock()
 	m.events = append(m.events, eventIntervals...)
+	m.streamAddLocked(eventIntervals...)
 }
 
 // StartInterval inserts a record at time t with the provided condition and returns an opaque
@@ -203,6 +210,7 @@ func (m *Monitor) StartInterval(t time.Time, condition monitorapi.Condition) int
 		Condition: condition,
 		From:      t,
 	})
+	m.streamStartLocked(len(m.unsortedEvents)-1, m.unsortedEvents[len(m.unsortedEvents)-1])
 	return len(m.unsortedEvents) - 1
 }
 
@@ -214,6 +222,7 @@ func (m *Monitor) EndInterval(startedInterval int, t time.Time) {
 	if startedInterval < len(m.unsortedEvents) {
 		if m.unsortedEvents[startedInterval].From.Before(t) {
 			m.unsortedEvents[startedInterval].To = t
+			m.streamEndLocked(startedInterval, m.unsortedEvents[startedInterval])
 		}
 	}
 }
@@ -233,6 +242,7 @@ func (m *Monitor) RecordAt(t time.Time, conditions ...monitorapi.Condition) {
 			To:        t,
 		})
 	}
+	m.streamAddLocked(m.unsortedEvents[len(m.unsortedEvents)-len(conditions):]...)
 }
 
 func (m *Monitor) sample(hasPrevious bool) bool {
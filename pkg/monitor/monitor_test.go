@@ -1,11 +1,14 @@
 package monitor
 
 import (
+	"bytes"
 	"reflect"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/openshift/origin/pkg/monitor/monitorapi"
+	monitorserialization "github.com/openshift/origin/pkg/monitor/serialization"
 	"k8s.io/apimachinery/pkg/util/diff"
 )
 
@@ -110,3 +113,34 @@ func TestMonitor_Events(t *testing.T) {
 		})
 	}
 }
+
+func TestMonitor_IntervalStream(t *testing.T) {
+	m := NewMonitor()
+	m.Record(monitorapi.Condition{Level: monitorapi.Info, Locator: "a", Message: "before the stream"})
+
+	var buf bytes.Buffer
+	stream, err := monitorserialization.NewIntervalStreamWriter(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m.SetIntervalStream(stream)
+	start := time.Now().UTC()
+	i := m.StartInterval(start, monitorapi.Condition{Level: monitorapi.Error, Locator: "b", Message: "started"})
+	m.RecordAt(start, monitorapi.Condition{Level: monitorapi.Info, Locator: "c", Message: "at"})
+	m.EndInterval(i, start.Add(time.Minute))
+
+	events, err := monitorserialization.EventsFromJSON(buf.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var locators []string
+	for _, event := range events {
+		locators = append(locators, event.Locator)
+	}
+	if strings.Join(locators, ",") != "a,b,c" {
+		t.Fatalf("expected the intervals a, b and c in the stream, got %v", events)
+	}
+	if !events[1].To.Equal(start.Add(time.Minute)) {
+		t.Errorf("expected the started interval to be ended, got %v", events[1])
+	}
+}
@@ -21,6 +21,12 @@ func LocateDisruptionCheck(disruptionBackendName string, connectionType BackendC
 	return fmt.Sprintf("disruption/%s connection/%s", disruptionBackendName, connectionType)
 }
 
+// LocateDisruptionPhase locates the intervals describing a phase of the requests to a disruption backend.
+// It does not include the disruption key, so these intervals are not mistaken for disruption.
+func LocateDisruptionPhase(disruptionBackendName string, connectionType BackendConnectionType, phase string) string {
+	return fmt.Sprintf("disruption-phase/%s connection/%s phase/%s", disruptionBackendName, connectionType, phase)
+}
+
 func E2ETestLocator(testName string) string {
 	return fmt.Sprintf("e2e-test/%q", testName)
 }
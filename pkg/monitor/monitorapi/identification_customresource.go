@@ -0,0 +1,17 @@
+package monitorapi
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// LocateCustomResource locates an object of a resource watched with run-monitor --watch-resource.
+// namespace is empty for cluster scoped resources.
+func LocateCustomResource(gvr schema.GroupVersionResource, namespace, name string) string {
+	resource := gvr.GroupResource().String()
+	if len(namespace) == 0 {
+		return fmt.Sprintf("resource/%s name/%s", resource, name)
+	}
+	return fmt.Sprintf("ns/%s resource/%s name/%s", namespace, resource, name)
+}
@@ -0,0 +1,17 @@
+package monitorapi
+
+import "fmt"
+
+const (
+	// PodSecurityReasonViolation means a request violated the pod security audit level of its namespace.
+	PodSecurityReasonViolation = "PodSecurityViolation"
+)
+
+// LocatePodSecurityViolation locates the workload of a request that violated pod security.
+// name is empty for requests that did not name the object, like pods created with generateName.
+func LocatePodSecurityViolation(namespace, resource, name string) string {
+	if len(name) == 0 {
+		return fmt.Sprintf("ns/%s resource/%s", namespace, resource)
+	}
+	return fmt.Sprintf("ns/%s resource/%s name/%s", namespace, resource, name)
+}
@@ -0,0 +1,62 @@
+package monitorapi
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+)
+
+// IntervalQuery selects the intervals a test is interested in, for example the disruption of a
+// backend while the test performed an action. Empty fields match every interval.
+type IntervalQuery struct {
+	// Locator is a regular expression matched against the locator of the interval.
+	Locator string
+	// Source is a locator key, such as disruption, alert, node, or ns, that identifies the kind of
+	// monitor that recorded the interval.
+	Source string
+	// From and To select the intervals that overlap [From,To). A zero To leaves the window open.
+	From time.Time
+	To   time.Time
+}
+
+// Matcher returns a function matching the intervals selected by the query.
+func (q IntervalQuery) Matcher() (EventIntervalMatchesFunc, error) {
+	var locator *regexp.Regexp
+	if len(q.Locator) > 0 {
+		re, err := regexp.Compile(q.Locator)
+		if err != nil {
+			return nil, fmt.Errorf("invalid locator query: %v", err)
+		}
+		locator = re
+	}
+	return func(eventInterval EventInterval) bool {
+		if locator != nil && !locator.MatchString(eventInterval.Locator) {
+			return false
+		}
+		if len(q.Source) > 0 {
+			if _, ok := LocatorParts(eventInterval.Locator)[q.Source]; !ok {
+				return false
+			}
+		}
+		to := eventInterval.To
+		if to.IsZero() || to.Before(eventInterval.From) {
+			to = eventInterval.From
+		}
+		if !q.From.IsZero() && to.Before(q.From) {
+			return false
+		}
+		if !q.To.IsZero() && !eventInterval.From.Before(q.To) {
+			return false
+		}
+		return true
+	}, nil
+}
+
+// Query returns a copy of the intervals selected by the query.
+func (intervals Intervals) Query(q IntervalQuery) (Intervals, error) {
+	matches, err := q.Matcher()
+	if err != nil {
+		return nil, err
+	}
+	return intervals.Filter(matches), nil
+}
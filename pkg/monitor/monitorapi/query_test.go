@@ -0,0 +1,74 @@
+package monitorapi
+
+import (
+	"testing"
+	"time"
+)
+
+func TestIntervals_Query(t *testing.T) {
+	start := time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC)
+	intervals := Intervals{
+		{Condition: Condition{Locator: "disruption/kube-api connection/new", Message: "disrupted"}, From: start, To: start.Add(10 * time.Second)},
+		{Condition: Condition{Locator: "disruption/oauth-api connection/reused", Message: "disrupted"}, From: start.Add(time.Minute), To: start.Add(2 * time.Minute)},
+		{Condition: Condition{Locator: "alert/Watchdog ns/openshift-monitoring", Message: "firing"}, From: start.Add(5 * time.Second)},
+		{Condition: Condition{Locator: "ns/e2e-test pod/a", Message: "created"}, From: start.Add(3 * time.Minute), To: start.Add(3 * time.Minute)},
+	}
+
+	tests := []struct {
+		name    string
+		query   IntervalQuery
+		want    []string
+		wantErr bool
+	}{
+		{
+			name:  "everything",
+			query: IntervalQuery{},
+			want:  []string{"disruption/kube-api connection/new", "disruption/oauth-api connection/reused", "alert/Watchdog ns/openshift-monitoring", "ns/e2e-test pod/a"},
+		},
+		{
+			name:  "by source",
+			query: IntervalQuery{Source: "disruption"},
+			want:  []string{"disruption/kube-api connection/new", "disruption/oauth-api connection/reused"},
+		},
+		{
+			name:  "by locator",
+			query: IntervalQuery{Locator: `connection/reused`},
+			want:  []string{"disruption/oauth-api connection/reused"},
+		},
+		{
+			name:  "overlapping the window",
+			query: IntervalQuery{Source: "disruption", From: start.Add(5 * time.Second), To: start.Add(30 * time.Second)},
+			want:  []string{"disruption/kube-api connection/new"},
+		},
+		{
+			name:  "instant events in an open window",
+			query: IntervalQuery{From: start.Add(2*time.Minute + time.Second)},
+			want:  []string{"ns/e2e-test pod/a"},
+		},
+		{
+			name:    "invalid locator",
+			query:   IntervalQuery{Locator: "("},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := intervals.Query(tt.query)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Query() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			var locators []string
+			for _, interval := range got {
+				locators = append(locators, interval.Locator)
+			}
+			if len(locators) != len(tt.want) {
+				t.Fatalf("unexpected intervals %v", locators)
+			}
+			for i := range tt.want {
+				if locators[i] != tt.want[i] {
+					t.Errorf("unexpected intervals %v", locators)
+				}
+			}
+		})
+	}
+}
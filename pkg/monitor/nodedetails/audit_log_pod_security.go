@@ -0,0 +1,51 @@
+package nodedetails
+
+import (
+	"time"
+
+	auditv1 "k8s.io/apiserver/pkg/apis/audit/v1"
+)
+
+// podSecurityAuditViolationsAnnotation is set by pod security admission on requests that violate
+// the audit level of the namespace. Requests that violate the warn level get the same violations
+// returned as warnings.
+const podSecurityAuditViolationsAnnotation = "pod-security.kubernetes.io/audit-violations"
+
+// PodSecurityViolation is a request that violated the pod security audit level of its namespace.
+type PodSecurityViolation struct {
+	Namespace string
+	Resource  string
+	Name      string
+	User      string
+	Violation string
+	Time      time.Time
+}
+
+func podSecurityViolationFor(auditEvent *auditv1.Event) (PodSecurityViolation, bool) {
+	violation, ok := auditEvent.Annotations[podSecurityAuditViolationsAnnotation]
+	if !ok {
+		return PodSecurityViolation{}, false
+	}
+	// the annotation is repeated on every stage after admission.
+	if auditEvent.Stage != auditv1.StageResponseComplete {
+		return PodSecurityViolation{}, false
+	}
+
+	ns, gvr, name, _ := URIToParts(auditEvent.RequestURI)
+	if ref := auditEvent.ObjectRef; ref != nil {
+		if len(ref.Namespace) > 0 {
+			ns = ref.Namespace
+		}
+		if len(ref.Name) > 0 {
+			name = ref.Name
+		}
+	}
+	return PodSecurityViolation{
+		Namespace: ns,
+		Resource:  gvr.Resource,
+		Name:      name,
+		User:      auditEvent.User.Username,
+		Violation: violation,
+		Time:      auditEvent.RequestReceivedTimestamp.Time,
+	}, true
+}
@@ -20,6 +20,7 @@ type AuditLogSummary struct {
 	perUserRequestCount       map[string]*PerUserRequestCount
 	perResourceRequestCount   map[schema.GroupVersionResource]*PerResourceRequestCount
 	perHTTPStatusRequestCount map[int32]*PerHTTPStatusRequestCount
+	podSecurityViolations     []PodSecurityViolation
 }
 
 type RequestCounts struct {
@@ -97,6 +98,16 @@ func (s *AuditLogSummary) Add(auditEvent *auditv1.Event, auditEventInfo auditEve
 		}
 		s.perHTTPStatusRequestCount[httpStatus].Add(auditEvent, auditEventInfo)
 	}
+
+	if violation, ok := podSecurityViolationFor(auditEvent); ok {
+		s.podSecurityViolations = append(s.podSecurityViolations, violation)
+	}
+}
+
+// PodSecurityViolations returns the requests that violated the pod security audit level of their
+// namespace.
+func (s *AuditLogSummary) PodSecurityViolations() []PodSecurityViolation {
+	return s.podSecurityViolations
 }
 
 func (s *RequestCounts) Add(auditEvent *auditv1.Event) {
@@ -200,6 +211,7 @@ func (s *AuditLogSummary) AddSummary(rhs *AuditLogSummary) {
 		}
 		s.perHTTPStatusRequestCount[k].AddSummary(v)
 	}
+	s.podSecurityViolations = append(s.podSecurityViolations, rhs.podSecurityViolations...)
 }
 
 func (s *RequestCounts) AddSummary(rhs *RequestCounts) {
@@ -12,6 +12,7 @@ import (
 	"regexp"
 	"strings"
 	"sync"
+	"time"
 
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/selection"
@@ -46,6 +47,28 @@ func GetNodeLog(ctx context.Context, client kubernetes.Interface, nodeName, syst
 	return ioutil.ReadAll(in)
 }
 
+// GetNodeLogWindow returns the logs of a systemd service on a node between since and until. The
+// times are passed to journalctl in UTC, which is the time zone of the nodes.
+func GetNodeLogWindow(ctx context.Context, client kubernetes.Interface, nodeName, systemdServiceName string, since, until time.Time) ([]byte, error) {
+	path := client.CoreV1().RESTClient().Get().
+		Namespace("").Name(nodeName).
+		Resource("nodes").SubResource("proxy", "logs").Suffix("journal").URL().Path
+
+	req := client.CoreV1().RESTClient().Get().RequestURI(path).
+		SetHeader("Accept", "text/plain, */*")
+	req.Param("since", since.UTC().Format("2006-01-02 15:04:05"))
+	req.Param("until", until.UTC().Format("2006-01-02 15:04:05"))
+	req.Param("unit", systemdServiceName)
+
+	in, err := req.Stream(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer in.Close()
+
+	return ioutil.ReadAll(in)
+}
+
 func GetKubeAuditLogSummary(ctx context.Context, kubeClient kubernetes.Interface) (*AuditLogSummary, error) {
 	masterOnly, err := labels.NewRequirement("node-role.kubernetes.io/master", selection.Exists, nil)
 	if err != nil {
@@ -11,12 +11,24 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/cache"
 
 	configv1 "github.com/openshift/api/config/v1"
 	configclientset "github.com/openshift/client-go/config/clientset/versioned"
 )
 
+// StartClusterOperatorMonitoring records the condition and version changes of the cluster operators and
+// the cluster version.
+func StartClusterOperatorMonitoring(ctx context.Context, m Recorder, clusterConfig *rest.Config) error {
+	client, err := configclientset.NewForConfig(clusterConfig)
+	if err != nil {
+		return err
+	}
+	startClusterOperatorMonitoring(ctx, m, client)
+	return nil
+}
+
 func startClusterOperatorMonitoring(ctx context.Context, m Recorder, client configclientset.Interface) {
 	coInformer := cache.NewSharedIndexInformer(
 		NewErrorRecordingListWatcher(m, &cache.ListWatch{
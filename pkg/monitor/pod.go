@@ -275,11 +275,12 @@ func startPodMonitoring(ctx context.Context, m Recorder, client kubernetes.Inter
 				continue
 			}
 
-			if containerStatus.RestartCount != oldContainerStatus.RestartCount {
+			if containerStatus.RestartCount > oldContainerStatus.RestartCount {
+				// a single update may report several restarts
 				conditions = append(conditions, monitorapi.Condition{
 					Level:   monitorapi.Warning,
 					Locator: monitorapi.LocatePodContainer(pod, containerName),
-					Message: "reason/Restarted",
+					Message: monitorapi.ReasonedMessagef("Restarted", "restarts/%d", containerStatus.RestartCount-oldContainerStatus.RestartCount),
 				})
 			}
 		}
@@ -1,6 +1,7 @@
 package monitorserialization
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -43,7 +44,12 @@ func EventsFromFile(filename string) (monitorapi.Intervals, error) {
 	return EventsFromJSON(data)
 }
 
+// EventsFromJSON reads intervals written by EventsToJSON, or an interval stream written by an
+// IntervalStreamWriter.
 func EventsFromJSON(data []byte) (monitorapi.Intervals, error) {
+	if isIntervalStream(data) {
+		return EventsFromIntervalStream(bytes.NewReader(data))
+	}
 	var list EventIntervalList
 	if err := json.Unmarshal(data, &list); err != nil {
 		return nil, err
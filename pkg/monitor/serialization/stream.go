@@ -0,0 +1,242 @@
+package monitorserialization
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/openshift/origin/pkg/monitor/monitorapi"
+)
+
+// IntervalStreamSchemaVersion is the version of the interval stream format. Version 1 is the
+// EventIntervalList written by EventsToFile.
+const IntervalStreamSchemaVersion = 2
+
+// intervalStreamKind identifies the header line of an interval stream.
+const intervalStreamKind = "IntervalStream"
+
+// An interval stream is a JSON document per line. The first line is an intervalStreamHeader, and
+// every other line is an intervalStreamRecord. The stream is only appended to, so the intervals
+// written before a process dies can be read back even if the last line is incomplete.
+type intervalStreamHeader struct {
+	Kind          string `json:"kind"`
+	SchemaVersion int    `json:"schemaVersion"`
+}
+
+type intervalStreamOp string
+
+const (
+	// intervalStreamAdd records an interval. An interval without a to is open until ended.
+	intervalStreamAdd intervalStreamOp = "add"
+	// intervalStreamEnd sets the to of an open interval.
+	intervalStreamEnd intervalStreamOp = "end"
+)
+
+type intervalStreamRecord struct {
+	Op intervalStreamOp `json:"op"`
+	// ID identifies an open interval, and is not set on intervals added with a to.
+	ID int64 `json:"id,omitempty"`
+
+	Level   string `json:"level,omitempty"`
+	Locator string `json:"locator,omitempty"`
+	Message string `json:"message,omitempty"`
+	// From and To keep the sub-second precision that EventInterval drops
+	From *time.Time `json:"from,omitempty"`
+	To   *time.Time `json:"to,omitempty"`
+}
+
+// IntervalStreamWriter appends intervals to an interval stream as they are recorded. Every record
+// is written with a single unbuffered write. It is safe for concurrent use.
+type IntervalStreamWriter struct {
+	lock   sync.Mutex
+	w      io.Writer
+	lastID int64
+}
+
+// NewIntervalStreamWriter writes the header of an interval stream to w and returns a writer
+// for its intervals.
+func NewIntervalStreamWriter(w io.Writer) (*IntervalStreamWriter, error) {
+	s := &IntervalStreamWriter{w: w}
+	if err := s.write(intervalStreamHeader{Kind: intervalStreamKind, SchemaVersion: IntervalStreamSchemaVersion}); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// CreateIntervalStreamFile creates or truncates filename and returns a writer for the interval
+// stream in it. Close the writer to close the file.
+func CreateIntervalStreamFile(filename string) (*IntervalStreamWriter, error) {
+	f, err := os.OpenFile(filename, os.O_CREATE|os.O_TRUNC|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return nil, err
+	}
+	s, err := NewIntervalStreamWriter(f)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	return s, nil
+}
+
+// Add appends intervals that have ended, or instants.
+func (s *IntervalStreamWriter) Add(intervals ...monitorapi.EventInterval) error {
+	for _, interval := range intervals {
+		if err := s.write(newIntervalStreamRecord(0, interval)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Start appends an interval that has not ended and returns the id to end it with.
+func (s *IntervalStreamWriter) Start(interval monitorapi.EventInterval) (int64, error) {
+	s.lock.Lock()
+	s.lastID++
+	id := s.lastID
+	s.lock.Unlock()
+	interval.To = time.Time{}
+	return id, s.write(newIntervalStreamRecord(id, interval))
+}
+
+// End appends the end of the interval started with id.
+func (s *IntervalStreamWriter) End(id int64, to time.Time) error {
+	return s.write(intervalStreamRecord{Op: intervalStreamEnd, ID: id, To: &to})
+}
+
+// Close closes the underlying writer if it is an io.Closer.
+func (s *IntervalStreamWriter) Close() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if closer, ok := s.w.(io.Closer); ok {
+		return closer.Close()
+	}
+	return nil
+}
+
+func (s *IntervalStreamWriter) write(record interface{}) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	data = append(data, '\n')
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	_, err = s.w.Write(data)
+	return err
+}
+
+func newIntervalStreamRecord(id int64, interval monitorapi.EventInterval) intervalStreamRecord {
+	record := intervalStreamRecord{
+		Op:      intervalStreamAdd,
+		ID:      id,
+		Level:   fmt.Sprintf("%v", interval.Level),
+		Locator: interval.Locator,
+		Message: interval.Message,
+		From:    &interval.From,
+	}
+	if !interval.To.IsZero() {
+		record.To = &interval.To
+	}
+	return record
+}
+
+// isIntervalStream returns true if data begins with the header of an interval stream.
+func isIntervalStream(data []byte) bool {
+	line := data
+	if i := bytes.IndexByte(data, '\n'); i >= 0 {
+		line = data[:i]
+	}
+	var header intervalStreamHeader
+	if err := json.Unmarshal(line, &header); err != nil {
+		return false
+	}
+	return header.Kind == intervalStreamKind
+}
+
+// EventsFromIntervalStream reads the intervals of an interval stream. Intervals that were started
+// and never ended are returned without a to. An incomplete last line, left by a process that died
+// while writing it, is ignored.
+func EventsFromIntervalStream(r io.Reader) (monitorapi.Intervals, error) {
+	reader := bufio.NewReader(r)
+	var events monitorapi.Intervals
+	open := map[int64]int{}
+	for lineNumber := 1; ; lineNumber++ {
+		line, readErr := reader.ReadBytes('\n')
+		if readErr != nil && readErr != io.EOF {
+			return nil, readErr
+		}
+		complete := readErr == nil
+		line = bytes.TrimSpace(line)
+		if len(line) > 0 {
+			if err := readIntervalStreamLine(lineNumber, line, &events, open); err != nil {
+				if !complete {
+					// the process writing the stream stopped in the middle of this line
+					break
+				}
+				return nil, err
+			}
+		}
+		if !complete {
+			break
+		}
+	}
+	return events, nil
+}
+
+func readIntervalStreamLine(lineNumber int, line []byte, events *monitorapi.Intervals, open map[int64]int) error {
+	if lineNumber == 1 {
+		var header intervalStreamHeader
+		if err := json.Unmarshal(line, &header); err != nil || header.Kind != intervalStreamKind {
+			return fmt.Errorf("line 1: not an interval stream")
+		}
+		if header.SchemaVersion != IntervalStreamSchemaVersion {
+			return fmt.Errorf("line 1: unsupported interval stream schema version %d", header.SchemaVersion)
+		}
+		return nil
+	}
+	var record intervalStreamRecord
+	if err := json.Unmarshal(line, &record); err != nil {
+		return fmt.Errorf("line %d: %v", lineNumber, err)
+	}
+	switch record.Op {
+	case intervalStreamAdd:
+		level, err := monitorapi.EventLevelFromString(record.Level)
+		if err != nil {
+			return fmt.Errorf("line %d: %v", lineNumber, err)
+		}
+		interval := monitorapi.EventInterval{
+			Condition: monitorapi.Condition{
+				Level:   level,
+				Locator: record.Locator,
+				Message: record.Message,
+			},
+		}
+		if record.From != nil {
+			interval.From = *record.From
+		}
+		if record.To != nil {
+			interval.To = *record.To
+		}
+		if record.ID != 0 {
+			open[record.ID] = len(*events)
+		}
+		*events = append(*events, interval)
+	case intervalStreamEnd:
+		i, ok := open[record.ID]
+		if !ok {
+			return fmt.Errorf("line %d: no interval was started with id %d", lineNumber, record.ID)
+		}
+		if record.To != nil && (*events)[i].From.Before(*record.To) {
+			(*events)[i].To = *record.To
+		}
+		delete(open, record.ID)
+	default:
+		return fmt.Errorf("line %d: unknown op %q", lineNumber, record.Op)
+	}
+	return nil
+}
@@ -0,0 +1,128 @@
+package monitorserialization
+
+import (
+	"bytes"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/openshift/origin/pkg/monitor/monitorapi"
+)
+
+func TestIntervalStreamRoundTrip(t *testing.T) {
+	start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	instant := monitorapi.EventInterval{
+		Condition: monitorapi.Condition{Level: monitorapi.Info, Locator: "ns/a pod/b", Message: "reason/Created"},
+		From:      start,
+		To:        start,
+	}
+	started := monitorapi.EventInterval{
+		Condition: monitorapi.Condition{Level: monitorapi.Error, Locator: "disruption/kube-api", Message: "unreachable"},
+		From:      start.Add(time.Second),
+	}
+	neverEnded := monitorapi.EventInterval{
+		Condition: monitorapi.Condition{Level: monitorapi.Warning, Locator: "node/a", Message: "not ready"},
+		From:      start.Add(2 * time.Second),
+	}
+
+	var buf bytes.Buffer
+	w, err := NewIntervalStreamWriter(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	id, err := w.Start(started)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Add(instant); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := w.Start(neverEnded); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.End(id, start.Add(5*time.Second)); err != nil {
+		t.Fatal(err)
+	}
+
+	events, err := EventsFromJSON(buf.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	ended := started
+	ended.To = start.Add(5 * time.Second)
+	want := monitorapi.Intervals{ended, instant, neverEnded}
+	if len(events) != len(want) {
+		t.Fatalf("expected %d intervals, got %v", len(want), events)
+	}
+	for i := range want {
+		if events[i].String() != want[i].String() || !events[i].To.Equal(want[i].To) {
+			t.Errorf("interval %d: expected %s to %s, got %s to %s", i, want[i], want[i].To, events[i], events[i].To)
+		}
+	}
+
+	// a process killed while writing leaves an incomplete last line
+	truncated := buf.Bytes()[:buf.Len()-10]
+	events, err = EventsFromJSON(truncated)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 3 || !events[0].To.IsZero() {
+		t.Errorf("expected the intervals before the incomplete line with the first still open, got %v", events)
+	}
+}
+
+func TestEventsFromFileFormats(t *testing.T) {
+	dir := t.TempDir()
+	events := monitorapi.Intervals{{
+		Condition: monitorapi.Condition{Level: monitorapi.Info, Locator: "ns/a", Message: "created"},
+		From:      time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+		To:        time.Date(2023, 1, 1, 0, 1, 0, 0, time.UTC),
+	}}
+
+	v1 := filepath.Join(dir, "e2e-events.json")
+	if err := EventsToFile(v1, events); err != nil {
+		t.Fatal(err)
+	}
+	v2 := filepath.Join(dir, "e2e-events-stream.jsonl")
+	w, err := CreateIntervalStreamFile(v2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Add(events...); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, file := range []string{v1, v2} {
+		read, err := EventsFromFile(file)
+		if err != nil {
+			t.Fatalf("%s: %v", file, err)
+		}
+		if len(read) != 1 || read[0].String() != events[0].String() || !read[0].To.Equal(events[0].To) {
+			t.Errorf("%s: expected %v, got %v", file, events, read)
+		}
+	}
+}
+
+func TestEventsFromIntervalStreamErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		wantErr string
+	}{
+		{name: "newer schema", data: `{"kind":"IntervalStream","schemaVersion":3}` + "\n", wantErr: "unsupported interval stream schema version 3"},
+		{name: "end without start", data: `{"kind":"IntervalStream","schemaVersion":2}` + "\n" + `{"op":"end","id":4,"to":"2023-01-01T00:00:00Z"}` + "\n", wantErr: "no interval was started with id 4"},
+		{name: "corrupt line", data: `{"kind":"IntervalStream","schemaVersion":2}` + "\n" + "{\n" + `{"op":"add","level":"Info","from":"2023-01-01T00:00:00Z"}` + "\n", wantErr: "line 2"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := EventsFromJSON([]byte(test.data))
+			if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", test.wantErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package monitor
+
+import (
+	"k8s.io/klog/v2"
+
+	"github.com/openshift/origin/pkg/monitor/monitorapi"
+	monitorserialization "github.com/openshift/origin/pkg/monitor/serialization"
+)
+
+// SetIntervalStream appends every interval the monitor has recorded, and records from now on, to
+// stream, so the intervals of a run survive the process being killed before they are written at
+// the end. Sampled conditions are not streamed. After the first error writing to the stream, the
+// monitor stops writing to it. A nil stream stops streaming.
+func (m *Monitor) SetIntervalStream(stream *monitorserialization.IntervalStreamWriter) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.stream = stream
+	m.streamIDs = map[int]int64{}
+	m.streamAddLocked(m.events...)
+	for i, interval := range m.unsortedEvents {
+		if interval.To.IsZero() {
+			m.streamStartLocked(i, interval)
+			continue
+		}
+		m.streamAddLocked(interval)
+	}
+}
+
+func (m *Monitor) streamAddLocked(intervals ...monitorapi.EventInterval) {
+	if m.stream == nil || len(intervals) == 0 {
+		return
+	}
+	m.streamErrorLocked(m.stream.Add(intervals...))
+}
+
+func (m *Monitor) streamStartLocked(index int, interval monitorapi.EventInterval) {
+	if m.stream == nil {
+		return
+	}
+	id, err := m.stream.Start(interval)
+	if err == nil {
+		m.streamIDs[index] = id
+	}
+	m.streamErrorLocked(err)
+}
+
+func (m *Monitor) streamEndLocked(index int, interval monitorapi.EventInterval) {
+	if m.stream == nil {
+		return
+	}
+	id, ok := m.streamIDs[index]
+	if !ok {
+		return
+	}
+	delete(m.streamIDs, index)
+	m.streamErrorLocked(m.stream.End(id, interval.To))
+}
+
+func (m *Monitor) streamErrorLocked(err error) {
+	if err == nil {
+		return
+	}
+	klog.Errorf("Unable to write to the interval stream, intervals will no longer be streamed: %v", err)
+	m.stream = nil
+}
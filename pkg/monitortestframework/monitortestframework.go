@@ -0,0 +1,194 @@
+// Package monitortestframework lets repositories outside of origin contribute monitor tests to
+// openshift-tests. A monitor test records intervals while the cluster is monitored and checks
+// invariants over the recorded intervals when the suite ends. Monitor tests are registered from
+// the init function of a package that a custom build of openshift-tests imports.
+package monitortestframework
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"k8s.io/client-go/rest"
+
+	"github.com/openshift/origin/pkg/monitor"
+	"github.com/openshift/origin/pkg/monitor/monitorapi"
+	"github.com/openshift/origin/pkg/test/ginkgo/junitapi"
+)
+
+// MonitorTest records intervals while the cluster is monitored and evaluates them when the suite
+// ends.
+type MonitorTest interface {
+	// StartCollection is called when the monitor starts. It is non-blocking, must stop when ctx is
+	// done, and records intervals with recorder.
+	StartCollection(ctx context.Context, recorder monitor.Recorder, clusterConfig *rest.Config) error
+	// EvaluateInvariants returns JUnit results for the intervals of the suite. A failing result fails
+	// the suite, and a passing and a failing result with the same name mark the invariant as flaky.
+	// clusterConfig may be nil and the cluster may be unavailable.
+	EvaluateInvariants(events monitorapi.Intervals, duration time.Duration, clusterConfig *rest.Config, testSuite string, recordedResources *monitorapi.ResourcesMap) []*junitapi.JUnitTestCase
+}
+
+// MonitorTestFuncs converts a pair of functions into the MonitorTest interface. Either function may
+// be nil for monitor tests that only record intervals or only evaluate the intervals of others.
+type MonitorTestFuncs struct {
+	StartCollectionFunc    monitor.StartEventIntervalRecorderFunc
+	EvaluateInvariantsFunc func(events monitorapi.Intervals, duration time.Duration, clusterConfig *rest.Config, testSuite string, recordedResources *monitorapi.ResourcesMap) []*junitapi.JUnitTestCase
+}
+
+func (f MonitorTestFuncs) StartCollection(ctx context.Context, recorder monitor.Recorder, clusterConfig *rest.Config) error {
+	if f.StartCollectionFunc == nil {
+		return nil
+	}
+	return f.StartCollectionFunc(ctx, recorder, clusterConfig)
+}
+
+func (f MonitorTestFuncs) EvaluateInvariants(events monitorapi.Intervals, duration time.Duration, clusterConfig *rest.Config, testSuite string, recordedResources *monitorapi.ResourcesMap) []*junitapi.JUnitTestCase {
+	if f.EvaluateInvariantsFunc == nil {
+		return nil
+	}
+	return f.EvaluateInvariantsFunc(events, duration, clusterConfig, testSuite, recordedResources)
+}
+
+// InvariantCheck is a monitor test that only checks the intervals of the suite, so a check can be
+// registered next to the specs it concerns and react to the data of the whole run. It reports a
+// JUnit test case named TestName, failed with the error of Check. A panicking check fails its test
+// case instead of the suite. Specs run in separate processes, so the check must be registered while
+// the spec tree is built, from an init function or a Describe body, and not from an It or
+// BeforeSuite body. It runs whether or not the specs next to it were selected.
+type InvariantCheck struct {
+	TestName string
+	Check    func(events monitorapi.Intervals, duration time.Duration) error
+}
+
+func (c InvariantCheck) StartCollection(ctx context.Context, recorder monitor.Recorder, clusterConfig *rest.Config) error {
+	return nil
+}
+
+func (c InvariantCheck) EvaluateInvariants(events monitorapi.Intervals, duration time.Duration, clusterConfig *rest.Config, testSuite string, recordedResources *monitorapi.ResourcesMap) []*junitapi.JUnitTestCase {
+	err := c.check(events, duration)
+	if err == nil {
+		return []*junitapi.JUnitTestCase{{Name: c.TestName}}
+	}
+	return []*junitapi.JUnitTestCase{{
+		Name: c.TestName,
+		FailureOutput: &junitapi.FailureOutput{
+			Output: err.Error(),
+		},
+		SystemOut: err.Error(),
+	}}
+}
+
+func (c InvariantCheck) check(events monitorapi.Intervals, duration time.Duration) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("invariant check panicked: %v", r)
+		}
+	}()
+	return c.Check(events, duration)
+}
+
+type namedMonitorTest struct {
+	name string
+	test MonitorTest
+}
+
+var (
+	monitorTestsLock sync.Mutex
+	monitorTests     []namedMonitorTest
+	// registrationClosed is set once the spec tree is built
+	registrationClosed bool
+	// disabled holds the names of the monitor tests that are neither started nor evaluated
+	disabled = map[string]bool{}
+)
+
+// Register adds a monitor test to every suite and to run-monitor. Monitor tests are started and
+// evaluated in the order they were registered. Register panics if name is empty or already
+// registered, like other registries that are populated from init functions. Monitor tests are
+// started and evaluated by the process that runs the suite, while each spec runs in a process of
+// its own, so they must be registered from an init function or a Describe body. Register panics
+// when called after CloseRegistration, as from an It or BeforeSuite body, rather than register a
+// monitor test the suite would never evaluate.
+func Register(name string, test MonitorTest) {
+	monitorTestsLock.Lock()
+	defer monitorTestsLock.Unlock()
+	if len(name) == 0 || test == nil {
+		panic("monitortestframework: Register requires a name and a monitor test")
+	}
+	if registrationClosed {
+		panic(fmt.Sprintf("monitortestframework: monitor test %q was registered while a spec runs, register it from an init function or a Describe body so the process that runs the suite evaluates it", name))
+	}
+	for _, existing := range monitorTests {
+		if existing.name == name {
+			panic(fmt.Sprintf("monitortestframework: monitor test %q is already registered", name))
+		}
+	}
+	monitorTests = append(monitorTests, namedMonitorTest{name: name, test: test})
+}
+
+// CloseRegistration makes later calls to Register panic. It is called once the spec tree is built and
+// before a spec runs.
+func CloseRegistration() {
+	monitorTestsLock.Lock()
+	defer monitorTestsLock.Unlock()
+	registrationClosed = true
+}
+
+// Disable keeps the named monitor tests from being started or evaluated, for clusters they do not
+// apply to. Names that are not registered are ignored, so a monitor test may be disabled whether or
+// not a build of openshift-tests includes it.
+func Disable(names ...string) {
+	monitorTestsLock.Lock()
+	defer monitorTestsLock.Unlock()
+	for _, name := range names {
+		disabled[name] = true
+	}
+}
+
+// Names returns the names of the enabled monitor tests in the order they were registered.
+func Names() []string {
+	var names []string
+	for _, registered := range registeredMonitorTests() {
+		names = append(names, registered.name)
+	}
+	return names
+}
+
+// registeredMonitorTests returns the monitor tests that are registered and not disabled.
+func registeredMonitorTests() []namedMonitorTest {
+	monitorTestsLock.Lock()
+	defer monitorTestsLock.Unlock()
+	var enabled []namedMonitorTest
+	for _, registered := range monitorTests {
+		if !disabled[registered.name] {
+			enabled = append(enabled, registered)
+		}
+	}
+	return enabled
+}
+
+// StartCollectionFuncs returns the recorders of the registered monitor tests, to start with the
+// monitor.
+func StartCollectionFuncs() []monitor.StartEventIntervalRecorderFunc {
+	var recorders []monitor.StartEventIntervalRecorderFunc
+	for _, registered := range registeredMonitorTests() {
+		registered := registered
+		recorders = append(recorders, func(ctx context.Context, recorder monitor.Recorder, clusterConfig *rest.Config) error {
+			if err := registered.test.StartCollection(ctx, recorder, clusterConfig); err != nil {
+				return fmt.Errorf("unable to start monitor test %s: %v", registered.name, err)
+			}
+			return nil
+		})
+	}
+	return recorders
+}
+
+// EvaluateInvariants returns the results of every registered monitor test for the intervals of the
+// suite.
+func EvaluateInvariants(events monitorapi.Intervals, duration time.Duration, clusterConfig *rest.Config, testSuite string, recordedResources *monitorapi.ResourcesMap) []*junitapi.JUnitTestCase {
+	var results []*junitapi.JUnitTestCase
+	for _, registered := range registeredMonitorTests() {
+		results = append(results, registered.test.EvaluateInvariants(events, duration, clusterConfig, testSuite, recordedResources)...)
+	}
+	return results
+}
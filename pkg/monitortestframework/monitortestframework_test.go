@@ -0,0 +1,146 @@
+package monitortestframework
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"k8s.io/client-go/rest"
+
+	"github.com/openshift/origin/pkg/monitor"
+	"github.com/openshift/origin/pkg/monitor/monitorapi"
+	"github.com/openshift/origin/pkg/test/ginkgo/junitapi"
+)
+
+func TestCloseRegistration(t *testing.T) {
+	defer func() {
+		monitorTests = nil
+		registrationClosed = false
+	}()
+
+	Register("from init", InvariantCheck{TestName: "from init"})
+	CloseRegistration()
+	func() {
+		defer func() {
+			r := recover()
+			if r == nil || !strings.Contains(fmt.Sprint(r), `monitor test "from a spec" was registered while a spec runs`) {
+				t.Errorf("expected registering from a running spec to panic, got %v", r)
+			}
+		}()
+		Register("from a spec", InvariantCheck{TestName: "from a spec"})
+	}()
+	if got := Names(); !reflect.DeepEqual(got, []string{"from init"}) {
+		t.Errorf("unexpected names %v", got)
+	}
+}
+
+func TestRegister(t *testing.T) {
+	defer func() { monitorTests = nil }()
+
+	var started []string
+	Register("records", MonitorTestFuncs{
+		StartCollectionFunc: func(ctx context.Context, recorder monitor.Recorder, clusterConfig *rest.Config) error {
+			started = append(started, "records")
+			return nil
+		},
+	})
+	Register("evaluates", MonitorTestFuncs{
+		EvaluateInvariantsFunc: func(events monitorapi.Intervals, duration time.Duration, clusterConfig *rest.Config, testSuite string, recordedResources *monitorapi.ResourcesMap) []*junitapi.JUnitTestCase {
+			return []*junitapi.JUnitTestCase{{Name: testSuite + " has no disruption"}}
+		},
+	})
+	Register("fails to start", MonitorTestFuncs{
+		StartCollectionFunc: func(ctx context.Context, recorder monitor.Recorder, clusterConfig *rest.Config) error {
+			return errors.New("no access")
+		},
+	})
+
+	if got, want := Names(), []string{"records", "evaluates", "fails to start"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected names %v", got)
+	}
+
+	var errs []string
+	for _, start := range StartCollectionFuncs() {
+		if err := start(context.Background(), nil, nil); err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+	if !reflect.DeepEqual(started, []string{"records"}) {
+		t.Errorf("unexpected started monitor tests %v", started)
+	}
+	if len(errs) != 1 || !strings.Contains(errs[0], "fails to start") {
+		t.Errorf("expected the failure to start to name the monitor test, got %v", errs)
+	}
+
+	results := EvaluateInvariants(nil, time.Minute, nil, "suite", nil)
+	if len(results) != 1 || results[0].Name != "suite has no disruption" {
+		t.Errorf("unexpected results %#v", results)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("expected registering a duplicate name to panic")
+		}
+	}()
+	Register("records", MonitorTestFuncs{})
+}
+
+func TestDisable(t *testing.T) {
+	defer func() { monitorTests, disabled = nil, map[string]bool{} }()
+
+	evaluated := func(name string) MonitorTestFuncs {
+		return MonitorTestFuncs{
+			EvaluateInvariantsFunc: func(events monitorapi.Intervals, duration time.Duration, clusterConfig *rest.Config, testSuite string, recordedResources *monitorapi.ResourcesMap) []*junitapi.JUnitTestCase {
+				return []*junitapi.JUnitTestCase{{Name: name}}
+			},
+		}
+	}
+	Register("applies", evaluated("applies"))
+	Register("does not apply", evaluated("does not apply"))
+	Disable("does not apply", "not registered")
+
+	if got, want := Names(), []string{"applies"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected names %v", got)
+	}
+	results := EvaluateInvariants(nil, time.Minute, nil, "suite", nil)
+	if len(results) != 1 || results[0].Name != "applies" {
+		t.Errorf("expected only the enabled monitor test to be evaluated, got %#v", results)
+	}
+}
+
+func TestInvariantCheck(t *testing.T) {
+	defer func() { monitorTests = nil }()
+
+	events := monitorapi.Intervals{{Condition: monitorapi.Condition{Locator: "node/a"}}}
+	Register("passes", InvariantCheck{TestName: "[sig-foo] passes", Check: func(got monitorapi.Intervals, duration time.Duration) error {
+		if len(got) != 1 || duration != time.Hour {
+			return errors.New("unexpected arguments")
+		}
+		return nil
+	}})
+	Register("fails", InvariantCheck{TestName: "[sig-foo] fails", Check: func(monitorapi.Intervals, time.Duration) error {
+		return errors.New("something went wrong")
+	}})
+	Register("panics", InvariantCheck{TestName: "[sig-foo] panics", Check: func(monitorapi.Intervals, time.Duration) error {
+		panic("boom")
+	}})
+
+	results := EvaluateInvariants(events, time.Hour, nil, "suite", nil)
+	if len(results) != 3 {
+		t.Fatalf("expected 3 results, got %d", len(results))
+	}
+	wantFailures := []string{"", "something went wrong", "invariant check panicked: boom"}
+	for i, result := range results {
+		var failure string
+		if result.FailureOutput != nil {
+			failure = result.FailureOutput.Output
+		}
+		if failure != wantFailures[i] {
+			t.Errorf("%s: expected failure %q, got %q", result.Name, wantFailures[i], failure)
+		}
+	}
+}
@@ -0,0 +1,15 @@
+// Package clusterversionoperator records the condition and version changes of the cluster operators
+// and the cluster version while the cluster is monitored. Topologies without the cluster version
+// operator, such as MicroShift, disable it.
+package clusterversionoperator
+
+import (
+	"github.com/openshift/origin/pkg/monitor"
+	"github.com/openshift/origin/pkg/monitortestframework"
+)
+
+func init() {
+	monitortestframework.Register("cluster-version-operator", monitortestframework.MonitorTestFuncs{
+		StartCollectionFunc: monitor.StartClusterOperatorMonitoring,
+	})
+}
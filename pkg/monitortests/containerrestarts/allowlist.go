@@ -0,0 +1,164 @@
+package containerrestarts
+
+import (
+	_ "embed"
+	"fmt"
+	"os"
+	"regexp"
+	"sync"
+
+	"sigs.k8s.io/yaml"
+)
+
+// Violation is how a container that restarts more often than allowed is reported.
+type Violation string
+
+const (
+	// ViolationFailure fails the test.
+	ViolationFailure Violation = "Failure"
+	// ViolationFlake reports the test as a flake.
+	ViolationFlake Violation = "Flake"
+)
+
+// Allowlist sets the number of restarts allowed for the containers of platform namespaces during a
+// run. It is data, so the thresholds can change without a code change to this package.
+type Allowlist struct {
+	// Namespaces are patterns of the namespaces whose containers are checked.
+	Namespaces []string `json:"namespaces"`
+	// DefaultMaxRestarts is the number of restarts allowed for a container that no rule matches.
+	DefaultMaxRestarts int `json:"defaultMaxRestarts"`
+	// Rules are consulted in order, and the first rule that matches a container sets its threshold.
+	Rules []Rule `json:"rules"`
+
+	namespaces []*regexp.Regexp
+}
+
+// Rule allows the containers that match its patterns to restart up to MaxRestarts times in each pod,
+// and sets how more restarts are reported.
+type Rule struct {
+	// Namespace is a pattern of the namespace of the pod. An empty pattern matches every namespace.
+	Namespace string `json:"namespace,omitempty"`
+	// Container is a pattern of the name of the container. An empty pattern matches every container.
+	Container string `json:"container,omitempty"`
+	// MaxRestarts is the number of restarts allowed for the container in each pod.
+	MaxRestarts int `json:"maxRestarts"`
+	// Violation defaults to Failure.
+	Violation Violation `json:"violation,omitempty"`
+	// Reason explains why the restarts are expected, usually with a link to a bug.
+	Reason string `json:"reason"`
+
+	namespace, container *regexp.Regexp
+}
+
+//go:embed allowlist.yaml
+var defaultAllowlistData []byte
+
+var (
+	allowlistLock sync.Mutex
+	// allowlist is the allowlist in use, the default unless one was loaded
+	allowlist = mustParseAllowlist(defaultAllowlistData)
+)
+
+// ParseAllowlist parses and validates a YAML or JSON container restart allowlist.
+func ParseAllowlist(data []byte) (*Allowlist, error) {
+	allowlist := &Allowlist{}
+	if err := yaml.UnmarshalStrict(data, allowlist); err != nil {
+		return nil, err
+	}
+	if allowlist.DefaultMaxRestarts < 0 {
+		return nil, fmt.Errorf("defaultMaxRestarts may not be negative")
+	}
+	for i, pattern := range allowlist.Namespaces {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("namespaces[%d]: %v", i, err)
+		}
+		allowlist.namespaces = append(allowlist.namespaces, re)
+	}
+	for i := range allowlist.Rules {
+		rule := &allowlist.Rules[i]
+		if rule.MaxRestarts < 0 {
+			return nil, fmt.Errorf("rules[%d]: maxRestarts may not be negative", i)
+		}
+		switch rule.Violation {
+		case "":
+			rule.Violation = ViolationFailure
+		case ViolationFailure, ViolationFlake:
+		default:
+			return nil, fmt.Errorf("rules[%d]: violation must be %s or %s", i, ViolationFailure, ViolationFlake)
+		}
+		if len(rule.Reason) == 0 {
+			return nil, fmt.Errorf("rules[%d]: reason is required", i)
+		}
+		var err error
+		if rule.namespace, err = regexp.Compile(rule.Namespace); err != nil {
+			return nil, fmt.Errorf("rules[%d]: namespace: %v", i, err)
+		}
+		if rule.container, err = regexp.Compile(rule.Container); err != nil {
+			return nil, fmt.Errorf("rules[%d]: container: %v", i, err)
+		}
+	}
+	return allowlist, nil
+}
+
+func mustParseAllowlist(data []byte) *Allowlist {
+	allowlist, err := ParseAllowlist(data)
+	if err != nil {
+		panic(err)
+	}
+	return allowlist
+}
+
+// LoadAllowlist reads the allowlist at path and uses it instead of the default allowlist for the
+// rest of the process.
+func LoadAllowlist(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	loaded, err := ParseAllowlist(data)
+	if err != nil {
+		return fmt.Errorf("invalid container restart allowlist %s: %v", path, err)
+	}
+	SetAllowlist(loaded)
+	return nil
+}
+
+// SetAllowlist sets the allowlist in use. A nil allowlist restores the default.
+func SetAllowlist(a *Allowlist) {
+	allowlistLock.Lock()
+	defer allowlistLock.Unlock()
+	if a == nil {
+		a = mustParseAllowlist(defaultAllowlistData)
+	}
+	allowlist = a
+}
+
+func currentAllowlist() *Allowlist {
+	allowlistLock.Lock()
+	defer allowlistLock.Unlock()
+	return allowlist
+}
+
+// checked returns true if the containers of the namespace are checked.
+func (a *Allowlist) checked(namespace string) bool {
+	for _, re := range a.namespaces {
+		if re.MatchString(namespace) {
+			return true
+		}
+	}
+	return false
+}
+
+// maxRestarts returns the number of restarts allowed for a container of a pod in the namespace, how
+// more restarts are reported, and the rule that sets them, or nil for the default. Containers that
+// no rule matches fail the test.
+func (a *Allowlist) maxRestarts(namespace, container string) (int, Violation, *Rule) {
+	for i := range a.Rules {
+		rule := &a.Rules[i]
+		if rule.namespace.MatchString(namespace) && rule.container.MatchString(container) {
+			return rule.MaxRestarts, rule.Violation, rule
+		}
+	}
+	return a.DefaultMaxRestarts, ViolationFailure, nil
+}
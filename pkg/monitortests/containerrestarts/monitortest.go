@@ -0,0 +1,102 @@
+// Package containerrestarts reports the containers of platform namespaces that restart more often than
+// the thresholds of an allowlist during the run. The restarts are the increases of the restart count
+// of each container recorded in the reason/Restarted intervals of the pod monitor. Containers over
+// their threshold fail the test, unless the rule of the allowlist that matches them reports them as
+// a flake.
+package containerrestarts
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"k8s.io/client-go/rest"
+
+	"github.com/openshift/origin/pkg/monitor/monitorapi"
+	"github.com/openshift/origin/pkg/monitortestframework"
+	"github.com/openshift/origin/pkg/test/ginkgo/junitapi"
+)
+
+const testName = "[sig-arch] platform containers should not restart more often than their allowlist allows"
+
+func init() {
+	monitortestframework.Register("container-restarts", monitortestframework.MonitorTestFuncs{
+		EvaluateInvariantsFunc: evaluateInvariants,
+	})
+}
+
+func evaluateInvariants(events monitorapi.Intervals, _ time.Duration, _ *rest.Config, _ string, _ *monitorapi.ResourcesMap) []*junitapi.JUnitTestCase {
+	failures, flakes := restartOffenders(events, currentAllowlist())
+	if len(failures) == 0 && len(flakes) == 0 {
+		return []*junitapi.JUnitTestCase{{Name: testName}}
+	}
+
+	var sections []string
+	if len(failures) > 0 {
+		sections = append(sections, fmt.Sprintf("%d containers restarted more often than allowed, fix the component or add a rule with a bug to the container restart allowlist:\n\n%s", len(failures), strings.Join(failures, "\n")))
+	}
+	if len(flakes) > 0 {
+		sections = append(sections, fmt.Sprintf("%d containers restarted more often than allowed by rules that report them as a flake:\n\n%s", len(flakes), strings.Join(flakes, "\n")))
+	}
+	output := strings.Join(sections, "\n\n")
+	failure := &junitapi.JUnitTestCase{
+		Name: testName,
+		FailureOutput: &junitapi.FailureOutput{
+			Output: output,
+		},
+		SystemOut: output,
+	}
+	if len(failures) > 0 {
+		return []*junitapi.JUnitTestCase{failure}
+	}
+	// every offender is matched by a rule that reports it as a flake
+	return []*junitapi.JUnitTestCase{failure, {Name: testName}}
+}
+
+// restartOffenders returns a description of every container of a checked namespace that restarted
+// more often than the allowlist allows, split into those that fail the test and those reported as a
+// flake, sorted by locator.
+func restartOffenders(events monitorapi.Intervals, allowlist *Allowlist) (failures, flakes []string) {
+	restarts := map[monitorapi.ContainerReference]int{}
+	for _, event := range events {
+		if monitorapi.ReasonFrom(event.Message) != "Restarted" {
+			continue
+		}
+		container := monitorapi.ContainerFrom(event.Locator)
+		if len(container.ContainerName) == 0 || !allowlist.checked(container.Pod.Namespace) {
+			continue
+		}
+		restarts[container] += restartsFrom(event.Message)
+	}
+
+	for container, count := range restarts {
+		allowed, violation, rule := allowlist.maxRestarts(container.Pod.Namespace, container.ContainerName)
+		if count <= allowed {
+			continue
+		}
+		offender := fmt.Sprintf("%s restarted %d times, %d allowed", container.ToLocator(), count, allowed)
+		if rule != nil {
+			offender += fmt.Sprintf(" by rule %q", rule.Reason)
+		}
+		if violation == ViolationFlake {
+			flakes = append(flakes, offender)
+		} else {
+			failures = append(failures, offender)
+		}
+	}
+	sort.Strings(failures)
+	sort.Strings(flakes)
+	return failures, flakes
+}
+
+// restartsFrom returns the increase of the restart count recorded in the message of a reason/Restarted
+// interval. Intervals recorded without it are one restart.
+func restartsFrom(message string) int {
+	restarts, err := strconv.Atoi(monitorapi.AnnotationsFromMessage(message)["restarts"])
+	if err != nil || restarts < 1 {
+		return 1
+	}
+	return restarts
+}
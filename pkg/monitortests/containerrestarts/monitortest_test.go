@@ -0,0 +1,126 @@
+package containerrestarts
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/openshift/origin/pkg/monitor/monitorapi"
+)
+
+func restarted(namespace, pod, uid, container string) monitorapi.EventInterval {
+	return monitorapi.EventInterval{
+		Condition: monitorapi.Condition{
+			Level:   monitorapi.Warning,
+			Locator: "ns/" + namespace + " pod/" + pod + " node/worker-1 uid/" + uid + " container/" + container,
+			Message: "reason/Restarted",
+		},
+	}
+}
+
+func repeat(n int, event monitorapi.EventInterval) monitorapi.Intervals {
+	var events monitorapi.Intervals
+	for i := 0; i < n; i++ {
+		events = append(events, event)
+	}
+	return events
+}
+
+func TestRestartOffenders(t *testing.T) {
+	allowlist, err := ParseAllowlist([]byte(`
+namespaces:
+- '^openshift-'
+defaultMaxRestarts: 1
+rules:
+- namespace: '^openshift-etcd$'
+  container: '^guard$'
+  maxRestarts: 5
+  reason: https://issues.redhat.com/browse/OCPBUGS-1
+- namespace: '^openshift-monitoring$'
+  maxRestarts: 0
+  reason: restarts of monitoring are regressions
+- namespace: '^openshift-ingress$'
+  maxRestarts: 0
+  violation: Flake
+  reason: https://issues.redhat.com/browse/OCPBUGS-2
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var events monitorapi.Intervals
+	// within the default threshold
+	events = append(events, restarted("openshift-dns", "dns-1", "a", "dns"))
+	// over the default threshold in a single update
+	multiple := restarted("openshift-dns", "dns-3", "f", "dns")
+	multiple.Message = "reason/Restarted restarts/3"
+	events = append(events, multiple)
+	// over the default threshold
+	events = append(events, repeat(2, restarted("openshift-dns", "dns-2", "b", "dns"))...)
+	// within a container rule
+	events = append(events, repeat(5, restarted("openshift-etcd", "guard-1", "c", "guard"))...)
+	// over a namespace rule
+	events = append(events, restarted("openshift-monitoring", "prometheus-0", "d", "prometheus"))
+	// over a rule reporting a flake
+	events = append(events, restarted("openshift-ingress", "router-1", "g", "router"))
+	// namespaces that are not checked
+	events = append(events, repeat(10, restarted("e2e-test", "client", "e", "client"))...)
+	// other pod events
+	events = append(events, monitorapi.EventInterval{Condition: monitorapi.Condition{Locator: "ns/openshift-dns pod/dns-2 node/worker-1 uid/b container/dns", Message: "reason/Ready"}})
+
+	want := []string{
+		"ns/openshift-dns pod/dns-2 uid/b container/dns restarted 2 times, 1 allowed",
+		"ns/openshift-dns pod/dns-3 uid/f container/dns restarted 3 times, 1 allowed",
+		`ns/openshift-monitoring pod/prometheus-0 uid/d container/prometheus restarted 1 times, 0 allowed by rule "restarts of monitoring are regressions"`,
+	}
+	wantFlakes := []string{
+		`ns/openshift-ingress pod/router-1 uid/g container/router restarted 1 times, 0 allowed by rule "https://issues.redhat.com/browse/OCPBUGS-2"`,
+	}
+	got, gotFlakes := restartOffenders(events, allowlist)
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected offenders:\n%s\ngot:\n%s", strings.Join(want, "\n"), strings.Join(got, "\n"))
+	}
+	if !reflect.DeepEqual(gotFlakes, wantFlakes) {
+		t.Errorf("expected flakes:\n%s\ngot:\n%s", strings.Join(wantFlakes, "\n"), strings.Join(gotFlakes, "\n"))
+	}
+}
+
+func TestEvaluateInvariants(t *testing.T) {
+	defer SetAllowlist(nil)
+
+	results := evaluateInvariants(nil, time.Hour, nil, "", nil)
+	if len(results) != 1 || results[0].Name != testName || results[0].FailureOutput != nil {
+		t.Fatalf("expected a passing result, got %#v", results)
+	}
+
+	SetAllowlist(mustParseAllowlist([]byte("namespaces: ['^openshift-']\ndefaultMaxRestarts: 0\nrules:\n- namespace: '^openshift-ingress$'\n  violation: Flake\n  reason: bug\n")))
+	dns := restarted("openshift-dns", "dns-1", "a", "dns")
+	router := restarted("openshift-ingress", "router-1", "b", "router")
+	results = evaluateInvariants(monitorapi.Intervals{dns, router}, time.Hour, nil, "", nil)
+	if len(results) != 1 || results[0].FailureOutput == nil || !strings.Contains(results[0].FailureOutput.Output, "1 containers restarted more often than allowed, fix") {
+		t.Fatalf("expected a failing result, got %#v", results)
+	}
+	results = evaluateInvariants(monitorapi.Intervals{router}, time.Hour, nil, "", nil)
+	if len(results) != 2 || results[0].FailureOutput == nil || !strings.Contains(results[0].FailureOutput.Output, "report them as a flake") || results[1].FailureOutput != nil {
+		t.Fatalf("expected a flaking result, got %#v", results)
+	}
+}
+
+func TestParseAllowlist(t *testing.T) {
+	for _, data := range []string{
+		"defaultMaxRestarts: -1",
+		"namespaces: ['[']",
+		"rules:\n- maxRestarts: 1",
+		"rules:\n- container: '['\n  reason: bug",
+		"rules:\n- violation: Ignore\n  reason: bug",
+		"unknown: true",
+	} {
+		if _, err := ParseAllowlist([]byte(data)); err == nil {
+			t.Errorf("expected an error for %q", data)
+		}
+	}
+	if _, err := ParseAllowlist(defaultAllowlistData); err != nil {
+		t.Errorf("the default allowlist is invalid: %v", err)
+	}
+}
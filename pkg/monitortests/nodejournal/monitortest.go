@@ -0,0 +1,245 @@
+// Package nodejournal collects the kubelet and crio journals of nodes around failures while the
+// cluster is monitored: around a failed test from the nodes named by the warnings and errors recorded
+// near the failure, and around a node becoming not ready from that node. The journals of busy nodes
+// rotate quickly, so by the time must-gather runs the journal of an early failure is often gone.
+//
+// The journals are written to node-journals/<node>/<window> under ARTIFACT_DIR, and every collected
+// window is recorded as an interval on the node that names the directory.
+package nodejournal
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+
+	"github.com/openshift/origin/pkg/monitor"
+	"github.com/openshift/origin/pkg/monitor/monitorapi"
+	"github.com/openshift/origin/pkg/monitor/nodedetails"
+	"github.com/openshift/origin/pkg/monitortestframework"
+)
+
+const (
+	// window is how long before and after a failure the journals are collected.
+	window = 2 * time.Minute
+	// pollInterval is how often the recorded intervals are checked for failures.
+	pollInterval = 30 * time.Second
+	// maxWindows bounds the journal windows collected in a run, so a run with many failures does not
+	// overload the kubelets.
+	maxWindows = 50
+
+	journalDirName = "node-journals"
+
+	triggerTestFailed   = "TestFailed"
+	triggerNodeNotReady = "NodeNotReady"
+)
+
+// units are the systemd units whose journals are collected.
+var units = []string{"kubelet", "crio"}
+
+// testFailedRe matches the result the runner records when a test fails.
+var testFailedRe = regexp.MustCompile(`finishedStatus/Failed\b`)
+
+// nodeNotReadyRe matches the condition change the node monitor records when a node stops being ready.
+var nodeNotReadyRe = regexp.MustCompile(`condition/Ready status/(False|Unknown)\b`)
+
+func init() {
+	monitortestframework.Register("node-journal-windows", monitortestframework.MonitorTestFuncs{
+		StartCollectionFunc: startCollection,
+	})
+}
+
+// intervalSource is implemented by the monitor, which passes itself as the recorder.
+type intervalSource interface {
+	Intervals(from, to time.Time) monitorapi.Intervals
+}
+
+// fetchFunc returns the journal of unit on node between since and until.
+type fetchFunc func(ctx context.Context, node, unit string, since, until time.Time) ([]byte, error)
+
+func startCollection(ctx context.Context, recorder monitor.Recorder, clusterConfig *rest.Config) error {
+	artifactDir := os.Getenv("ARTIFACT_DIR")
+	source, ok := recorder.(intervalSource)
+	if len(artifactDir) == 0 || !ok {
+		return nil
+	}
+	client, err := kubernetes.NewForConfig(clusterConfig)
+	if err != nil {
+		return err
+	}
+	c := newCollector(artifactDir, recorder, source, func(ctx context.Context, node, unit string, since, until time.Time) ([]byte, error) {
+		return nodedetails.GetNodeLogWindow(ctx, client, node, unit, since, until)
+	})
+	go wait.UntilWithContext(ctx, func(ctx context.Context) { c.poll(ctx, time.Now()) }, pollInterval)
+	return nil
+}
+
+// collector turns the failures recorded by the monitor into journal windows once the window after
+// each failure has passed.
+type collector struct {
+	artifactDir string
+	recorder    monitor.Recorder
+	intervals   intervalSource
+	fetch       fetchFunc
+
+	// processedUntil is the time up to which failures have been turned into windows.
+	processedUntil time.Time
+	// collectedUntil is the end of the last window collected from each node, so overlapping windows
+	// only collect the part of the journal not collected yet.
+	collectedUntil map[string]time.Time
+	windows        int
+}
+
+func newCollector(artifactDir string, recorder monitor.Recorder, intervals intervalSource, fetch fetchFunc) *collector {
+	return &collector{
+		artifactDir:    artifactDir,
+		recorder:       recorder,
+		intervals:      intervals,
+		fetch:          fetch,
+		processedUntil: time.Now(),
+		collectedUntil: map[string]time.Time{},
+	}
+}
+
+// trigger is a failure the journals of nodes are collected around.
+type trigger struct {
+	reason string
+	at     time.Time
+	nodes  []string
+}
+
+// poll collects the journals around the failures whose window ended by now.
+func (c *collector) poll(ctx context.Context, now time.Time) {
+	until := now.Add(-window)
+	if !until.After(c.processedUntil) {
+		return
+	}
+	intervals := c.intervals.Intervals(c.processedUntil.Add(-window), time.Time{})
+	for _, t := range findTriggers(intervals, c.processedUntil, until) {
+		for _, node := range t.nodes {
+			if c.windows >= maxWindows {
+				if c.windows == maxWindows {
+					c.windows++
+					c.recorder.Record(monitorapi.Condition{
+						Level:   monitorapi.Info,
+						Locator: monitorapi.NodeLocator(node),
+						Message: fmt.Sprintf("reason/NodeJournalCollectionLimit no more than %d journal windows are collected in a run", maxWindows),
+					})
+				}
+				break
+			}
+			c.collect(ctx, t, node)
+		}
+	}
+	c.processedUntil = until
+}
+
+// collect writes the journals of node around the trigger, without the part already collected.
+func (c *collector) collect(ctx context.Context, t trigger, node string) {
+	from, to := t.at.Add(-window), t.at.Add(window)
+	if collected := c.collectedUntil[node]; collected.After(from) {
+		from = collected
+	}
+	if !from.Before(to) {
+		return
+	}
+	c.collectedUntil[node] = to
+	c.windows++
+
+	rel := filepath.Join(journalDirName, node, from.UTC().Format("20060102-150405"))
+	dir := filepath.Join(c.artifactDir, rel)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		c.recordFailure(node, t, err)
+		return
+	}
+	collected := 0
+	for _, unit := range units {
+		data, err := c.fetch(ctx, node, unit, from, to)
+		if err != nil {
+			c.recordFailure(node, t, fmt.Errorf("unable to read the %s journal: %v", unit, err))
+			continue
+		}
+		if err := os.WriteFile(filepath.Join(dir, unit+".log"), data, 0644); err != nil {
+			c.recordFailure(node, t, err)
+			continue
+		}
+		collected++
+	}
+	if collected == 0 {
+		return
+	}
+	interval := c.recorder.StartInterval(from, monitorapi.Condition{
+		Level:   monitorapi.Info,
+		Locator: monitorapi.NodeLocator(node),
+		Message: fmt.Sprintf("reason/NodeJournalCollected trigger/%s path/%s", t.reason, filepath.ToSlash(rel)),
+	})
+	c.recorder.EndInterval(interval, to)
+}
+
+func (c *collector) recordFailure(node string, t trigger, err error) {
+	c.recorder.Record(monitorapi.Condition{
+		Level:   monitorapi.Warning,
+		Locator: monitorapi.NodeLocator(node),
+		Message: fmt.Sprintf("reason/NodeJournalCollectionFailed trigger/%s %v", t.reason, err),
+	})
+}
+
+// findTriggers returns the failures of intervals that happened after from and no later than to, in
+// order. A failed test triggers collection from the nodes named by the warnings and errors recorded
+// within the window around it, and is skipped if there are none.
+func findTriggers(intervals monitorapi.Intervals, from, to time.Time) []trigger {
+	var triggers []trigger
+	for _, interval := range intervals {
+		if !interval.From.After(from) || interval.From.After(to) {
+			continue
+		}
+		switch {
+		case monitorapi.IsE2ETest(interval.Locator) && testFailedRe.MatchString(interval.Message):
+			if nodes := affectedNodes(intervals, interval.From); len(nodes) > 0 {
+				triggers = append(triggers, trigger{reason: triggerTestFailed, at: interval.From, nodes: nodes})
+			}
+		case nodeNotReadyRe.MatchString(interval.Message):
+			if node, ok := monitorapi.NodeFromLocator(interval.Locator); ok {
+				triggers = append(triggers, trigger{reason: triggerNodeNotReady, at: interval.From, nodes: []string{node}})
+			}
+		}
+	}
+	sort.SliceStable(triggers, func(i, j int) bool { return triggers[i].at.Before(triggers[j].at) })
+	return triggers
+}
+
+// affectedNodes returns the nodes named by the warnings and errors recorded within the window around
+// at, other than those of tests and of the collection of journals.
+func affectedNodes(intervals monitorapi.Intervals, at time.Time) []string {
+	from, to := at.Add(-window), at.Add(window)
+	seen := map[string]bool{}
+	var nodes []string
+	for _, interval := range intervals {
+		if interval.Level < monitorapi.Warning || monitorapi.IsE2ETest(interval.Locator) || strings.HasPrefix(monitorapi.ReasonFrom(interval.Message), "NodeJournal") {
+			continue
+		}
+		end := interval.To
+		if end.IsZero() {
+			end = to
+		}
+		if interval.From.After(to) || end.Before(from) {
+			continue
+		}
+		node := monitorapi.LocatorParts(interval.Locator)["node"]
+		if len(node) == 0 || seen[node] {
+			continue
+		}
+		seen[node] = true
+		nodes = append(nodes, node)
+	}
+	sort.Strings(nodes)
+	return nodes
+}
@@ -0,0 +1,105 @@
+package nodejournal
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/openshift/origin/pkg/monitor"
+	"github.com/openshift/origin/pkg/monitor/monitorapi"
+)
+
+func TestFindTriggers(t *testing.T) {
+	start := time.Date(2023, 5, 1, 10, 0, 0, 0, time.UTC)
+	at := func(d time.Duration) time.Time { return start.Add(d) }
+	interval := func(from time.Duration, level monitorapi.EventLevel, locator, message string) monitorapi.EventInterval {
+		return monitorapi.EventInterval{
+			Condition: monitorapi.Condition{Level: level, Locator: locator, Message: message},
+			From:      at(from),
+			To:        at(from),
+		}
+	}
+	intervals := monitorapi.Intervals{
+		interval(time.Minute, monitorapi.Warning, "ns/e2e-test-1 pod/client node/worker-b uid/a container/client", "reason/Restarted"),
+		interval(2*time.Minute, monitorapi.Error, "e2e-test/\"[sig-network] a\"", "finishedStatus/Failed"),
+		interval(2*time.Minute, monitorapi.Info, "ns/e2e-test-1 pod/server node/worker-c uid/b", "reason/Scheduled"),
+		interval(3*time.Minute, monitorapi.Warning, "node/worker-a", "condition/Ready status/False reason/KubeletNotReady roles/worker changed"),
+		interval(3*time.Minute, monitorapi.Warning, "node/worker-d", "reason/NodeJournalCollectionFailed trigger/TestFailed timeout"),
+		interval(5*time.Minute, monitorapi.Error, "e2e-test/\"[sig-network] b\"", "finishedStatus/Failed  reason/Timeout"),
+		interval(10*time.Minute, monitorapi.Error, "e2e-test/\"[sig-network] c\"", "finishedStatus/Failed"),
+		interval(11*time.Minute, monitorapi.Info, "e2e-test/\"[sig-network] d\"", "finishedStatus/Passed"),
+		interval(13*time.Minute, monitorapi.Warning, "node/worker-b", "condition/Ready status/True reason/KubeletReady roles/worker changed"),
+		interval(20*time.Minute, monitorapi.Warning, "node/worker-c", "condition/Ready status/Unknown reason/NodeStatusUnknown roles/worker changed"),
+	}
+
+	want := []trigger{
+		{reason: triggerTestFailed, at: at(2 * time.Minute), nodes: []string{"worker-a", "worker-b"}},
+		{reason: triggerNodeNotReady, at: at(3 * time.Minute), nodes: []string{"worker-a"}},
+		{reason: triggerTestFailed, at: at(5 * time.Minute), nodes: []string{"worker-a"}},
+	}
+	if got := findTriggers(intervals, start, at(15*time.Minute)); !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected triggers:\n%#v", got)
+	}
+}
+
+func TestCollect(t *testing.T) {
+	dir := t.TempDir()
+	m := monitor.NewMonitor()
+	var fetched []string
+	fetch := func(ctx context.Context, node, unit string, since, until time.Time) ([]byte, error) {
+		fetched = append(fetched, node+"/"+unit+" "+until.Sub(since).String())
+		if unit == "crio" {
+			return nil, errors.New("forbidden")
+		}
+		return []byte(unit + " journal of " + node), nil
+	}
+	c := newCollector(dir, m, m, fetch)
+
+	failedAt := time.Now().Add(-10 * time.Minute)
+	c.processedUntil = failedAt.Add(-time.Minute)
+	m.RecordAt(failedAt.Add(-30*time.Second), monitorapi.Condition{Level: monitorapi.Warning, Locator: "node/worker-a", Message: "condition/Ready status/False reason/KubeletNotReady roles/worker changed"})
+	m.RecordAt(failedAt, monitorapi.Condition{Level: monitorapi.Error, Locator: monitorapi.E2ETestLocator("[sig-network] a"), Message: "finishedStatus/Failed"})
+	c.poll(context.Background(), time.Now())
+
+	// the failed test only collects the part of the journal after the not ready window
+	wantFetched := []string{"worker-a/kubelet 4m0s", "worker-a/crio 4m0s", "worker-a/kubelet 30s", "worker-a/crio 30s"}
+	if !reflect.DeepEqual(fetched, wantFetched) {
+		t.Errorf("unexpected journals fetched %v", fetched)
+	}
+
+	var collected, failed []string
+	for _, interval := range m.Intervals(time.Time{}, time.Time{}) {
+		switch monitorapi.ReasonFrom(interval.Message) {
+		case "NodeJournalCollected":
+			collected = append(collected, interval.Message)
+		case "NodeJournalCollectionFailed":
+			failed = append(failed, interval.Message)
+		}
+	}
+	if len(collected) != 2 || len(failed) != 2 {
+		t.Fatalf("expected two collected windows and two failures, got %v and %v", collected, failed)
+	}
+	if !strings.Contains(collected[0], "trigger/NodeNotReady path/node-journals/worker-a/") {
+		t.Errorf("unexpected interval %q", collected[0])
+	}
+	path := strings.TrimPrefix(collected[0][strings.Index(collected[0], "path/"):], "path/")
+	data, err := os.ReadFile(filepath.Join(dir, path, "kubelet.log"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "kubelet journal of worker-a" {
+		t.Errorf("unexpected journal %q", data)
+	}
+
+	// failures are only processed once
+	fetched = nil
+	c.poll(context.Background(), time.Now().Add(time.Second))
+	if len(fetched) != 0 {
+		t.Errorf("expected no journals to be fetched again, got %v", fetched)
+	}
+}
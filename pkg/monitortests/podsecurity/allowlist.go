@@ -0,0 +1,136 @@
+package podsecurity
+
+import (
+	_ "embed"
+	"fmt"
+	"os"
+	"regexp"
+	"sync"
+
+	"sigs.k8s.io/yaml"
+)
+
+// Violation is how a pod security violation is reported.
+type Violation string
+
+const (
+	// ViolationFailure fails the test.
+	ViolationFailure Violation = "Failure"
+	// ViolationFlake reports the test as a flake.
+	ViolationFlake Violation = "Flake"
+	// ViolationAllowed does not report the violation.
+	ViolationAllowed Violation = "Allowed"
+)
+
+// Allowlist sets how the pod security violations of a run are reported. Violations that no rule
+// matches fail the test. It is data, so the rules can change without a code change to this package.
+type Allowlist struct {
+	// Rules are consulted in order, and the first rule that matches a violation sets how it is
+	// reported.
+	Rules []Rule `json:"rules"`
+}
+
+// Rule sets how the violations that match its patterns are reported.
+type Rule struct {
+	// Namespace is a pattern of the namespace of the workload. An empty pattern matches every
+	// namespace.
+	Namespace string `json:"namespace,omitempty"`
+	// User is a pattern of the user that created or updated the workload. An empty pattern matches
+	// every user.
+	User string `json:"user,omitempty"`
+	// Violation defaults to Failure.
+	Violation Violation `json:"violation,omitempty"`
+	// Reason explains why the violations are expected, usually with a link to a bug.
+	Reason string `json:"reason"`
+
+	namespace, user *regexp.Regexp
+}
+
+//go:embed allowlist.yaml
+var defaultAllowlistData []byte
+
+var (
+	allowlistLock sync.Mutex
+	// allowlist is the allowlist in use, the default unless one was loaded
+	allowlist = mustParseAllowlist(defaultAllowlistData)
+)
+
+// ParseAllowlist parses, defaults and validates a YAML or JSON pod security violation allowlist.
+func ParseAllowlist(data []byte) (*Allowlist, error) {
+	allowlist := &Allowlist{}
+	if err := yaml.UnmarshalStrict(data, allowlist); err != nil {
+		return nil, err
+	}
+	for i := range allowlist.Rules {
+		rule := &allowlist.Rules[i]
+		switch rule.Violation {
+		case "":
+			rule.Violation = ViolationFailure
+		case ViolationFailure, ViolationFlake, ViolationAllowed:
+		default:
+			return nil, fmt.Errorf("rules[%d]: violation must be %s, %s or %s", i, ViolationFailure, ViolationFlake, ViolationAllowed)
+		}
+		if len(rule.Reason) == 0 {
+			return nil, fmt.Errorf("rules[%d]: reason is required", i)
+		}
+		var err error
+		if rule.namespace, err = regexp.Compile(rule.Namespace); err != nil {
+			return nil, fmt.Errorf("rules[%d]: namespace: %v", i, err)
+		}
+		if rule.user, err = regexp.Compile(rule.User); err != nil {
+			return nil, fmt.Errorf("rules[%d]: user: %v", i, err)
+		}
+	}
+	return allowlist, nil
+}
+
+func mustParseAllowlist(data []byte) *Allowlist {
+	allowlist, err := ParseAllowlist(data)
+	if err != nil {
+		panic(err)
+	}
+	return allowlist
+}
+
+// LoadAllowlist reads the allowlist at path and uses it instead of the default allowlist for the
+// rest of the process.
+func LoadAllowlist(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	loaded, err := ParseAllowlist(data)
+	if err != nil {
+		return fmt.Errorf("invalid pod security allowlist %s: %v", path, err)
+	}
+	SetAllowlist(loaded)
+	return nil
+}
+
+// SetAllowlist sets the allowlist in use. A nil allowlist restores the default.
+func SetAllowlist(a *Allowlist) {
+	allowlistLock.Lock()
+	defer allowlistLock.Unlock()
+	if a == nil {
+		a = mustParseAllowlist(defaultAllowlistData)
+	}
+	allowlist = a
+}
+
+func currentAllowlist() *Allowlist {
+	allowlistLock.Lock()
+	defer allowlistLock.Unlock()
+	return allowlist
+}
+
+// violation returns how a violation by user in the namespace is reported, and the rule that sets it,
+// or nil for the default.
+func (a *Allowlist) violation(namespace, user string) (Violation, *Rule) {
+	for i := range a.Rules {
+		rule := &a.Rules[i]
+		if rule.namespace.MatchString(namespace) && rule.user.MatchString(user) {
+			return rule.Violation, rule
+		}
+	}
+	return ViolationFailure, nil
+}
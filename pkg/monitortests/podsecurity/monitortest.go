@@ -0,0 +1,78 @@
+// Package podsecurity reports workloads that violate the pod security audit level of their namespace
+// during the run. The violations are read from the pod-security.kubernetes.io/audit-violations
+// annotation of the kube-apiserver audit events when the monitor ends. Violations in any namespace
+// fail the test unless a rule of the allowlist reports them as a flake or allows them.
+package podsecurity
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"k8s.io/client-go/rest"
+
+	"github.com/openshift/origin/pkg/monitor/monitorapi"
+	"github.com/openshift/origin/pkg/monitortestframework"
+	"github.com/openshift/origin/pkg/test/ginkgo/junitapi"
+)
+
+const testName = "[sig-auth] workloads should not violate the pod security audit level of their namespace"
+
+func init() {
+	monitortestframework.Register("pod-security-violations", monitortestframework.MonitorTestFuncs{
+		EvaluateInvariantsFunc: evaluateInvariants,
+	})
+}
+
+func evaluateInvariants(events monitorapi.Intervals, _ time.Duration, _ *rest.Config, _ string, _ *monitorapi.ResourcesMap) []*junitapi.JUnitTestCase {
+	failures, flakes := violationOffenders(events, currentAllowlist())
+	if len(failures) == 0 && len(flakes) == 0 {
+		return []*junitapi.JUnitTestCase{{Name: testName}}
+	}
+
+	var sections []string
+	if len(failures) > 0 {
+		sections = append(sections, fmt.Sprintf("found %d pod security audit violations, label the namespace or fix the workload:\n\n%s", len(failures), strings.Join(failures, "\n")))
+	}
+	if len(flakes) > 0 {
+		sections = append(sections, fmt.Sprintf("found %d pod security audit violations the allowlist reports as a flake:\n\n%s", len(flakes), strings.Join(flakes, "\n")))
+	}
+	output := strings.Join(sections, "\n\n")
+	failure := &junitapi.JUnitTestCase{
+		Name: testName,
+		FailureOutput: &junitapi.FailureOutput{
+			Output: output,
+		},
+		SystemOut: output,
+	}
+	if len(failures) > 0 {
+		return []*junitapi.JUnitTestCase{failure}
+	}
+	// every violation is matched by a rule that reports it as a flake
+	return []*junitapi.JUnitTestCase{failure, {Name: testName}}
+}
+
+// violationOffenders returns a description of every pod security violation that fails the test and
+// of every violation reported as a flake, sorted by locator. Allowed violations are left out.
+func violationOffenders(events monitorapi.Intervals, allowlist *Allowlist) (failures, flakes []string) {
+	for _, event := range events {
+		if monitorapi.ReasonFrom(event.Message) != monitorapi.PodSecurityReasonViolation {
+			continue
+		}
+		violation, rule := allowlist.violation(monitorapi.NamespaceFromLocator(event.Locator), monitorapi.AnnotationsFromMessage(event.Message)["user"])
+		offender := fmt.Sprintf("%s %s", event.Locator, strings.TrimPrefix(event.Message, fmt.Sprintf("reason/%s ", monitorapi.PodSecurityReasonViolation)))
+		if rule != nil {
+			offender += fmt.Sprintf(" (rule %q)", rule.Reason)
+		}
+		switch violation {
+		case ViolationFailure:
+			failures = append(failures, offender)
+		case ViolationFlake:
+			flakes = append(flakes, offender)
+		}
+	}
+	sort.Strings(failures)
+	sort.Strings(flakes)
+	return failures, flakes
+}
@@ -0,0 +1,148 @@
+package podsecurity
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/openshift/origin/pkg/monitor/monitorapi"
+)
+
+func violation(locator, message string) monitorapi.EventInterval {
+	return monitorapi.EventInterval{
+		Condition: monitorapi.Condition{
+			Level:   monitorapi.Warning,
+			Locator: locator,
+			Message: message,
+		},
+	}
+}
+
+func TestViolationOffenders(t *testing.T) {
+	allowlist, err := ParseAllowlist([]byte(`
+rules:
+- namespace: '^openshift-bar$'
+  user: '^system:serviceaccount:kube-system:'
+  violation: Flake
+  reason: https://issues.redhat.com/browse/OCPBUGS-1
+- namespace: '^e2e-'
+  violation: Allowed
+  reason: tests violate on purpose
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	events := monitorapi.Intervals{
+		violation("ns/openshift-foo pod/foo", "reason/Created"),
+		violation("ns/openshift-foo resource/deployments name/foo", `reason/PodSecurityViolation user/admin would violate PodSecurity "restricted:latest": runAsNonRoot != true`),
+		violation("ns/openshift-bar resource/pods", `reason/PodSecurityViolation user/system:serviceaccount:kube-system:replicaset-controller would violate PodSecurity "restricted:latest": privileged`),
+		// the rule for openshift-bar only matches the controllers
+		violation("ns/openshift-bar resource/pods", `reason/PodSecurityViolation user/admin would violate PodSecurity "restricted:latest": privileged`),
+		violation("ns/e2e-test-foo-x7d2k resource/pods", `reason/PodSecurityViolation user/admin would violate PodSecurity "restricted:latest": privileged`),
+	}
+	failures, flakes := violationOffenders(events, allowlist)
+	wantFailures := []string{
+		`ns/openshift-bar resource/pods user/admin would violate PodSecurity "restricted:latest": privileged`,
+		`ns/openshift-foo resource/deployments name/foo user/admin would violate PodSecurity "restricted:latest": runAsNonRoot != true`,
+	}
+	wantFlakes := []string{
+		`ns/openshift-bar resource/pods user/system:serviceaccount:kube-system:replicaset-controller would violate PodSecurity "restricted:latest": privileged (rule "https://issues.redhat.com/browse/OCPBUGS-1")`,
+	}
+	if !reflect.DeepEqual(failures, wantFailures) {
+		t.Errorf("expected failures:\n%s\ngot:\n%s", strings.Join(wantFailures, "\n"), strings.Join(failures, "\n"))
+	}
+	if !reflect.DeepEqual(flakes, wantFlakes) {
+		t.Errorf("expected flakes:\n%s\ngot:\n%s", strings.Join(wantFlakes, "\n"), strings.Join(flakes, "\n"))
+	}
+}
+
+func TestEvaluateInvariants(t *testing.T) {
+	defer SetAllowlist(nil)
+	platform := violation("ns/openshift-foo resource/pods", `reason/PodSecurityViolation user/admin would violate PodSecurity "restricted:latest": privileged`)
+	e2e := violation("ns/e2e-test-foo-x7d2k resource/pods", `reason/PodSecurityViolation user/admin would violate PodSecurity "restricted:latest": privileged`)
+
+	tests := []struct {
+		name      string
+		allowlist string
+		events    monitorapi.Intervals
+		// want is the failure output of each result, empty for a pass
+		want []string
+	}{
+		{
+			name:   "no violations",
+			events: monitorapi.Intervals{violation("ns/openshift-foo pod/foo", "reason/Created")},
+			want:   []string{""},
+		},
+		{
+			name:   "violations allowed by the default allowlist",
+			events: monitorapi.Intervals{e2e},
+			want:   []string{""},
+		},
+		{
+			name:   "violations fail by default",
+			events: monitorapi.Intervals{platform, e2e},
+			want:   []string{"found 1 pod security audit violations, label the namespace or fix the workload:\n\nns/openshift-foo resource/pods user/admin"},
+		},
+		{
+			name:      "violations reported as a flake",
+			allowlist: "rules:\n- namespace: '^openshift-foo$'\n  violation: Flake\n  reason: bug",
+			events:    monitorapi.Intervals{platform},
+			want:      []string{"found 1 pod security audit violations the allowlist reports as a flake", ""},
+		},
+		{
+			name:      "a failure is not downgraded by flakes",
+			allowlist: "rules:\n- namespace: '^openshift-foo$'\n  violation: Flake\n  reason: bug",
+			events:    monitorapi.Intervals{platform, e2e},
+			want:      []string{"found 1 pod security audit violations, label the namespace or fix the workload"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetAllowlist(nil)
+			if len(tt.allowlist) > 0 {
+				SetAllowlist(mustParseAllowlist([]byte(tt.allowlist)))
+			}
+			results := evaluateInvariants(tt.events, time.Hour, nil, "", nil)
+			if len(results) != len(tt.want) {
+				t.Fatalf("expected %d results, got %#v", len(tt.want), results)
+			}
+			for i, want := range tt.want {
+				if results[i].Name != testName {
+					t.Errorf("unexpected name %q", results[i].Name)
+				}
+				if len(want) == 0 {
+					if results[i].FailureOutput != nil {
+						t.Errorf("expected result %d to pass, got:\n%s", i, results[i].FailureOutput.Output)
+					}
+					continue
+				}
+				if results[i].FailureOutput == nil || !strings.Contains(results[i].FailureOutput.Output, want) {
+					t.Errorf("expected result %d to fail with %q, got %#v", i, want, results[i].FailureOutput)
+				}
+			}
+		})
+	}
+}
+
+func TestParseAllowlist(t *testing.T) {
+	for _, data := range []string{
+		"rules:\n- namespace: '^a$'",
+		"rules:\n- namespace: '['\n  reason: bug",
+		"rules:\n- user: '['\n  reason: bug",
+		"rules:\n- violation: Ignore\n  reason: bug",
+		"unknown: true",
+	} {
+		if _, err := ParseAllowlist([]byte(data)); err == nil {
+			t.Errorf("expected an error for %q", data)
+		}
+	}
+	allowlist, err := ParseAllowlist([]byte("rules:\n- reason: bug"))
+	if err != nil || allowlist.Rules[0].Violation != ViolationFailure {
+		t.Errorf("expected the violation to default to %s, got %#v: %v", ViolationFailure, allowlist, err)
+	}
+	if _, err := ParseAllowlist(defaultAllowlistData); err != nil {
+		t.Errorf("the default allowlist is invalid: %v", err)
+	}
+}
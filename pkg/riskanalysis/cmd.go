@@ -26,6 +26,12 @@ type Options struct {
 	Out, ErrOut io.Writer
 	JUnitDir    string
 	SippyURL    string
+	// PassRateFile is the test-pass-rates.json written by a previous run. When set, the pass rates updated with
+	// this run are written to JUnitDir, and the risk is computed from them if sippy is unreachable.
+	PassRateFile string
+	// IncludeIntervals adds a summary of the infrastructure intervals recorded by the monitor to the job run sent
+	// to sippy, and uses it to lower the risk of tests that failed during infrastructure problems.
+	IncludeIntervals bool
 }
 
 const testFailureSummaryFilePrefix = "test-failures-summary"
@@ -73,14 +79,51 @@ func (opt *Options) Run() error {
 		finalProwJobRun.TestCount += pjr.TestCount
 	}
 
+	if opt.IncludeIntervals && finalProwJobRun != nil {
+		intervals, err := loadIntervals(opt.JUnitDir)
+		if err != nil {
+			fmt.Fprintf(opt.ErrOut, "error: Unable to read the intervals of the job run: %v\n", err)
+		} else {
+			var failedTests []string
+			for _, test := range finalProwJobRun.Tests {
+				failedTests = append(failedTests, test.Test.Name)
+			}
+			finalProwJobRun.IntervalSummary = summarizeIntervals(intervals, failedTests)
+		}
+	}
+
 	inputBytes, err := json.Marshal(finalProwJobRun)
 	if err != nil {
 		return errors.Wrap(err, "error marshalling results")
 	}
 
+	// Record the pass rates of this run before the analysis, so they are available to the next run even if the
+	// analysis fails.
+	rates, err := opt.updatePassRates()
+	if err != nil {
+		fmt.Fprintf(opt.ErrOut, "error: Unable to update the test pass rates: %v\n", err)
+	}
+
+	riskAnalysisBytes, err := opt.requestRiskAnalysis(inputBytes)
+	if err != nil {
+		if len(opt.PassRateFile) == 0 || rates == nil || finalProwJobRun == nil {
+			return err
+		}
+		fmt.Fprintf(opt.ErrOut, "warning: %v, computing risk analysis from %s\n", err, opt.PassRateFile)
+		riskAnalysisBytes, err = json.Marshal(localRiskAnalysis(finalProwJobRun, rates.previous, opt.PassRateFile))
+		if err != nil {
+			return errors.Wrap(err, "error marshalling local risk analysis")
+		}
+	}
+
+	return opt.writeRiskAnalysis(riskAnalysisBytes)
+}
+
+// requestRiskAnalysis submits the job run to sippy and returns the risk analysis response.
+func (opt *Options) requestRiskAnalysis(inputBytes []byte) ([]byte, error) {
 	req, err := http.NewRequest("GET", opt.SippyURL, bytes.NewBuffer(inputBytes))
 	if err != nil {
-		return errors.Wrap(err, "error creating GET request during risk analysis")
+		return nil, errors.Wrap(err, "error creating GET request during risk analysis")
 	}
 	req.Header.Set("Content-Type", "application/json")
 	client := &http.Client{}
@@ -107,18 +150,58 @@ func (opt *Options) Run() error {
 		time.Sleep(time.Duration(i*30) * time.Second)
 	}
 	if !clientDoSuccess {
-		return errors.Wrap(err, "unable to obtain risk analysis from sippy after retries")
+		return nil, errors.Wrap(err, "unable to obtain risk analysis from sippy after retries")
 	}
 	defer resp.Body.Close()
 
 	riskAnalysisBytes, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
-		return errors.Wrap(err, "error reading risk analysis request body from sippy")
+		return nil, errors.Wrap(err, "error reading risk analysis request body from sippy")
 	}
 	fmt.Println("response Body:", string(riskAnalysisBytes))
+	return riskAnalysisBytes, nil
+}
+
+// passRateHistory holds the pass rates read from --pass-rate-file, and the pass rates updated with this run.
+type passRateHistory struct {
+	previous *TestPassRates
+	updated  *TestPassRates
+}
+
+// updatePassRates writes the pass rates of --pass-rate-file, updated with the results of this run, to the junit
+// dir. It returns nil pass rates when --pass-rate-file is not set.
+func (opt *Options) updatePassRates() (*passRateHistory, error) {
+	if len(opt.PassRateFile) == 0 {
+		return nil, nil
+	}
+	history := &passRateHistory{}
+	for _, rates := range []**TestPassRates{&history.previous, &history.updated} {
+		loaded, err := LoadTestPassRates(opt.PassRateFile)
+		switch {
+		case os.IsNotExist(err):
+			// the first run of a job has no history to start from
+			loaded = &TestPassRates{Tests: map[string]*TestPassRate{}}
+		case err != nil:
+			return nil, err
+		}
+		*rates = loaded
+	}
+	updated := history.updated
+	if err := updated.addJUnitResults(opt.JUnitDir); err != nil {
+		return history, err
+	}
+	outputFile := filepath.Join(opt.JUnitDir, passRatesFile)
+	if err := updated.write(outputFile); err != nil {
+		return history, errors.Wrap(err, "error writing test pass rates artifact")
+	}
+	fmt.Fprintf(opt.Out, "Successfully wrote: %s\n", outputFile)
+	return history, nil
+}
 
+// writeRiskAnalysis writes the risk analysis json artifact and the html page that renders it in spyglass.
+func (opt *Options) writeRiskAnalysis(riskAnalysisBytes []byte) error {
 	outputFile := filepath.Join(opt.JUnitDir, "risk-analysis.json")
-	err = ioutil.WriteFile(outputFile, riskAnalysisBytes, 0644)
+	err := ioutil.WriteFile(outputFile, riskAnalysisBytes, 0644)
 	if err != nil {
 		return errors.Wrap(err, "error writing risk analysis json artifact")
 	}
@@ -0,0 +1,189 @@
+package riskanalysis
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/openshift/origin/pkg/monitor/monitorapi"
+	monitorserialization "github.com/openshift/origin/pkg/monitor/serialization"
+)
+
+// maxSummaryLocators bounds the locators listed for each category of an interval summary, so the payload stays
+// small on runs with widespread disruption.
+const maxSummaryLocators = 5
+
+// IntervalSummary is a compressed view of the infrastructure problems recorded by the monitor during the job run,
+// sent with the failed tests when --include-intervals is set. It lets the analysis tell a test that failed during
+// a known infrastructure blip from a regression.
+type IntervalSummary struct {
+	// Categories totals the infrastructure intervals of the whole run.
+	Categories []IntervalCategorySummary `json:"categories"`
+	// Tests lists the failed tests and the infrastructure intervals that overlapped their run.
+	Tests []TestIntervalSummary `json:"tests"`
+}
+
+// IntervalCategorySummary counts the intervals of one kind of infrastructure problem, such as disruption of a
+// backend, a node that was not ready, or a cluster operator that was unavailable or degraded.
+type IntervalCategorySummary struct {
+	Category string   `json:"category"`
+	Count    int      `json:"count"`
+	Seconds  float64  `json:"seconds"`
+	Locators []string `json:"locators"`
+}
+
+// TestIntervalSummary describes the infrastructure intervals that overlapped a failed test.
+type TestIntervalSummary struct {
+	Name        string                    `json:"name"`
+	From        time.Time                 `json:"from"`
+	To          time.Time                 `json:"to"`
+	Overlapping []IntervalCategorySummary `json:"overlapping"`
+}
+
+// loadIntervals reads the intervals of every e2e-events file in junitDir.
+func loadIntervals(junitDir string) (monitorapi.Intervals, error) {
+	files, err := filepath.Glob(filepath.Join(junitDir, "e2e-events_*.json"))
+	if err != nil {
+		return nil, err
+	}
+	var intervals monitorapi.Intervals
+	for _, file := range files {
+		events, err := monitorserialization.EventsFromFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read intervals from %s: %v", file, err)
+		}
+		intervals = append(intervals, events...)
+	}
+	return intervals, nil
+}
+
+// infrastructureCategory returns the kind of infrastructure problem an interval records, or an empty string if it
+// does not record one.
+func infrastructureCategory(interval monitorapi.EventInterval) string {
+	if interval.Level != monitorapi.Error || monitorapi.IsE2ETest(interval.Locator) {
+		return ""
+	}
+	switch {
+	case len(monitorapi.LocatorParts(interval.Locator)["disruption"]) > 0:
+		return "disruption"
+	case monitorapi.IsNode(interval.Locator):
+		return "node"
+	case monitorapi.IsOperator(interval.Locator):
+		return "clusteroperator"
+	}
+	return ""
+}
+
+// summarizeIntervals returns the infrastructure intervals of the run, and those that overlapped each of the failed
+// tests. Tests without a recorded run are left out.
+func summarizeIntervals(intervals monitorapi.Intervals, failedTests []string) *IntervalSummary {
+	failed := map[string]bool{}
+	for _, name := range failedTests {
+		failed[name] = true
+	}
+
+	var infrastructure monitorapi.Intervals
+	testRuns := map[string][]monitorapi.EventInterval{}
+	for _, interval := range intervals {
+		if name, ok := monitorapi.E2ETestFromLocator(interval.Locator); ok {
+			if failed[name] && strings.Contains(interval.Message, "e2e test finished") && !interval.To.IsZero() {
+				testRuns[name] = append(testRuns[name], interval)
+			}
+			continue
+		}
+		if len(infrastructureCategory(interval)) > 0 {
+			infrastructure = append(infrastructure, interval)
+		}
+	}
+
+	summary := &IntervalSummary{
+		Categories: summarizeCategories(infrastructure, time.Time{}, time.Time{}),
+		Tests:      []TestIntervalSummary{},
+	}
+	names := make([]string, 0, len(testRuns))
+	for name := range testRuns {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		for _, run := range testRuns[name] {
+			summary.Tests = append(summary.Tests, TestIntervalSummary{
+				Name:        name,
+				From:        run.From,
+				To:          run.To,
+				Overlapping: summarizeCategories(infrastructure, run.From, run.To),
+			})
+		}
+	}
+	return summary
+}
+
+// summarizeCategories totals the intervals by category, counting only the part of each interval between from and
+// to when they are set.
+func summarizeCategories(intervals monitorapi.Intervals, from, to time.Time) []IntervalCategorySummary {
+	byCategory := map[string]*IntervalCategorySummary{}
+	for _, interval := range intervals {
+		start, end := interval.From, interval.To
+		if end.IsZero() {
+			end = start
+		}
+		if !from.IsZero() && start.Before(from) {
+			start = from
+		}
+		if !to.IsZero() && end.After(to) {
+			end = to
+		}
+		if end.Before(start) {
+			continue
+		}
+		category := infrastructureCategory(interval)
+		summary, ok := byCategory[category]
+		if !ok {
+			summary = &IntervalCategorySummary{Category: category, Locators: []string{}}
+			byCategory[category] = summary
+		}
+		summary.Count++
+		summary.Seconds += end.Sub(start).Seconds()
+		if len(summary.Locators) < maxSummaryLocators && !containsString(summary.Locators, interval.Locator) {
+			summary.Locators = append(summary.Locators, interval.Locator)
+		}
+	}
+	categories := []IntervalCategorySummary{}
+	for _, summary := range byCategory {
+		categories = append(categories, *summary)
+	}
+	sort.Slice(categories, func(i, j int) bool { return categories[i].Category < categories[j].Category })
+	return categories
+}
+
+// overlappingReason describes the infrastructure intervals that overlapped the runs of a test, or returns an empty
+// string if there were none.
+func (s *IntervalSummary) overlappingReason(testName string) string {
+	if s == nil {
+		return ""
+	}
+	var parts []string
+	for _, test := range s.Tests {
+		if test.Name != testName {
+			continue
+		}
+		for _, category := range test.Overlapping {
+			parts = append(parts, fmt.Sprintf("%s (%d intervals, %.0fs)", category.Category, category.Count, category.Seconds))
+		}
+	}
+	if len(parts) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("The test failed during infrastructure problems: %s.", strings.Join(parts, ", "))
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
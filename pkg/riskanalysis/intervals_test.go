@@ -0,0 +1,83 @@
+package riskanalysis
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/openshift/origin/pkg/monitor/monitorapi"
+)
+
+func TestSummarizeIntervals(t *testing.T) {
+	start := time.Date(2023, 5, 1, 10, 0, 0, 0, time.UTC)
+	at := func(minutes int) time.Time { return start.Add(time.Duration(minutes) * time.Minute) }
+	interval := func(level monitorapi.EventLevel, locator, message string, from, to int) monitorapi.EventInterval {
+		return monitorapi.EventInterval{
+			Condition: monitorapi.Condition{Level: level, Locator: locator, Message: message},
+			From:      at(from),
+			To:        at(to),
+		}
+	}
+	intervals := monitorapi.Intervals{
+		interval(monitorapi.Error, monitorapi.E2ETestLocator("during disruption"), `e2e test finished As "Failed"`, 0, 10),
+		interval(monitorapi.Error, monitorapi.E2ETestLocator("regression"), `e2e test finished As "Failed"`, 20, 30),
+		interval(monitorapi.Info, monitorapi.E2ETestLocator("passed"), `e2e test finished As "Passed"`, 0, 10),
+		interval(monitorapi.Error, monitorapi.LocateDisruptionCheck("kube-api", monitorapi.NewConnectionType), "disruption", 5, 15),
+		interval(monitorapi.Error, "node/worker-1", "node is not ready", 8, 9),
+		interval(monitorapi.Error, "ns/e2e-test pod/client", "container failed", 2, 3),
+		interval(monitorapi.Warning, "clusteroperator/dns", "dns is progressing", 2, 3),
+	}
+
+	summary := summarizeIntervals(intervals, []string{"during disruption", "regression", "did not run"})
+
+	wantCategories := []IntervalCategorySummary{
+		{Category: "disruption", Count: 1, Seconds: 600, Locators: []string{"disruption/kube-api connection/new"}},
+		{Category: "node", Count: 1, Seconds: 60, Locators: []string{"node/worker-1"}},
+	}
+	if !reflect.DeepEqual(summary.Categories, wantCategories) {
+		t.Errorf("expected categories %#v, got %#v", wantCategories, summary.Categories)
+	}
+	wantTests := []TestIntervalSummary{
+		{
+			Name: "during disruption",
+			From: at(0),
+			To:   at(10),
+			Overlapping: []IntervalCategorySummary{
+				{Category: "disruption", Count: 1, Seconds: 300, Locators: []string{"disruption/kube-api connection/new"}},
+				{Category: "node", Count: 1, Seconds: 60, Locators: []string{"node/worker-1"}},
+			},
+		},
+		{
+			Name:        "regression",
+			From:        at(20),
+			To:          at(30),
+			Overlapping: []IntervalCategorySummary{},
+		},
+	}
+	if !reflect.DeepEqual(summary.Tests, wantTests) {
+		t.Errorf("expected tests %#v, got %#v", wantTests, summary.Tests)
+	}
+
+	if got, want := summary.overlappingReason("during disruption"), "The test failed during infrastructure problems: disruption (1 intervals, 300s), node (1 intervals, 60s)."; got != want {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+	if got := summary.overlappingReason("regression"); len(got) > 0 {
+		t.Errorf("expected no reason for a failure without infrastructure problems, got %q", got)
+	}
+
+	rates := &TestPassRates{Tests: map[string]*TestPassRate{
+		"during disruption": {Runs: 50, Passes: 50},
+		"regression":        {Runs: 50, Passes: 50},
+	}}
+	jobRun := &ProwJobRun{
+		Tests: []ProwJobRunTest{
+			{Test: Test{Name: "during disruption"}, Status: 12},
+			{Test: Test{Name: "regression"}, Status: 12},
+		},
+		IntervalSummary: summary,
+	}
+	analysis := localRiskAnalysis(jobRun, rates, "rates.json")
+	if analysis.Tests[0].Risk.Level != riskLevelLow || analysis.Tests[1].Risk.Level != riskLevelHigh {
+		t.Errorf("expected the failure during infrastructure problems to be low risk and the regression high risk, got %#v", analysis.Tests)
+	}
+}
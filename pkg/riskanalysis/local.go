@@ -0,0 +1,177 @@
+package riskanalysis
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/openshift/origin/pkg/test/ginkgo/junitapi"
+	"github.com/pkg/errors"
+)
+
+const (
+	// passRatesFile is written to the junit dir after every analysis, so the next run can be given the pass rates of
+	// this one.
+	passRatesFile = "test-pass-rates.json"
+	// maxHistoryRuns limits the runs a pass rate is computed from, so older results count less over time.
+	maxHistoryRuns = 100
+	// minHistoryRuns is the number of runs needed before a pass rate is trusted.
+	minHistoryRuns = 3
+	// maxAnalyzedFailures is the number of failures above which the run is high risk no matter the pass rates.
+	maxAnalyzedFailures = 20
+)
+
+// These levels match the levels returned by sippy, so the analysis renders the same way.
+var (
+	riskLevelNone    = RiskLevel{Name: "None", Level: 0}
+	riskLevelLow     = RiskLevel{Name: "Low", Level: 1}
+	riskLevelUnknown = RiskLevel{Name: "Unknown", Level: 5}
+	riskLevelMedium  = RiskLevel{Name: "Medium", Level: 5}
+	riskLevelHigh    = RiskLevel{Name: "High", Level: 10}
+)
+
+// TestPassRates is the historical pass rate of every test, cached in the artifacts of a run so that risk can be
+// analyzed when sippy is unreachable.
+type TestPassRates struct {
+	Tests map[string]*TestPassRate `json:"tests"`
+}
+
+// TestPassRate counts the runs of a test that did not skip it, and how many of them passed. A test that flaked
+// passed.
+type TestPassRate struct {
+	Runs   int `json:"runs"`
+	Passes int `json:"passes"`
+}
+
+// LoadTestPassRates reads the pass rates written by a previous run.
+func LoadTestPassRates(path string) (*TestPassRates, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	rates := &TestPassRates{}
+	if err := json.Unmarshal(data, rates); err != nil {
+		return nil, errors.Wrapf(err, "error unmarshalling pass rates from %s", path)
+	}
+	if rates.Tests == nil {
+		rates.Tests = map[string]*TestPassRate{}
+	}
+	return rates, nil
+}
+
+// addJUnitResults counts the results of every test in the junit_e2e files of junitDir.
+func (r *TestPassRates) addJUnitResults(junitDir string) error {
+	files, err := filepath.Glob(filepath.Join(junitDir, "junit_e2e_*.xml"))
+	if err != nil {
+		return err
+	}
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return err
+		}
+		suite := &junitapi.JUnitTestSuite{}
+		if err := xml.Unmarshal(data, suite); err != nil {
+			return errors.Wrapf(err, "error unmarshalling %s", file)
+		}
+		passed := map[string]bool{}
+		for _, testCase := range suite.TestCases {
+			if testCase.SkipMessage != nil {
+				continue
+			}
+			if testCase.FailureOutput == nil {
+				passed[testCase.Name] = true
+			} else if _, ok := passed[testCase.Name]; !ok {
+				passed[testCase.Name] = false
+			}
+		}
+		for name, pass := range passed {
+			r.add(name, pass)
+		}
+	}
+	return nil
+}
+
+func (r *TestPassRates) add(name string, passed bool) {
+	rate, ok := r.Tests[name]
+	if !ok {
+		rate = &TestPassRate{}
+		r.Tests[name] = rate
+	}
+	rate.Runs++
+	if passed {
+		rate.Passes++
+	}
+	if rate.Runs > maxHistoryRuns {
+		rate.Passes = rate.Passes * maxHistoryRuns / rate.Runs
+		rate.Runs = maxHistoryRuns
+	}
+}
+
+func (r *TestPassRates) write(path string) error {
+	data, err := json.MarshalIndent(r, "", "    ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, data, 0644)
+}
+
+// localRiskAnalysis estimates the risk of the failures of the job run from historical pass rates, the way sippy does:
+// a failure of a test that almost always passes is high risk, a failure of a test that often fails is low risk.
+func localRiskAnalysis(jobRun *ProwJobRun, rates *TestPassRates, source string) *RiskAnalysis {
+	analysis := &RiskAnalysis{
+		ProwJobName:  jobRun.ProwJob.Name,
+		ProwJobRunID: jobRun.ID,
+		Tests:        []TestRiskAnalysis{},
+		OverallRisk:  FailureRisk{Level: riskLevelNone, Reasons: []string{}},
+		OpenBugs:     []Bug{},
+	}
+	for _, test := range jobRun.Tests {
+		risk := testRisk(rates.Tests[test.Test.Name])
+		if reason := jobRun.IntervalSummary.overlappingReason(test.Test.Name); len(reason) > 0 && risk.Level.Level > riskLevelLow.Level {
+			risk.Level = riskLevelLow
+			risk.Reasons = append(risk.Reasons, reason)
+		}
+		analysis.Tests = append(analysis.Tests, TestRiskAnalysis{Name: test.Test.Name, Risk: risk, OpenBugs: []Bug{}})
+		if risk.Level.Level > analysis.OverallRisk.Level.Level {
+			analysis.OverallRisk.Level = risk.Level
+		}
+	}
+	sort.Slice(analysis.Tests, func(i, j int) bool { return analysis.Tests[i].Name < analysis.Tests[j].Name })
+
+	switch failures := len(jobRun.Tests); {
+	case failures == 0:
+		analysis.OverallRisk.Reasons = append(analysis.OverallRisk.Reasons, "No test failures found in this job run.")
+	case failures > maxAnalyzedFailures:
+		analysis.OverallRisk.Level = riskLevelHigh
+		analysis.OverallRisk.Reasons = append(analysis.OverallRisk.Reasons, fmt.Sprintf("%d tests failed in this job run, more than the %d expected from known flakes.", failures, maxAnalyzedFailures))
+	default:
+		analysis.OverallRisk.Reasons = append(analysis.OverallRisk.Reasons, fmt.Sprintf("Maximum failed test risk: %s", analysis.OverallRisk.Level.Name))
+	}
+	analysis.OverallRisk.Reasons = append(analysis.OverallRisk.Reasons, fmt.Sprintf("Sippy was unreachable, risk was computed from the historical pass rates in %s.", source))
+	return analysis
+}
+
+func testRisk(rate *TestPassRate) FailureRisk {
+	if rate == nil || rate.Runs < minHistoryRuns {
+		runs := 0
+		if rate != nil {
+			runs = rate.Runs
+		}
+		return FailureRisk{Level: riskLevelUnknown, Reasons: []string{fmt.Sprintf("This test has only %d runs in the history, too few to compute a pass rate.", runs)}}
+	}
+	passRate := float64(rate.Passes) / float64(rate.Runs) * 100
+	reason := fmt.Sprintf("This test has passed %.2f%% of %d runs in the history.", passRate, rate.Runs)
+	switch {
+	case passRate >= 98:
+		return FailureRisk{Level: riskLevelHigh, Reasons: []string{reason}}
+	case passRate >= 80:
+		return FailureRisk{Level: riskLevelMedium, Reasons: []string{reason}}
+	default:
+		return FailureRisk{Level: riskLevelLow, Reasons: []string{reason}}
+	}
+}
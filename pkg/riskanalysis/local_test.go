@@ -0,0 +1,86 @@
+package riskanalysis
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestTestRisk(t *testing.T) {
+	tests := []struct {
+		name string
+		rate *TestPassRate
+		want RiskLevel
+	}{
+		{name: "no history", want: riskLevelUnknown},
+		{name: "too few runs", rate: &TestPassRate{Runs: 2, Passes: 2}, want: riskLevelUnknown},
+		{name: "always passes", rate: &TestPassRate{Runs: 100, Passes: 99}, want: riskLevelHigh},
+		{name: "sometimes fails", rate: &TestPassRate{Runs: 100, Passes: 90}, want: riskLevelMedium},
+		{name: "often fails", rate: &TestPassRate{Runs: 100, Passes: 50}, want: riskLevelLow},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := testRisk(test.rate); got.Level != test.want {
+				t.Errorf("expected %v, got %v", test.want, got.Level)
+			}
+		})
+	}
+}
+
+func TestLocalRiskAnalysis(t *testing.T) {
+	rates := &TestPassRates{Tests: map[string]*TestPassRate{
+		"stable":  {Runs: 50, Passes: 50},
+		"flaky":   {Runs: 50, Passes: 20},
+		"unknown": {Runs: 1, Passes: 1},
+	}}
+	jobRun := &ProwJobRun{Tests: []ProwJobRunTest{
+		{Test: Test{Name: "flaky"}, Status: 12},
+		{Test: Test{Name: "stable"}, Status: 12},
+	}}
+	analysis := localRiskAnalysis(jobRun, rates, "rates.json")
+	if analysis.OverallRisk.Level != riskLevelHigh {
+		t.Errorf("expected high overall risk, got %v", analysis.OverallRisk.Level)
+	}
+	if len(analysis.Tests) != 2 || analysis.Tests[0].Name != "flaky" || analysis.Tests[0].Risk.Level != riskLevelLow {
+		t.Errorf("unexpected test analysis: %#v", analysis.Tests)
+	}
+
+	analysis = localRiskAnalysis(&ProwJobRun{}, rates, "rates.json")
+	if analysis.OverallRisk.Level != riskLevelNone {
+		t.Errorf("expected no overall risk without failures, got %v", analysis.OverallRisk.Level)
+	}
+}
+
+func TestAddJUnitResults(t *testing.T) {
+	dir := t.TempDir()
+	junit := `<testsuite name="openshift-tests">
+  <testcase name="passes"></testcase>
+  <testcase name="fails"><failure message="">failed</failure></testcase>
+  <testcase name="flakes"><failure message="">failed</failure></testcase>
+  <testcase name="flakes"></testcase>
+  <testcase name="skipped"><skipped message="skipped"></skipped></testcase>
+</testsuite>`
+	if err := os.WriteFile(filepath.Join(dir, "junit_e2e_1.xml"), []byte(junit), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	rates := &TestPassRates{Tests: map[string]*TestPassRate{
+		"passes": {Runs: maxHistoryRuns, Passes: maxHistoryRuns / 2},
+	}}
+	if err := rates.addJUnitResults(dir); err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]TestPassRate{
+		"passes": {Runs: maxHistoryRuns, Passes: (maxHistoryRuns/2 + 1) * maxHistoryRuns / (maxHistoryRuns + 1)},
+		"fails":  {Runs: 1, Passes: 0},
+		"flakes": {Runs: 1, Passes: 1},
+	}
+	if len(rates.Tests) != len(expected) {
+		t.Fatalf("expected %d tests, got %#v", len(expected), rates.Tests)
+	}
+	for name, want := range expected {
+		if got := rates.Tests[name]; got == nil || *got != want {
+			t.Errorf("%s: expected %v, got %v", name, want, got)
+		}
+	}
+}
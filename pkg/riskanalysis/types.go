@@ -13,6 +13,8 @@ type ProwJobRun struct {
 	ClusterData platformidentification.ClusterData
 	Tests       []ProwJobRunTest
 	TestCount   int
+	// IntervalSummary is only sent when the analysis is run with --include-intervals.
+	IntervalSummary *IntervalSummary `json:",omitempty"`
 }
 
 type ProwJob struct {
@@ -32,3 +34,36 @@ type ProwJobRunTest struct {
 	Suite  Suite
 	Status int // would like to use smallint here, but gorm auto-migrate breaks trying to change the type every start
 }
+
+// RiskAnalysis is the subset of the sippy risk analysis response rendered in the spyglass page. It is only built
+// locally when sippy is unreachable.
+type RiskAnalysis struct {
+	ProwJobName    string
+	ProwJobRunID   int
+	CompareRelease string
+	Tests          []TestRiskAnalysis
+	OverallRisk    FailureRisk
+	OpenBugs       []Bug
+}
+
+type TestRiskAnalysis struct {
+	Name     string
+	Risk     FailureRisk
+	OpenBugs []Bug
+}
+
+type FailureRisk struct {
+	Level   RiskLevel
+	Reasons []string
+}
+
+type RiskLevel struct {
+	Name  string
+	Level int
+}
+
+type Bug struct {
+	Key     string `json:"key"`
+	Summary string `json:"summary"`
+	URL     string `json:"url"`
+}
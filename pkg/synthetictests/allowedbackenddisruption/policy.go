@@ -0,0 +1,164 @@
+package allowedbackenddisruption
+
+import (
+	_ "embed"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	"github.com/openshift/origin/pkg/synthetictests/platformidentification"
+)
+
+// Violation is how a backend that exceeds its disruption budget is reported.
+type Violation string
+
+const (
+	// ViolationFailure fails the availability test of the backend.
+	ViolationFailure Violation = "Failure"
+	// ViolationFlake reports the availability test of the backend as a flake.
+	ViolationFlake Violation = "Flake"
+)
+
+// Policy sets the disruption budgets of backends, overriding the budgets derived from historical
+// data. It lets budgets change without a code change to this package.
+type Policy struct {
+	Backends []BackendBudget `json:"backends"`
+}
+
+// BackendBudget is the disruption allowed for a backend in a job run.
+type BackendBudget struct {
+	// Backend is the name of the backend, such as kube-api-new-connections.
+	Backend string `json:"backend"`
+	// Platforms limits the budget to job runs on these platforms, such as aws. An empty list
+	// matches every platform. A budget for the platform of the job run is preferred over one
+	// for every platform.
+	Platforms []string `json:"platforms,omitempty"`
+	// P95 is the disruption allowed in a job run.
+	P95 metav1.Duration `json:"p95"`
+	// Violation defaults to Failure.
+	Violation Violation `json:"violation,omitempty"`
+}
+
+//go:embed policy.yaml
+var defaultPolicyData []byte
+
+var (
+	policyLock sync.Mutex
+	// userPolicy is consulted before the default policy
+	userPolicy    *Policy
+	defaultPolicy = mustParsePolicy(defaultPolicyData)
+)
+
+// ParsePolicy parses and defaults a YAML or JSON disruption policy.
+func ParsePolicy(data []byte) (*Policy, error) {
+	policy := &Policy{}
+	if err := yaml.UnmarshalStrict(data, policy); err != nil {
+		return nil, err
+	}
+	for i := range policy.Backends {
+		budget := &policy.Backends[i]
+		if len(budget.Backend) == 0 {
+			return nil, fmt.Errorf("backends[%d]: backend is required", i)
+		}
+		if budget.P95.Duration < 0 {
+			return nil, fmt.Errorf("backends[%d]: p95 may not be negative", i)
+		}
+		switch budget.Violation {
+		case "":
+			budget.Violation = ViolationFailure
+		case ViolationFailure, ViolationFlake:
+		default:
+			return nil, fmt.Errorf("backends[%d]: violation must be %s or %s", i, ViolationFailure, ViolationFlake)
+		}
+	}
+	return policy, nil
+}
+
+func mustParsePolicy(data []byte) *Policy {
+	policy, err := ParsePolicy(data)
+	if err != nil {
+		panic(err)
+	}
+	return policy
+}
+
+// LoadPolicy reads the disruption policy at path and consults it before the default policy and
+// historical data for the rest of the process.
+func LoadPolicy(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	policy, err := ParsePolicy(data)
+	if err != nil {
+		return fmt.Errorf("invalid disruption policy %s: %v", path, err)
+	}
+	SetPolicy(policy)
+	return nil
+}
+
+// SetPolicy sets the policy consulted before the default policy and historical data. A nil policy
+// restores the default.
+func SetPolicy(policy *Policy) {
+	policyLock.Lock()
+	defer policyLock.Unlock()
+	userPolicy = policy
+}
+
+// budgetFor returns the budget for the backend in a job run of jobType, or nil if the policies
+// define none.
+func budgetFor(backendName string, jobType platformidentification.JobType) *BackendBudget {
+	policyLock.Lock()
+	defer policyLock.Unlock()
+	for _, policy := range []*Policy{userPolicy, defaultPolicy} {
+		if budget := policy.budgetFor(backendName, jobType); budget != nil {
+			return budget
+		}
+	}
+	return nil
+}
+
+func (p *Policy) budgetFor(backendName string, jobType platformidentification.JobType) *BackendBudget {
+	if p == nil {
+		return nil
+	}
+	var anyPlatform *BackendBudget
+	for i := range p.Backends {
+		budget := &p.Backends[i]
+		if budget.Backend != backendName {
+			continue
+		}
+		if len(budget.Platforms) == 0 {
+			if anyPlatform == nil {
+				anyPlatform = budget
+			}
+			continue
+		}
+		for _, platform := range budget.Platforms {
+			if platform == jobType.Platform {
+				return budget
+			}
+		}
+	}
+	return anyPlatform
+}
+
+// GetViolation returns how the backend is reported when its disruption exceeds the allowed
+// disruption in a job run of jobType.
+func GetViolation(backendName string, jobType platformidentification.JobType) Violation {
+	if budget := budgetFor(backendName, jobType); budget != nil {
+		return budget.Violation
+	}
+	return ViolationFailure
+}
+
+func (b *BackendBudget) details(jobType platformidentification.JobType) string {
+	if len(b.Platforms) == 0 {
+		return fmt.Sprintf("disruption policy budget for %s: %s", b.Backend, b.P95.Duration.Round(time.Millisecond))
+	}
+	return fmt.Sprintf("disruption policy budget for %s on %s: %s", b.Backend, jobType.Platform, b.P95.Duration.Round(time.Millisecond))
+}
@@ -0,0 +1,85 @@
+package allowedbackenddisruption
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/openshift/origin/pkg/synthetictests/platformidentification"
+)
+
+func TestParsePolicy(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		wantErr string
+	}{
+		{name: "yaml", data: "backends:\n- backend: kube-api-new-connections\n  platforms: [aws]\n  p95: 5s\n  violation: Flake\n"},
+		{name: "json", data: `{"backends":[{"backend":"kube-api-new-connections","p95":"5s"}]}`},
+		{name: "missing backend", data: "backends:\n- p95: 5s\n", wantErr: "backend is required"},
+		{name: "negative budget", data: "backends:\n- backend: a\n  p95: -5s\n", wantErr: "may not be negative"},
+		{name: "unknown violation", data: "backends:\n- backend: a\n  p95: 5s\n  violation: Warn\n", wantErr: "violation must be"},
+		{name: "unknown field", data: "backends:\n- backend: a\n  p99: 5s\n", wantErr: "p99"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := ParsePolicy([]byte(test.data))
+			if len(test.wantErr) == 0 && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(test.wantErr) > 0 && (err == nil || !strings.Contains(err.Error(), test.wantErr)) {
+				t.Fatalf("expected error containing %q, got %v", test.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestGetAllowedDisruptionFromPolicy(t *testing.T) {
+	policy, err := ParsePolicy([]byte(`
+backends:
+- backend: kube-api-new-connections
+  p95: 10s
+- backend: kube-api-new-connections
+  platforms: [aws, gcp]
+  p95: 3s
+  violation: Flake
+- backend: ci-cluster-network-liveness-new-connections
+  p95: 1m
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	SetPolicy(policy)
+	defer SetPolicy(nil)
+
+	aws := platformidentification.JobType{Release: "4.12", FromRelease: "4.12", Platform: "aws", Architecture: "amd64", Network: "sdn", Topology: "ha"}
+	metal := aws
+	metal.Platform = "metal"
+
+	tests := []struct {
+		name          string
+		backend       string
+		jobType       platformidentification.JobType
+		wantAllowed   time.Duration
+		wantViolation Violation
+	}{
+		{name: "platform budget is preferred", backend: "kube-api-new-connections", jobType: aws, wantAllowed: 3 * time.Second, wantViolation: ViolationFlake},
+		{name: "budget for every platform", backend: "kube-api-new-connections", jobType: metal, wantAllowed: 10 * time.Second, wantViolation: ViolationFailure},
+		{name: "policy replaces the default policy", backend: "ci-cluster-network-liveness-new-connections", jobType: aws, wantAllowed: time.Minute, wantViolation: ViolationFailure},
+		{name: "default policy", backend: "ci-cluster-network-liveness-reused-connections", jobType: aws, wantAllowed: 10 * time.Minute, wantViolation: ViolationFailure},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			allowed, details, err := GetAllowedDisruption(test.backend, test.jobType)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if allowed == nil || *allowed != test.wantAllowed {
+				t.Errorf("expected %s allowed, got %v: %s", test.wantAllowed, allowed, details)
+			}
+			if violation := GetViolation(test.backend, test.jobType); violation != test.wantViolation {
+				t.Errorf("expected violation %s, got %s", test.wantViolation, violation)
+			}
+		})
+	}
+}
@@ -101,6 +101,10 @@ func testServerAvailability(
 			},
 			SystemOut: strings.Join(disruptionMsgs, "\n"),
 		}
+		if allowedbackenddisruption.GetViolation(backendName, *jobType) == allowedbackenddisruption.ViolationFlake {
+			// the disruption policy reports violations for this backend as flakes
+			return []*junitapi.JUnitTestCase{test, successTest}
+		}
 		return []*junitapi.JUnitTestCase{test}
 	} else {
 		successTest.SystemOut = resultsStr
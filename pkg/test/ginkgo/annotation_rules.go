@@ -0,0 +1,92 @@
+package ginkgo
+
+import (
+	"regexp"
+	"strings"
+	"sync"
+
+	"k8s.io/kubernetes/openshift-hack/e2e/annotate"
+
+	"github.com/openshift/origin/test/extended/util/annotate/rules"
+	"github.com/openshift/origin/test/extended/util/annotate/skips"
+)
+
+const (
+	kubeAnnotationRules   = "openshift/kubernetes openshift-hack/e2e/annotate/rules.go"
+	originAnnotationRules = "test/extended/util/annotate/rules/rules.go"
+	originSkipRules       = "test/extended/util/annotate/skips/skips.yaml"
+	annotationGenerator   = "openshift/kubernetes openshift-hack/e2e/annotate/annotate.go"
+)
+
+// annotationRule describes the rule of the annotation generator that added a label to a test.
+type annotationRule struct {
+	Label string `json:"label"`
+	// Rule is the pattern that matched the name of the test, or a description of the rule
+	Rule string `json:"rule"`
+	// Source is the file that defines the rule
+	Source string `json:"source,omitempty"`
+}
+
+type compiledAnnotationRule struct {
+	annotationRule
+	re *regexp.Regexp
+}
+
+var (
+	annotationRulesOnce sync.Once
+	// annotationRulesByLabel holds the rules of the annotation generator for each label
+	annotationRulesByLabel map[string][]compiledAnnotationRule
+)
+
+func loadAnnotationRules() map[string][]compiledAnnotationRule {
+	annotationRulesOnce.Do(func() {
+		annotationRulesByLabel = map[string][]compiledAnnotationRule{}
+		for _, source := range []struct {
+			name  string
+			rules map[string][]string
+		}{
+			{name: kubeAnnotationRules, rules: annotate.TestMaps},
+			{name: originAnnotationRules, rules: rules.TestMaps},
+			{name: originSkipRules, rules: skips.Default().Skips},
+		} {
+			for label, patterns := range source.rules {
+				for _, pattern := range patterns {
+					re, err := regexp.Compile(pattern)
+					if err != nil {
+						continue
+					}
+					annotationRulesByLabel[label] = append(annotationRulesByLabel[label], compiledAnnotationRule{
+						annotationRule: annotationRule{Label: label, Rule: pattern, Source: source.name},
+						re:             re,
+					})
+				}
+			}
+		}
+	})
+	return annotationRulesByLabel
+}
+
+// explainAnnotations returns, for every label appended to the name of the test by the annotations,
+// the rule that added it.
+func explainAnnotations(test *testCase, rulesByLabel map[string][]compiledAnnotationRule) []annotationRule {
+	var explained []annotationRule
+	for _, match := range labelRe.FindAllStringSubmatch(test.annotations, -1) {
+		label := "[" + match[1] + "]"
+		rule := annotationRule{Label: label, Rule: "no annotation rule matches the test"}
+		switch {
+		case label == "[Suite:k8s]":
+			rule.Rule, rule.Source = "the test is defined in k8s.io/kubernetes/test/e2e", annotationGenerator
+		case strings.HasPrefix(label, "[Suite:"):
+			rule.Rule, rule.Source = "the default suite for a test with its [Serial] and [Conformance] labels", annotationGenerator
+		default:
+			for _, candidate := range rulesByLabel[label] {
+				if candidate.re.MatchString(test.name) {
+					rule = candidate.annotationRule
+					break
+				}
+			}
+		}
+		explained = append(explained, rule)
+	}
+	return explained
+}
@@ -0,0 +1,34 @@
+package ginkgo
+
+import (
+	"reflect"
+	"regexp"
+	"testing"
+)
+
+func Test_explainAnnotations(t *testing.T) {
+	rulesByLabel := map[string][]compiledAnnotationRule{
+		"[Disabled:Broken]": {
+			{annotationRule: annotationRule{Label: "[Disabled:Broken]", Rule: `should be fixed`, Source: "rules.go"}, re: regexp.MustCompile(`should be fixed`)},
+			{annotationRule: annotationRule{Label: "[Disabled:Broken]", Rule: `\[Feature:Broken\]`, Source: "rules.go"}, re: regexp.MustCompile(`\[Feature:Broken\]`)},
+		},
+	}
+	test := &testCase{
+		name:        "[sig-network] a [Feature:Broken] [Disabled:Broken] [Slow] [Suite:openshift/conformance/parallel] [Suite:k8s]",
+		annotations: " [Disabled:Broken] [Slow] [Suite:openshift/conformance/parallel] [Suite:k8s]",
+	}
+
+	want := []annotationRule{
+		{Label: "[Disabled:Broken]", Rule: `\[Feature:Broken\]`, Source: "rules.go"},
+		{Label: "[Slow]", Rule: "no annotation rule matches the test"},
+		{Label: "[Suite:openshift/conformance/parallel]", Rule: "the default suite for a test with its [Serial] and [Conformance] labels", Source: annotationGenerator},
+		{Label: "[Suite:k8s]", Rule: "the test is defined in k8s.io/kubernetes/test/e2e", Source: annotationGenerator},
+	}
+	if got := explainAnnotations(test, rulesByLabel); !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected rules:\n%#v", got)
+	}
+
+	if len(loadAnnotationRules()) == 0 {
+		t.Error("expected the annotation rules to be loaded")
+	}
+}
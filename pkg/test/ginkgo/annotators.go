@@ -0,0 +1,74 @@
+package ginkgo
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/openshift/origin/test/extended/util/annotate/generated"
+)
+
+// GeneratedAnnotationsPriority is the priority of the built-in annotator that appends the labels
+// produced by the generated annotation rules.
+const GeneratedAnnotationsPriority = 0
+
+// Annotator describes a test before the suite runs. The returned text is appended to the name of
+// the test, and the metadata is recorded with the test in the dry-run listing and the reports.
+type Annotator interface {
+	Annotate(name string) (text string, metadata map[string]string)
+}
+
+// AnnotatorFunc converts a function into the Annotator interface.
+type AnnotatorFunc func(name string) (text string, metadata map[string]string)
+
+func (fn AnnotatorFunc) Annotate(name string) (string, map[string]string) {
+	return fn(name)
+}
+
+type prioritizedAnnotator struct {
+	priority  int
+	annotator Annotator
+}
+
+var (
+	annotatorsLock sync.Mutex
+	annotators     = []prioritizedAnnotator{
+		{priority: GeneratedAnnotationsPriority, annotator: AnnotatorFunc(func(name string) (string, map[string]string) {
+			return generated.Annotations[name], nil
+		})},
+	}
+)
+
+// RegisterAnnotator adds an annotator for every test. Annotators are applied in increasing order of
+// priority, or in the order they were registered when priorities are equal, and each is given the
+// name including the text appended before it. When annotators set the same metadata key the last one
+// applied wins. Annotators must be registered before the tests of the suite are listed or run.
+func RegisterAnnotator(priority int, annotator Annotator) {
+	annotatorsLock.Lock()
+	defer annotatorsLock.Unlock()
+	annotators = append(annotators, prioritizedAnnotator{priority: priority, annotator: annotator})
+	sort.SliceStable(annotators, func(i, j int) bool { return annotators[i].priority < annotators[j].priority })
+}
+
+// annotateTest applies every registered annotator to the test with name and returns the text to
+// append to the name and the combined metadata.
+func annotateTest(name string) (string, map[string]string) {
+	annotatorsLock.Lock()
+	defer annotatorsLock.Unlock()
+	return applyAnnotators(annotators, name)
+}
+
+func applyAnnotators(annotators []prioritizedAnnotator, name string) (string, map[string]string) {
+	var annotation string
+	var metadata map[string]string
+	for _, a := range annotators {
+		text, values := a.annotator.Annotate(name + annotation)
+		annotation += text
+		for k, v := range values {
+			if metadata == nil {
+				metadata = map[string]string{}
+			}
+			metadata[k] = v
+		}
+	}
+	return annotation, metadata
+}
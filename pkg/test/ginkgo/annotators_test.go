@@ -0,0 +1,36 @@
+package ginkgo
+
+import (
+	"reflect"
+	"testing"
+)
+
+func Test_applyAnnotators(t *testing.T) {
+	var seen []string
+	annotator := func(text string, metadata map[string]string) Annotator {
+		return AnnotatorFunc(func(name string) (string, map[string]string) {
+			seen = append(seen, name)
+			return text, metadata
+		})
+	}
+	annotators := []prioritizedAnnotator{
+		{priority: -1, annotator: annotator(" [Slow]", map[string]string{"team": "network", "tier": "1"})},
+		{priority: 0, annotator: annotator("", nil)},
+		{priority: 10, annotator: annotator(" [Serial]", map[string]string{"tier": "2"})},
+	}
+
+	annotation, metadata := applyAnnotators(annotators, "a")
+	if annotation != " [Slow] [Serial]" {
+		t.Errorf("unexpected annotation %q", annotation)
+	}
+	if want := map[string]string{"team": "network", "tier": "2"}; !reflect.DeepEqual(metadata, want) {
+		t.Errorf("unexpected metadata %v", metadata)
+	}
+	if want := []string{"a", "a [Slow]", "a [Slow]"}; !reflect.DeepEqual(seen, want) {
+		t.Errorf("annotators were given names %q", seen)
+	}
+
+	if _, metadata := applyAnnotators(annotators[1:2], "a"); metadata != nil {
+		t.Errorf("expected no metadata, got %v", metadata)
+	}
+}
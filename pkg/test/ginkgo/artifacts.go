@@ -0,0 +1,153 @@
+package ginkgo
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/openshift/origin/pkg/test/ginkgo/runnerapi"
+)
+
+const (
+	// attachedArtifactPrefix starts the lines run-test writes for every artifact the test attached.
+	attachedArtifactPrefix = "artifact: "
+)
+
+// testArtifactIndex maps the IDs of the tests that wrote artifacts to their artifacts. It is written
+// to test-artifacts/index.json under the junit dir.
+type testArtifactIndex struct {
+	Tests map[string]testArtifactIndexEntry `json:"tests"`
+}
+
+type testArtifactIndexEntry struct {
+	Name  string    `json:"name"`
+	State TestState `json:"state"`
+	// Dir is relative to the junit dir
+	Dir   string   `json:"dir"`
+	Files []string `json:"files"`
+}
+
+// testArtifactDir returns the directory a test may write its own artifacts to, or an empty string
+// if per-test artifacts are not being collected.
+func testArtifactDir(baseDir string, test *testCase) string {
+	if len(baseDir) == 0 {
+		return ""
+	}
+	return filepath.Join(baseDir, runnerapi.TestArtifactsDir, test.id())
+}
+
+// testArtifactIndexPath returns the path of the index of the test artifacts under baseDir.
+func testArtifactIndexPath(baseDir string) string {
+	return filepath.Join(baseDir, runnerapi.TestArtifactsDir, runnerapi.TestArtifactsIndex)
+}
+
+// writeTestArtifactIndex records which tests wrote artifacts and removes the directories of tests
+// that did not write anything. Entries already in the index, written by an earlier run into the same
+// junit dir, are kept unless the test ran again.
+func writeTestArtifactIndex(baseDir string, tests []*testCase) error {
+	index := testArtifactIndex{Tests: map[string]testArtifactIndexEntry{}}
+	path := testArtifactIndexPath(baseDir)
+	data, err := ioutil.ReadFile(path)
+	switch {
+	case err == nil:
+		if err := json.Unmarshal(data, &index); err != nil {
+			return fmt.Errorf("unable to read %s: %v", path, err)
+		}
+		if index.Tests == nil {
+			index.Tests = map[string]testArtifactIndexEntry{}
+		}
+	case !os.IsNotExist(err):
+		return err
+	}
+
+	for _, test := range tests {
+		id := test.id()
+		dir := testArtifactDir(baseDir, test)
+		delete(index.Tests, id)
+
+		var files []string
+		err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			if info.IsDir() {
+				return nil
+			}
+			rel, err := filepath.Rel(dir, path)
+			if err != nil {
+				return err
+			}
+			files = append(files, filepath.ToSlash(rel))
+			return nil
+		})
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if len(files) == 0 {
+			if err := os.RemoveAll(dir); err != nil {
+				return err
+			}
+			continue
+		}
+
+		rel, err := filepath.Rel(baseDir, dir)
+		if err != nil {
+			return err
+		}
+		index.Tests[id] = testArtifactIndexEntry{
+			Name:  test.name,
+			State: test.state(),
+			Dir:   filepath.ToSlash(rel),
+			Files: files,
+		}
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	data, err = json.MarshalIndent(index, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, data, 0644)
+}
+
+// attachedArtifacts returns the artifacts the test attached that exist in its artifact directory,
+// relative to baseDir, as listed in its output by run-test.
+func attachedArtifacts(baseDir string, test *testCase) []string {
+	dir := testArtifactDir(baseDir, test)
+	if len(dir) == 0 {
+		return nil
+	}
+	var artifacts []string
+	seen := map[string]bool{}
+	scanner := bufio.NewScanner(bytes.NewReader(test.testOutputBytes))
+	for scanner.Scan() {
+		name, ok := strings.CutPrefix(scanner.Text(), attachedArtifactPrefix)
+		if !ok || seen[name] {
+			continue
+		}
+		seen[name] = true
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		if rel, err := filepath.Rel(dir, path); err != nil || strings.HasPrefix(rel, "..") {
+			continue
+		}
+		if _, err := os.Stat(path); err != nil {
+			continue
+		}
+		rel, err := filepath.Rel(baseDir, path)
+		if err != nil {
+			continue
+		}
+		artifacts = append(artifacts, filepath.ToSlash(rel))
+	}
+	return artifacts
+}
@@ -0,0 +1,100 @@
+package ginkgo
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func Test_writeTestArtifactIndex(t *testing.T) {
+	baseDir := t.TempDir()
+	withArtifacts := &testCase{name: "[sig-network] writes artifacts", failed: true}
+	withoutArtifacts := &testCase{name: "[sig-network] writes nothing", success: true}
+	earlier := &testCase{name: "[sig-network] ran in an earlier run", success: true}
+
+	for _, test := range []*testCase{withArtifacts, earlier} {
+		dir := testArtifactDir(baseDir, test)
+		if err := os.MkdirAll(filepath.Join(dir, "pods"), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(filepath.Join(dir, "pods", "pod.yaml"), []byte("kind: Pod"), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := writeTestArtifactIndex(baseDir, []*testCase{earlier}); err != nil {
+		t.Fatal(err)
+	}
+	emptyDir := testArtifactDir(baseDir, withoutArtifacts)
+	if err := os.MkdirAll(emptyDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := writeTestArtifactIndex(baseDir, []*testCase{withArtifacts, withoutArtifacts}); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := os.Stat(emptyDir); !os.IsNotExist(err) {
+		t.Errorf("expected empty artifact dir to be removed, got %v", err)
+	}
+	data, err := ioutil.ReadFile(filepath.Join(baseDir, "test-artifacts", "index.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	index := testArtifactIndex{}
+	if err := json.Unmarshal(data, &index); err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]testArtifactIndexEntry{
+		withArtifacts.id(): {
+			Name:  withArtifacts.name,
+			State: TestFailed,
+			Dir:   "test-artifacts/" + withArtifacts.id(),
+			Files: []string{"pods/pod.yaml"},
+		},
+		earlier.id(): {
+			Name:  earlier.name,
+			State: TestSucceeded,
+			Dir:   "test-artifacts/" + earlier.id(),
+			Files: []string{"pods/pod.yaml"},
+		},
+	}
+	if !reflect.DeepEqual(index.Tests, want) {
+		t.Errorf("unexpected index %#v", index.Tests)
+	}
+}
+
+func Test_attachedArtifacts(t *testing.T) {
+	baseDir := t.TempDir()
+	test := &testCase{name: "[sig-network] attaches artifacts"}
+	dir := testArtifactDir(baseDir, test)
+	if err := os.MkdirAll(filepath.Join(dir, "routes"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"dump.txt", filepath.Join("routes", "route.yaml")} {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte("data"), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	test.testOutputBytes = []byte(`STEP: dumping routes
+artifact: dump.txt
+artifact: routes/route.yaml
+artifact: dump.txt
+artifact: never-written.txt
+artifact: ../outside.txt
+the artifact: is not listed
+`)
+
+	want := []string{
+		"test-artifacts/" + test.id() + "/dump.txt",
+		"test-artifacts/" + test.id() + "/routes/route.yaml",
+	}
+	if got := attachedArtifacts(baseDir, test); !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+	if got := attachedArtifacts("", test); got != nil {
+		t.Errorf("expected no artifacts without a junit dir, got %v", got)
+	}
+}
@@ -0,0 +1,187 @@
+package ginkgo
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/openshift/origin/pkg/test/ginkgo/junitapi"
+)
+
+// SuiteBudget describes invariants the tests selected for a suite must satisfy before any of them run,
+// so that suite hygiene is enforced by the runner instead of by review.
+type SuiteBudget struct {
+	// MaxSerialTests is the maximum number of [Serial] tests in the suite. Zero means unlimited.
+	MaxSerialTests int
+	// RequireSigLabel requires every test to carry a [sig-*] label.
+	RequireSigLabel bool
+	// RequireTimeout requires every test to set its own [Timeout:*].
+	RequireTimeout bool
+	// RequiredLabels requires every test that is not skipped to carry at least one of these labels.
+	// A label ending in * matches any label with that prefix.
+	RequiredLabels []string
+	// AllowedLabels is the vocabulary of labels tests that are not skipped may carry. Empty means any
+	// label is allowed. A label ending in * matches any label with that prefix.
+	AllowedLabels []string
+}
+
+// withLabels returns a copy of the budget that also requires and restricts the given labels, or the
+// budget itself when no labels are given.
+func (b *SuiteBudget) withLabels(required, allowed []string) *SuiteBudget {
+	if len(required) == 0 && len(allowed) == 0 {
+		return b
+	}
+	copied := &SuiteBudget{}
+	if b != nil {
+		*copied = *b
+	}
+	copied.RequiredLabels = append(append([]string(nil), copied.RequiredLabels...), required...)
+	copied.AllowedLabels = append(append([]string(nil), copied.AllowedLabels...), allowed...)
+	return copied
+}
+
+// check returns a JUnit result for every configured invariant. Invariants that are not configured are
+// omitted.
+func (b *SuiteBudget) check(tests []*testCase) []*junitapi.JUnitTestCase {
+	if b == nil {
+		return nil
+	}
+	var results []*junitapi.JUnitTestCase
+	if b.MaxSerialTests > 0 {
+		serial, _ := splitTests(tests, isSerialTest)
+		var failure string
+		if len(serial) > b.MaxSerialTests {
+			failure = fmt.Sprintf("the suite contains %d serial tests, at most %d are allowed:\n\n%s", len(serial), b.MaxSerialTests, strings.Join(sortedNames(serial), "\n"))
+		}
+		results = append(results, budgetResult("suite may not exceed its budget of serial tests", failure))
+	}
+	if b.RequireSigLabel {
+		offenders, _ := splitTests(tests, func(t *testCase) bool { return !hasSigLabel(t) })
+		var failure string
+		if len(offenders) > 0 {
+			failure = fmt.Sprintf("%d tests have no [sig-*] label:\n\n%s", len(offenders), strings.Join(sortedNames(offenders), "\n"))
+		}
+		results = append(results, budgetResult("every test must have a sig label", failure))
+	}
+	if b.RequireTimeout {
+		offenders, _ := splitTests(tests, func(t *testCase) bool { return t.testTimeout == 0 })
+		var failure string
+		if len(offenders) > 0 {
+			failure = fmt.Sprintf("%d tests have no [Timeout:*] label:\n\n%s", len(offenders), strings.Join(sortedNames(offenders), "\n"))
+		}
+		results = append(results, budgetResult("every test must set a timeout", failure))
+	}
+	if len(b.RequiredLabels) > 0 {
+		offenders, _ := splitTests(tests, func(t *testCase) bool {
+			if t.skipped {
+				return false
+			}
+			for _, label := range t.labels() {
+				if matchesLabel(b.RequiredLabels, label) {
+					return false
+				}
+			}
+			return true
+		})
+		var failure string
+		if len(offenders) > 0 {
+			failure = fmt.Sprintf("%d tests have none of the required labels %s:\n\n%s", len(offenders), strings.Join(b.RequiredLabels, ", "), strings.Join(sortedNames(offenders), "\n"))
+		}
+		results = append(results, budgetResult("every test must have a required label", failure))
+	}
+	if len(b.AllowedLabels) > 0 {
+		var offenders []string
+		for _, test := range tests {
+			if test.skipped {
+				continue
+			}
+			for _, label := range test.labels() {
+				if !matchesLabel(b.AllowedLabels, label) {
+					offenders = append(offenders, fmt.Sprintf("%s: [%s]", test.name, label))
+				}
+			}
+		}
+		var failure string
+		if len(offenders) > 0 {
+			failure = fmt.Sprintf("%d labels are not in the allowed vocabulary:\n\n%s", len(offenders), strings.Join(uniqueStrings(offenders), "\n"))
+		}
+		results = append(results, budgetResult("tests may only use allowed labels", failure))
+	}
+	return results
+}
+
+// matchesLabel returns true if label is one of patterns, or starts with a pattern ending in *.
+func matchesLabel(patterns []string, label string) bool {
+	for _, pattern := range patterns {
+		if prefix := strings.TrimSuffix(pattern, "*"); prefix != pattern {
+			if strings.HasPrefix(label, prefix) {
+				return true
+			}
+		} else if label == pattern {
+			return true
+		}
+	}
+	return false
+}
+
+func budgetResult(invariant, failure string) *junitapi.JUnitTestCase {
+	result := &junitapi.JUnitTestCase{
+		Name: fmt.Sprintf("[sig-arch] suite invariant: %s", invariant),
+	}
+	if len(failure) > 0 {
+		result.FailureOutput = &junitapi.FailureOutput{
+			Message: failure,
+			Output:  failure,
+		}
+	}
+	return result
+}
+
+func hasSigLabel(test *testCase) bool {
+	for _, label := range test.labels() {
+		if strings.HasPrefix(label, "sig-") {
+			return true
+		}
+	}
+	return false
+}
+
+func sortedNames(tests []*testCase) []string {
+	return uniqueStrings(testNames(tests))
+}
+
+// enforceBudget checks the invariants of the suite and returns an error describing every violation.
+// When a junit dir is set the results are also written as a JUnit report so violations show up like
+// any other failed test.
+func enforceBudget(budget *SuiteBudget, tests []*testCase, junitDir string, errOut io.Writer) error {
+	results := budget.check(tests)
+	var violations []string
+	for _, result := range results {
+		if result.FailureOutput != nil {
+			violations = append(violations, result.FailureOutput.Output)
+		}
+	}
+	if len(violations) == 0 {
+		return nil
+	}
+
+	if len(junitDir) > 0 {
+		s := &junitapi.JUnitTestSuite{Name: "openshift-tests-invariants"}
+		for _, result := range results {
+			s.NumTests++
+			if result.FailureOutput != nil {
+				s.NumFailed++
+			}
+			s.TestCases = append(s.TestCases, result)
+		}
+		if err := os.MkdirAll(junitDir, 0755); err != nil {
+			return err
+		}
+		if err := writeJUnitReport(s, "junit_invariants", time.Now().UTC().Format("20060102-150405"), junitDir, errOut); err != nil {
+			fmt.Fprintf(errOut, "error: Unable to write suite invariant JUnit results: %v\n", err)
+		}
+	}
+	return fmt.Errorf("the suite violates %d invariants:\n\n%s", len(violations), strings.Join(violations, "\n\n"))
+}
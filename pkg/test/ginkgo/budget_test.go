@@ -0,0 +1,77 @@
+package ginkgo
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSuiteBudget_check(t *testing.T) {
+	tests := []*testCase{
+		{name: "[sig-cli] a [Serial]", testTimeout: time.Minute},
+		{name: "[sig-cli] b [Serial]"},
+		{name: "c [Timeout:1m]", testTimeout: time.Minute},
+	}
+
+	var nilBudget *SuiteBudget
+	if results := nilBudget.check(tests); len(results) != 0 {
+		t.Errorf("expected no results without a budget, got %d", len(results))
+	}
+
+	budget := &SuiteBudget{MaxSerialTests: 2, RequireSigLabel: true, RequireTimeout: true}
+	results := budget.check(tests)
+	if len(results) != 3 {
+		t.Fatalf("expected 3 results, got %d", len(results))
+	}
+	if results[0].FailureOutput != nil {
+		t.Errorf("serial budget should pass: %s", results[0].FailureOutput.Output)
+	}
+	if results[1].FailureOutput == nil || !strings.Contains(results[1].FailureOutput.Output, "\nc [Timeout:1m]") {
+		t.Errorf("sig label invariant should fail for c: %#v", results[1].FailureOutput)
+	}
+	if results[2].FailureOutput == nil || !strings.Contains(results[2].FailureOutput.Output, "\n[sig-cli] b [Serial]") {
+		t.Errorf("timeout invariant should fail for b: %#v", results[2].FailureOutput)
+	}
+}
+
+func Test_enforceBudget(t *testing.T) {
+	dir := t.TempDir()
+	tests := []*testCase{{name: "[sig-cli] a [Serial]"}, {name: "[sig-cli] b [Serial]"}}
+
+	if err := enforceBudget(&SuiteBudget{MaxSerialTests: 2}, tests, dir, ioutil.Discard); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if err := enforceBudget(&SuiteBudget{MaxSerialTests: 1}, tests, dir, ioutil.Discard); err == nil {
+		t.Fatal("expected the serial budget to be exceeded")
+	}
+	if matches, _ := filepath.Glob(filepath.Join(dir, "junit_invariants_*.xml")); len(matches) != 1 {
+		t.Errorf("expected a JUnit report for the violation, got %v", matches)
+	}
+}
+
+func TestSuiteBudget_checkLabels(t *testing.T) {
+	tests := []*testCase{
+		{name: "[sig-cli] a [Serial]"},
+		{name: "b [Slow]"},
+		{name: "c [Disruptive]", skipped: true},
+	}
+
+	budget := (*SuiteBudget)(nil).withLabels([]string{"sig-*"}, []string{"sig-*", "Serial"})
+	results := budget.check(tests)
+	if len(results) != 2 {
+		t.Fatalf("expected 2 results, got %d", len(results))
+	}
+	if results[0].FailureOutput == nil || !strings.HasSuffix(results[0].FailureOutput.Output, "\n\nb [Slow]") {
+		t.Errorf("required label invariant should fail only for b: %#v", results[0].FailureOutput)
+	}
+	if results[1].FailureOutput == nil || !strings.HasSuffix(results[1].FailureOutput.Output, "\n\nb [Slow]: [Slow]") {
+		t.Errorf("allowed label invariant should fail only for b: %#v", results[1].FailureOutput)
+	}
+
+	original := &SuiteBudget{MaxSerialTests: 1, AllowedLabels: []string{"Serial"}}
+	if extended := original.withLabels(nil, []string{"Slow"}); extended.MaxSerialTests != 1 || len(extended.AllowedLabels) != 2 || len(original.AllowedLabels) != 1 {
+		t.Errorf("unexpected extended budget %#v from %#v", extended, original)
+	}
+}
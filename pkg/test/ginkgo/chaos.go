@@ -0,0 +1,58 @@
+package ginkgo
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/openshift/origin/pkg/chaos"
+)
+
+// faultInjector returns the faults that were injected at any time between from and to.
+type faultInjector interface {
+	Overlapping(from, to time.Time) []chaos.Injection
+}
+
+// chaosInterceptor reports a test that fails while a fault is injected, or within the settle time
+// after, as failed as expected, since the failure is explained by the fault.
+func chaosInterceptor(injector faultInjector) TestInterceptor {
+	return TestInterceptorFunc(func(ctx context.Context, test *testCase, next TestRunFunc) TestResult {
+		start := time.Now()
+		result := next(ctx)
+		switch result.State {
+		case TestFailed, TestFailedTimeout, TestUnknown:
+		default:
+			return result
+		}
+		overlapping := injector.Overlapping(start, time.Now())
+		if len(overlapping) == 0 {
+			return result
+		}
+		var faults []string
+		for _, injection := range overlapping {
+			faults = append(faults, injection.String())
+		}
+		result.State = TestFailedAsExpected
+		result.Output = append(result.Output, fmt.Sprintf("\nfailed as expected: the test ran while faults were injected: %s\n", strings.Join(faults, ", "))...)
+		return result
+	})
+}
+
+// writeChaosSummary lists the faults injected during the suite.
+func writeChaosSummary(out io.Writer, injections []chaos.Injection) {
+	if len(injections) == 0 {
+		fmt.Fprintf(out, "No faults were injected\n\n")
+		return
+	}
+	fmt.Fprintf(out, "Injected faults:\n\n")
+	for _, injection := range injections {
+		end := "in progress"
+		if !injection.To.IsZero() {
+			end = injection.To.Sub(injection.From).Round(time.Second).String()
+		}
+		fmt.Fprintf(out, "  %s %s (%s)\n", injection.From.UTC().Format(time.RFC3339), injection, end)
+	}
+	fmt.Fprintln(out)
+}
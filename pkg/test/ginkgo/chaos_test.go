@@ -0,0 +1,49 @@
+package ginkgo
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/openshift/origin/pkg/chaos"
+)
+
+func TestChaosInterceptor(t *testing.T) {
+	tests := []struct {
+		name     string
+		state    TestState
+		faults   bool
+		expected TestState
+	}{
+		{name: "failure during a fault", state: TestFailed, faults: true, expected: TestFailedAsExpected},
+		{name: "timeout during a fault", state: TestFailedTimeout, faults: true, expected: TestFailedAsExpected},
+		{name: "failure without faults", state: TestFailed, expected: TestFailed},
+		{name: "pass during a fault", state: TestSucceeded, faults: true, expected: TestSucceeded},
+		{name: "unexpected pass during a fault", state: TestUnexpectedPass, faults: true, expected: TestUnexpectedPass},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			injector := fakeFaultInjector{}
+			if tt.faults {
+				injector = fakeFaultInjector{{Fault: "reboot", Type: chaos.NodeReboot, Target: "node-a", From: time.Now()}}
+			}
+			result := chaosInterceptor(injector).InterceptTest(context.Background(), &testCase{name: "test"}, func(ctx context.Context) TestResult {
+				return TestResult{State: tt.state}
+			})
+			if result.State != tt.expected {
+				t.Errorf("expected %s, got %s", tt.expected, result.State)
+			}
+			explained := strings.Contains(string(result.Output), "failed as expected: the test ran while faults were injected: reboot (NodeReboot of node-a)")
+			if explained != (tt.expected == TestFailedAsExpected) {
+				t.Errorf("unexpected output:\n%s", result.Output)
+			}
+		})
+	}
+}
+
+type fakeFaultInjector []chaos.Injection
+
+func (f fakeFaultInjector) Overlapping(from, to time.Time) []chaos.Injection {
+	return f
+}
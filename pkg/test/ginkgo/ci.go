@@ -0,0 +1,94 @@
+package ginkgo
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/openshift/origin/pkg/test/ginkgo/junitapi"
+)
+
+// ciMetadata identifies the CI execution that ran the suite.
+type ciMetadata struct {
+	System string
+	Job    string
+	Build  string
+	Pull   string
+	Commit string
+	URL    string
+}
+
+// detectCIMetadata recognizes Prow, GitHub Actions, GitLab, and Jenkins from the environment they
+// set for every job. It returns nil when the suite is not running in a known CI system.
+func detectCIMetadata(getenv func(string) string) *ciMetadata {
+	switch {
+	case len(getenv("PROW_JOB_ID")) > 0:
+		return &ciMetadata{
+			System: "prow",
+			Job:    getenv("JOB_NAME"),
+			Build:  getenv("BUILD_ID"),
+			Pull:   getenv("PULL_NUMBER"),
+			Commit: firstNonEmpty(getenv("PULL_PULL_SHA"), getenv("PULL_BASE_SHA")),
+		}
+	case getenv("GITHUB_ACTIONS") == "true":
+		metadata := &ciMetadata{
+			System: "github-actions",
+			Job:    getenv("GITHUB_WORKFLOW"),
+			Build:  getenv("GITHUB_RUN_ID"),
+			Commit: getenv("GITHUB_SHA"),
+		}
+		if server, repository := getenv("GITHUB_SERVER_URL"), getenv("GITHUB_REPOSITORY"); len(server) > 0 && len(repository) > 0 && len(metadata.Build) > 0 {
+			metadata.URL = fmt.Sprintf("%s/%s/actions/runs/%s", server, repository, metadata.Build)
+		}
+		return metadata
+	case getenv("GITLAB_CI") == "true":
+		return &ciMetadata{
+			System: "gitlab",
+			Job:    getenv("CI_JOB_NAME"),
+			Build:  getenv("CI_JOB_ID"),
+			Pull:   getenv("CI_MERGE_REQUEST_IID"),
+			Commit: getenv("CI_COMMIT_SHA"),
+			URL:    getenv("CI_JOB_URL"),
+		}
+	case len(getenv("JENKINS_URL")) > 0:
+		return &ciMetadata{
+			System: "jenkins",
+			Job:    getenv("JOB_NAME"),
+			Build:  getenv("BUILD_NUMBER"),
+			Pull:   getenv("CHANGE_ID"),
+			Commit: getenv("GIT_COMMIT"),
+			URL:    getenv("BUILD_URL"),
+		}
+	}
+	return nil
+}
+
+// ciProperties returns the CI metadata of the current process as JUnit suite properties.
+func ciProperties() []*junitapi.TestSuiteProperty {
+	metadata := detectCIMetadata(os.Getenv)
+	if metadata == nil {
+		return nil
+	}
+	var properties []*junitapi.TestSuiteProperty
+	for _, property := range []struct{ name, value string }{
+		{"ci.system", metadata.System},
+		{"ci.job", metadata.Job},
+		{"ci.build", metadata.Build},
+		{"ci.pull", metadata.Pull},
+		{"ci.commit", metadata.Commit},
+		{"ci.url", metadata.URL},
+	} {
+		if len(property.value) > 0 {
+			properties = append(properties, &junitapi.TestSuiteProperty{Name: property.name, Value: property.value})
+		}
+	}
+	return properties
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if len(value) > 0 {
+			return value
+		}
+	}
+	return ""
+}
@@ -0,0 +1,47 @@
+package ginkgo
+
+import (
+	"reflect"
+	"testing"
+)
+
+func Test_detectCIMetadata(t *testing.T) {
+	tests := []struct {
+		name string
+		env  map[string]string
+		want *ciMetadata
+	}{
+		{
+			name: "local",
+			env:  map[string]string{"JOB_NAME": "not-ci"},
+		},
+		{
+			name: "prow presubmit",
+			env:  map[string]string{"PROW_JOB_ID": "abc", "JOB_NAME": "pull-ci-e2e", "BUILD_ID": "123", "PULL_NUMBER": "42", "PULL_BASE_SHA": "base", "PULL_PULL_SHA": "head"},
+			want: &ciMetadata{System: "prow", Job: "pull-ci-e2e", Build: "123", Pull: "42", Commit: "head"},
+		},
+		{
+			name: "github actions",
+			env:  map[string]string{"GITHUB_ACTIONS": "true", "GITHUB_WORKFLOW": "e2e", "GITHUB_RUN_ID": "7", "GITHUB_SHA": "sha", "GITHUB_SERVER_URL": "https://github.com", "GITHUB_REPOSITORY": "openshift/origin"},
+			want: &ciMetadata{System: "github-actions", Job: "e2e", Build: "7", Commit: "sha", URL: "https://github.com/openshift/origin/actions/runs/7"},
+		},
+		{
+			name: "gitlab",
+			env:  map[string]string{"GITLAB_CI": "true", "CI_JOB_NAME": "e2e", "CI_JOB_ID": "8", "CI_JOB_URL": "https://gitlab/job/8"},
+			want: &ciMetadata{System: "gitlab", Job: "e2e", Build: "8", URL: "https://gitlab/job/8"},
+		},
+		{
+			name: "jenkins",
+			env:  map[string]string{"JENKINS_URL": "https://jenkins", "JOB_NAME": "e2e", "BUILD_NUMBER": "9", "BUILD_URL": "https://jenkins/job/e2e/9"},
+			want: &ciMetadata{System: "jenkins", Job: "e2e", Build: "9", URL: "https://jenkins/job/e2e/9"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := detectCIMetadata(func(key string) string { return tt.env[key] })
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("detectCIMetadata() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,105 @@
+package ginkgo
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/uuid"
+)
+
+const (
+	cloudEventSuiteStarted  = "com.openshift.tests.suite.started"
+	cloudEventTestFailed    = "com.openshift.tests.test.failed"
+	cloudEventSuiteFinished = "com.openshift.tests.suite.finished"
+)
+
+// cloudEvent is a CloudEvents 1.0 event in the structured JSON format.
+type cloudEvent struct {
+	SpecVersion     string      `json:"specversion"`
+	ID              string      `json:"id"`
+	Source          string      `json:"source"`
+	Type            string      `json:"type"`
+	Time            time.Time   `json:"time"`
+	DataContentType string      `json:"datacontenttype"`
+	Data            interface{} `json:"data"`
+}
+
+// cloudEventSink posts suite lifecycle events to an HTTP endpoint such as a Knative broker or a
+// Tekton event listener. A nil sink sends nothing, and delivery errors never fail the suite.
+type cloudEventSink struct {
+	url    string
+	source string
+	client *http.Client
+	errOut io.Writer
+}
+
+func newCloudEventSink(url, suite string, errOut io.Writer) *cloudEventSink {
+	return &cloudEventSink{
+		url:    url,
+		source: "openshift-tests/" + suite,
+		client: &http.Client{Timeout: 10 * time.Second},
+		errOut: errOut,
+	}
+}
+
+func (s *cloudEventSink) SuiteStarted(suite string, tests int) {
+	s.send(cloudEventSuiteStarted, map[string]interface{}{
+		"suite": suite,
+		"tests": tests,
+	})
+}
+
+func (s *cloudEventSink) SuiteFinished(suite string, pass, fail, skip int, duration time.Duration) {
+	s.send(cloudEventSuiteFinished, map[string]interface{}{
+		"suite":    suite,
+		"pass":     pass,
+		"fail":     fail,
+		"skip":     skip,
+		"duration": duration.String(),
+	})
+}
+
+// FailureHook returns a hook that sends an event for every failed test.
+func (s *cloudEventSink) FailureHook() FailureHook {
+	return FailureHookFunc(func(ctx context.Context, testName string, testOutput []byte, _ string, _ io.Writer) error {
+		s.send(cloudEventTestFailed, map[string]interface{}{
+			"test":    testName,
+			"message": lastLinesUntil(string(testOutput), 10, "fail ["),
+		})
+		return nil
+	})
+}
+
+func (s *cloudEventSink) send(eventType string, data interface{}) {
+	if s == nil {
+		return
+	}
+	event := cloudEvent{
+		SpecVersion:     "1.0",
+		ID:              string(uuid.NewUUID()),
+		Source:          s.source,
+		Type:            eventType,
+		Time:            time.Now().UTC(),
+		DataContentType: "application/json",
+		Data:            data,
+	}
+	body, err := json.Marshal(event)
+	if err != nil {
+		fmt.Fprintf(s.errOut, "error: Unable to encode %s event: %v\n", eventType, err)
+		return
+	}
+	resp, err := s.client.Post(s.url, "application/cloudevents+json", bytes.NewReader(body))
+	if err != nil {
+		fmt.Fprintf(s.errOut, "error: Unable to send %s event: %v\n", eventType, err)
+		return
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		fmt.Fprintf(s.errOut, "error: Unable to send %s event: sink returned %s\n", eventType, resp.Status)
+	}
+}
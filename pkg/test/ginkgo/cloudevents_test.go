@@ -0,0 +1,53 @@
+package ginkgo
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+func Test_cloudEventSink(t *testing.T) {
+	var lock sync.Mutex
+	var received []cloudEvent
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if contentType := r.Header.Get("Content-Type"); contentType != "application/cloudevents+json" {
+			t.Errorf("unexpected content type %q", contentType)
+		}
+		event := cloudEvent{}
+		if err := json.NewDecoder(r.Body).Decode(&event); err != nil {
+			t.Errorf("unable to decode event: %v", err)
+		}
+		lock.Lock()
+		defer lock.Unlock()
+		received = append(received, event)
+	}))
+	defer server.Close()
+
+	errOut := &bytes.Buffer{}
+	sink := newCloudEventSink(server.URL, "openshift/conformance", errOut)
+	sink.SuiteStarted("openshift/conformance", 2)
+	sink.FailureHook().TestFailed(context.TODO(), "[sig-network] a", []byte("fail [a.go:1]: broken"), "", ioutil.Discard)
+	sink.SuiteFinished("openshift/conformance", 1, 1, 0, time.Minute)
+
+	if errOut.Len() > 0 {
+		t.Fatalf("unexpected errors: %s", errOut.String())
+	}
+	if len(received) != 3 {
+		t.Fatalf("expected 3 events, got %d", len(received))
+	}
+	for i, eventType := range []string{cloudEventSuiteStarted, cloudEventTestFailed, cloudEventSuiteFinished} {
+		if received[i].Type != eventType || received[i].Source != "openshift-tests/openshift/conformance" || received[i].SpecVersion != "1.0" {
+			t.Errorf("unexpected event %#v", received[i])
+		}
+	}
+
+	// a nil sink sends nothing
+	var nilSink *cloudEventSink
+	nilSink.SuiteStarted("suite", 1)
+}
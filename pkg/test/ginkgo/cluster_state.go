@@ -0,0 +1,282 @@
+package ginkgo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/restmapper"
+
+	"github.com/openshift/origin/pkg/test/ginkgo/junitapi"
+)
+
+// DefaultClusterStateResources are the cluster scoped resources, as resource.group, that are
+// snapshotted before and after a suite when the resources are "default".
+var DefaultClusterStateResources = []string{
+	"nodes",
+	"clusteroperators.config.openshift.io",
+	"machineconfigpools.machineconfiguration.openshift.io",
+	"customresourcedefinitions.apiextensions.k8s.io",
+}
+
+// expandClusterStateResources replaces "default" in resources with DefaultClusterStateResources.
+func expandClusterStateResources(resources []string) []string {
+	var expanded []string
+	for _, resource := range resources {
+		if resource == "default" {
+			expanded = append(expanded, DefaultClusterStateResources...)
+			continue
+		}
+		expanded = append(expanded, resource)
+	}
+	return expanded
+}
+
+const clusterStateDriftTestName = "[sig-arch] cluster state should not drift during the suite"
+
+// healthyConditionStatus is the status of a condition on a healthy object. A condition that had this
+// status before the suite and does not after is drift. Other conditions are reported but are not
+// drift.
+var healthyConditionStatus = map[string]string{
+	"Ready":              "True",
+	"Available":          "True",
+	"Established":        "True",
+	"Degraded":           "False",
+	"Failing":            "False",
+	"MemoryPressure":     "False",
+	"DiskPressure":       "False",
+	"PIDPressure":        "False",
+	"NetworkUnavailable": "False",
+}
+
+// clusterStateSnapshot holds the status of the conditions of every object of each resource.
+type clusterStateSnapshot struct {
+	Time      time.Time
+	Resources map[string]*resourceState
+}
+
+type resourceState struct {
+	// Error is set when the resource could not be listed, for instance because the cluster does
+	// not serve it.
+	Error string
+	// Objects maps the name of each object to the status of its conditions by type.
+	Objects map[string]map[string]string
+}
+
+type clusterStateDiff struct {
+	Before    time.Time           `json:"before"`
+	After     time.Time           `json:"after"`
+	Resources []resourceStateDiff `json:"resources"`
+}
+
+type resourceStateDiff struct {
+	Resource    string            `json:"resource"`
+	Error       string            `json:"error,omitempty"`
+	CountBefore int               `json:"countBefore"`
+	CountAfter  int               `json:"countAfter"`
+	Added       []string          `json:"added,omitempty"`
+	Removed     []string          `json:"removed,omitempty"`
+	Changed     []conditionChange `json:"changed,omitempty"`
+}
+
+type conditionChange struct {
+	Name      string `json:"name"`
+	Condition string `json:"condition"`
+	Before    string `json:"before"`
+	After     string `json:"after"`
+	Drift     bool   `json:"drift,omitempty"`
+}
+
+// clusterStateSnapshotter lists the configured resources with a dynamic client.
+type clusterStateSnapshotter struct {
+	client    dynamic.Interface
+	mapper    meta.RESTMapper
+	resources []string
+}
+
+func newClusterStateSnapshotter(config *rest.Config, resources []string) (*clusterStateSnapshotter, error) {
+	client, err := dynamic.NewForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	discoveryClient, err := discovery.NewDiscoveryClientForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	return &clusterStateSnapshotter{
+		client:    client,
+		mapper:    restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(discoveryClient)),
+		resources: resources,
+	}, nil
+}
+
+func (s *clusterStateSnapshotter) snapshot(ctx context.Context) *clusterStateSnapshot {
+	snapshot := &clusterStateSnapshot{Time: time.Now(), Resources: map[string]*resourceState{}}
+	for _, resource := range s.resources {
+		state := &resourceState{}
+		objects, err := s.list(ctx, resource)
+		if err != nil {
+			state.Error = err.Error()
+		} else {
+			state.Objects = objectConditions(objects)
+		}
+		snapshot.Resources[resource] = state
+	}
+	return snapshot
+}
+
+func (s *clusterStateSnapshotter) list(ctx context.Context, resource string) ([]unstructured.Unstructured, error) {
+	gvr, err := s.mapper.ResourceFor(schema.ParseGroupResource(resource).WithVersion(""))
+	if err != nil {
+		return nil, err
+	}
+	list, err := s.client.Resource(gvr).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+func objectConditions(objects []unstructured.Unstructured) map[string]map[string]string {
+	byName := map[string]map[string]string{}
+	for _, object := range objects {
+		statuses := map[string]string{}
+		conditions, _, _ := unstructured.NestedSlice(object.Object, "status", "conditions")
+		for _, c := range conditions {
+			condition, ok := c.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			conditionType, _, _ := unstructured.NestedString(condition, "type")
+			status, _, _ := unstructured.NestedString(condition, "status")
+			if len(conditionType) > 0 {
+				statuses[conditionType] = status
+			}
+		}
+		byName[object.GetName()] = statuses
+	}
+	return byName
+}
+
+// diffClusterState compares the snapshots taken before and after a suite. Resources that could not
+// be listed in either snapshot are reported with the error and are not compared.
+func diffClusterState(before, after *clusterStateSnapshot) *clusterStateDiff {
+	diff := &clusterStateDiff{Before: before.Time, After: after.Time}
+	var resources []string
+	for resource := range before.Resources {
+		resources = append(resources, resource)
+	}
+	sort.Strings(resources)
+
+	for _, resource := range resources {
+		previous, current := before.Resources[resource], after.Resources[resource]
+		if current == nil {
+			current = &resourceState{Error: "not in the snapshot after the suite"}
+		}
+		resourceDiff := resourceStateDiff{
+			Resource:    resource,
+			CountBefore: len(previous.Objects),
+			CountAfter:  len(current.Objects),
+		}
+		switch {
+		case len(previous.Error) > 0:
+			resourceDiff.Error = previous.Error
+		case len(current.Error) > 0:
+			resourceDiff.Error = current.Error
+		default:
+			diffResourceState(&resourceDiff, previous, current)
+		}
+		diff.Resources = append(diff.Resources, resourceDiff)
+	}
+	return diff
+}
+
+func diffResourceState(diff *resourceStateDiff, before, after *resourceState) {
+	for name, previous := range before.Objects {
+		current, ok := after.Objects[name]
+		if !ok {
+			diff.Removed = append(diff.Removed, name)
+			continue
+		}
+		for conditionType, previousStatus := range previous {
+			currentStatus := current[conditionType]
+			if currentStatus == previousStatus {
+				continue
+			}
+			healthy, ok := healthyConditionStatus[conditionType]
+			diff.Changed = append(diff.Changed, conditionChange{
+				Name:      name,
+				Condition: conditionType,
+				Before:    previousStatus,
+				After:     currentStatus,
+				Drift:     ok && previousStatus == healthy,
+			})
+		}
+	}
+	for name := range after.Objects {
+		if _, ok := before.Objects[name]; !ok {
+			diff.Added = append(diff.Added, name)
+		}
+	}
+	sort.Strings(diff.Added)
+	sort.Strings(diff.Removed)
+	sort.Slice(diff.Changed, func(i, j int) bool {
+		if diff.Changed[i].Name != diff.Changed[j].Name {
+			return diff.Changed[i].Name < diff.Changed[j].Name
+		}
+		return diff.Changed[i].Condition < diff.Changed[j].Condition
+	})
+}
+
+// drift describes the objects that were removed and the conditions that were healthy before the
+// suite and are not after.
+func (d *clusterStateDiff) drift() []string {
+	var drift []string
+	for _, resource := range d.Resources {
+		for _, name := range resource.Removed {
+			drift = append(drift, fmt.Sprintf("%s/%s was removed", resource.Resource, name))
+		}
+		for _, change := range resource.Changed {
+			if change.Drift {
+				drift = append(drift, fmt.Sprintf("%s/%s condition %s changed from %q to %q", resource.Resource, change.Name, change.Condition, change.Before, change.After))
+			}
+		}
+	}
+	return drift
+}
+
+func (d *clusterStateDiff) junit() *junitapi.JUnitTestCase {
+	drift := d.drift()
+	if len(drift) == 0 {
+		return &junitapi.JUnitTestCase{Name: clusterStateDriftTestName}
+	}
+	output := strings.Join(drift, "\n")
+	return &junitapi.JUnitTestCase{
+		Name: clusterStateDriftTestName,
+		FailureOutput: &junitapi.FailureOutput{
+			Output: output,
+		},
+		SystemOut: output,
+	}
+}
+
+func writeClusterStateDiff(dir, fileSuffix string, diff *clusterStateDiff) error {
+	data, err := json.MarshalIndent(diff, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filepath.Join(dir, fmt.Sprintf("cluster-state-diff%s.json", fileSuffix)), data, 0644)
+}
@@ -0,0 +1,136 @@
+package ginkgo
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestObjectConditions(t *testing.T) {
+	objects := []unstructured.Unstructured{
+		{Object: map[string]interface{}{
+			"metadata": map[string]interface{}{"name": "master-0"},
+			"status": map[string]interface{}{
+				"conditions": []interface{}{
+					map[string]interface{}{"type": "Ready", "status": "True"},
+					map[string]interface{}{"type": "DiskPressure", "status": "False"},
+					map[string]interface{}{"status": "Unknown"},
+				},
+			},
+		}},
+		{Object: map[string]interface{}{
+			"metadata": map[string]interface{}{"name": "no-status"},
+		}},
+	}
+	want := map[string]map[string]string{
+		"master-0":  {"Ready": "True", "DiskPressure": "False"},
+		"no-status": {},
+	}
+	if got := objectConditions(objects); !reflect.DeepEqual(want, got) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+}
+
+func TestDiffClusterState(t *testing.T) {
+	start := time.Date(2023, 4, 1, 10, 0, 0, 0, time.UTC)
+	before := &clusterStateSnapshot{Time: start, Resources: map[string]*resourceState{
+		"nodes": {Objects: map[string]map[string]string{
+			"master-0": {"Ready": "True"},
+			"worker-0": {"Ready": "True"},
+			"worker-1": {"Ready": "False"},
+		}},
+		"clusteroperators.config.openshift.io": {Objects: map[string]map[string]string{
+			"dns":     {"Available": "True", "Degraded": "False", "Progressing": "False"},
+			"ingress": {"Available": "True", "Degraded": "True"},
+		}},
+		"machineconfigpools.machineconfiguration.openshift.io": {Error: "the server could not find the requested resource"},
+	}}
+	after := &clusterStateSnapshot{Time: start.Add(time.Hour), Resources: map[string]*resourceState{
+		"nodes": {Objects: map[string]map[string]string{
+			"master-0": {"Ready": "False"},
+			"worker-1": {"Ready": "True"},
+			"worker-2": {"Ready": "True"},
+		}},
+		"clusteroperators.config.openshift.io": {Objects: map[string]map[string]string{
+			"dns":     {"Available": "True", "Degraded": "True", "Progressing": "True"},
+			"ingress": {"Available": "True", "Degraded": "False"},
+		}},
+		"machineconfigpools.machineconfiguration.openshift.io": {Error: "the server could not find the requested resource"},
+	}}
+
+	diff := diffClusterState(before, after)
+	want := []resourceStateDiff{
+		{
+			Resource:    "clusteroperators.config.openshift.io",
+			CountBefore: 2,
+			CountAfter:  2,
+			Changed: []conditionChange{
+				{Name: "dns", Condition: "Degraded", Before: "False", After: "True", Drift: true},
+				{Name: "dns", Condition: "Progressing", Before: "False", After: "True"},
+				{Name: "ingress", Condition: "Degraded", Before: "True", After: "False"},
+			},
+		},
+		{
+			Resource: "machineconfigpools.machineconfiguration.openshift.io",
+			Error:    "the server could not find the requested resource",
+		},
+		{
+			Resource:    "nodes",
+			CountBefore: 3,
+			CountAfter:  3,
+			Added:       []string{"worker-2"},
+			Removed:     []string{"worker-0"},
+			Changed: []conditionChange{
+				{Name: "master-0", Condition: "Ready", Before: "True", After: "False", Drift: true},
+				{Name: "worker-1", Condition: "Ready", Before: "False", After: "True"},
+			},
+		},
+	}
+	if !reflect.DeepEqual(want, diff.Resources) {
+		t.Fatalf("expected %#v, got %#v", want, diff.Resources)
+	}
+
+	result := diff.junit()
+	if result.FailureOutput == nil {
+		t.Fatal("expected the drift to fail the test")
+	}
+	wantOutput := strings.Join([]string{
+		`clusteroperators.config.openshift.io/dns condition Degraded changed from "False" to "True"`,
+		`nodes/worker-0 was removed`,
+		`nodes/master-0 condition Ready changed from "True" to "False"`,
+	}, "\n")
+	if result.FailureOutput.Output != wantOutput {
+		t.Errorf("expected output:\n%s\ngot:\n%s", wantOutput, result.FailureOutput.Output)
+	}
+}
+
+func TestDiffClusterStateWithoutDrift(t *testing.T) {
+	snapshot := &clusterStateSnapshot{Resources: map[string]*resourceState{
+		"customresourcedefinitions.apiextensions.k8s.io": {Objects: map[string]map[string]string{
+			"foos.example.com": {"Established": "True"},
+		}},
+	}}
+	added := &clusterStateSnapshot{Resources: map[string]*resourceState{
+		"customresourcedefinitions.apiextensions.k8s.io": {Objects: map[string]map[string]string{
+			"foos.example.com": {"Established": "True"},
+			"bars.example.com": {"Established": "False"},
+		}},
+	}}
+	if result := diffClusterState(snapshot, added).junit(); result.FailureOutput != nil {
+		t.Errorf("expected no drift, got %s", result.FailureOutput.Output)
+	}
+}
+
+func TestExpandClusterStateResources(t *testing.T) {
+	got := expandClusterStateResources([]string{"default", "storageclasses.storage.k8s.io"})
+	expected := append(append([]string{}, DefaultClusterStateResources...), "storageclasses.storage.k8s.io")
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+	if got := expandClusterStateResources(nil); len(got) != 0 {
+		t.Errorf("expected no resources, got %v", got)
+	}
+}
@@ -0,0 +1,111 @@
+package ginkgo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	configclient "github.com/openshift/client-go/config/clientset/versioned"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+
+	"github.com/openshift/origin/pkg/monitor"
+)
+
+// CollectDiagnosticsOptions gathers from a live cluster the artifacts a suite writes after its tests have
+// run, such as the intervals, alerts, and audit log summary, without running any tests.
+type CollectDiagnosticsOptions struct {
+	// ArtifactDir receives the artifacts, and is created if it does not exist
+	ArtifactDir string
+	// Duration is how long the cluster is monitored before the artifacts are gathered
+	Duration time.Duration
+	// LookBack is how long before monitoring started the intervals read back from the cluster begin
+	LookBack time.Duration
+
+	MonitorEventsOptions *MonitorEventsOptions
+
+	Out, ErrOut io.Writer
+}
+
+func NewCollectDiagnosticsOptions(out, errOut io.Writer) *CollectDiagnosticsOptions {
+	return &CollectDiagnosticsOptions{
+		Duration:             time.Minute,
+		LookBack:             time.Hour,
+		MonitorEventsOptions: NewMonitorEventsOptions(out, errOut),
+		Out:                  out,
+		ErrOut:               errOut,
+	}
+}
+
+// Run monitors the cluster for Duration, or until interrupted, and writes the artifacts to ArtifactDir.
+// Every artifact that can be gathered is written even if others fail.
+func (opt *CollectDiagnosticsOptions) Run(ctx context.Context) error {
+	if len(opt.ArtifactDir) == 0 {
+		return fmt.Errorf("an artifact directory must be specified")
+	}
+	if err := os.MkdirAll(opt.ArtifactDir, 0755); err != nil {
+		return fmt.Errorf("could not create the artifact directory: %v", err)
+	}
+	restConfig, err := monitor.GetMonitorRESTConfig()
+	if err != nil {
+		return err
+	}
+	configClient, err := configclient.NewForConfig(restConfig)
+	if err != nil {
+		return err
+	}
+
+	monitorCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	opt.MonitorEventsOptions.LookBack = opt.LookBack
+	if _, err := opt.MonitorEventsOptions.Start(monitorCtx, restConfig); err != nil {
+		return err
+	}
+	timeSuffix := fmt.Sprintf("_%s", opt.MonitorEventsOptions.GetStartTime().UTC().Format("20060102-150405"))
+
+	// an interrupt stops monitoring early, and a second one terminates while the artifacts are gathered
+	interruptCtx, stop := signal.NotifyContext(ctx, syscall.SIGINT, syscall.SIGTERM)
+	fmt.Fprintf(opt.Out, "Monitoring the cluster for %s, interrupt to gather the diagnostics sooner\n", opt.Duration)
+	select {
+	case <-interruptCtx.Done():
+	case <-time.After(opt.Duration):
+	}
+	stop()
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+
+	fmt.Fprintf(opt.Out, "Gathering diagnostics into %s\n", opt.ArtifactDir)
+	var errs []error
+	// no tests ran, so there are no intervals written by tests to read back from the artifact directory
+	if err := opt.MonitorEventsOptions.End(ctx, restConfig, ""); err != nil {
+		return err
+	}
+	if err := opt.MonitorEventsOptions.WriteRunDataToArtifactsDir(opt.ArtifactDir, timeSuffix); err != nil {
+		errs = append(errs, err)
+	}
+	if err := writeClusterOperators(ctx, configClient, opt.ArtifactDir, timeSuffix); err != nil {
+		errs = append(errs, err)
+	}
+	return utilerrors.NewAggregate(errs)
+}
+
+// writeClusterOperators writes the cluster operators, including their status, to clusteroperators.json in dir.
+func writeClusterOperators(ctx context.Context, client configclient.Interface, dir, fileSuffix string) error {
+	operators, err := client.ConfigV1().ClusterOperators().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("unable to list cluster operators: %v", err)
+	}
+	data, err := json.MarshalIndent(operators, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filepath.Join(dir, fmt.Sprintf("clusteroperators%s.json", fileSuffix)), data, 0644)
+}
@@ -0,0 +1,45 @@
+package ginkgo
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	configv1 "github.com/openshift/api/config/v1"
+	configfake "github.com/openshift/client-go/config/clientset/versioned/fake"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestWriteClusterOperators(t *testing.T) {
+	dir := t.TempDir()
+	client := configfake.NewSimpleClientset(&configv1.ClusterOperator{
+		ObjectMeta: metav1.ObjectMeta{Name: "kube-apiserver"},
+		Status: configv1.ClusterOperatorStatus{
+			Conditions: []configv1.ClusterOperatorStatusCondition{{Type: configv1.OperatorDegraded, Status: configv1.ConditionTrue, Reason: "NodeInstallerDegraded"}},
+		},
+	})
+	if err := writeClusterOperators(context.Background(), client, dir, "_20230501-100000"); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := ioutil.ReadFile(filepath.Join(dir, "clusteroperators_20230501-100000.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var operators configv1.ClusterOperatorList
+	if err := json.Unmarshal(data, &operators); err != nil {
+		t.Fatal(err)
+	}
+	if len(operators.Items) != 1 || operators.Items[0].Name != "kube-apiserver" || operators.Items[0].Status.Conditions[0].Reason != "NodeInstallerDegraded" {
+		t.Errorf("unexpected cluster operators %s", data)
+	}
+}
+
+func TestCollectDiagnosticsRequiresArtifactDir(t *testing.T) {
+	opt := NewCollectDiagnosticsOptions(ioutil.Discard, ioutil.Discard)
+	if err := opt.Run(context.Background()); err == nil {
+		t.Errorf("expected an error without an artifact directory")
+	}
+}
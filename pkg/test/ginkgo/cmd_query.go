@@ -0,0 +1,89 @@
+package ginkgo
+
+import (
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+)
+
+// QueryOptions reports which tests a selection matches without running them, so that filters can be
+// checked before they are committed to job configuration.
+type QueryOptions struct {
+	// Regex selects tests by name, in addition to the suite
+	Regex string
+	// Lint reports matching tests with a suspicious structure and fails if any are found
+	Lint bool
+
+	Out io.Writer
+}
+
+// Run prints the id and name of every test in the suite that matches the query, followed by the
+// number of matching tests with each label. When linting, the problems found in the matching tests
+// are printed instead.
+func (opt *QueryOptions) Run(suite *TestSuite) error {
+	var re *regexp.Regexp
+	if len(opt.Regex) > 0 {
+		var err error
+		if re, err = regexp.Compile(opt.Regex); err != nil {
+			return err
+		}
+	}
+	tests, err := ListTests()
+	if err != nil {
+		return err
+	}
+
+	var matched []TestInfo
+	for _, test := range tests {
+		if suite != nil && suite.Matches != nil && !suite.Matches(test.Name) {
+			continue
+		}
+		if re != nil && !re.MatchString(test.Name) {
+			continue
+		}
+		matched = append(matched, test)
+	}
+	if opt.Lint {
+		findings := lintTests(matched)
+		for _, finding := range findings {
+			fmt.Fprintln(opt.Out, finding)
+		}
+		if len(findings) > 0 {
+			return fmt.Errorf("found %d problems in %d tests", len(findings), len(matched))
+		}
+		fmt.Fprintf(opt.Out, "No problems found in %d tests\n", len(matched))
+		return nil
+	}
+	writeQueryResults(opt.Out, matched)
+	return nil
+}
+
+func writeQueryResults(out io.Writer, tests []TestInfo) {
+	labelCounts := map[string]int{}
+	for _, test := range tests {
+		fmt.Fprintf(out, "%s %q\n", test.ID, test.Name)
+		for _, label := range uniqueStrings(test.Labels) {
+			labelCounts[label]++
+		}
+	}
+	fmt.Fprintf(out, "\n%d tests matched\n", len(tests))
+	if len(labelCounts) == 0 {
+		return
+	}
+
+	labels := make([]string, 0, len(labelCounts))
+	for label := range labelCounts {
+		labels = append(labels, label)
+	}
+	sort.Slice(labels, func(i, j int) bool {
+		if labelCounts[labels[i]] != labelCounts[labels[j]] {
+			return labelCounts[labels[i]] > labelCounts[labels[j]]
+		}
+		return labels[i] < labels[j]
+	})
+	fmt.Fprintf(out, "\nLabels:\n")
+	for _, label := range labels {
+		fmt.Fprintf(out, "%6d %s\n", labelCounts[label], label)
+	}
+}
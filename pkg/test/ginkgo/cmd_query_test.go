@@ -0,0 +1,26 @@
+package ginkgo
+
+import (
+	"bytes"
+	"testing"
+)
+
+func Test_writeQueryResults(t *testing.T) {
+	out := &bytes.Buffer{}
+	writeQueryResults(out, []TestInfo{
+		{ID: "1", Name: "[sig-cli] a [Serial]", Labels: []string{"sig-cli", "Serial"}},
+		{ID: "2", Name: "[sig-cli] b", Labels: []string{"sig-cli"}},
+	})
+	want := `1 "[sig-cli] a [Serial]"
+2 "[sig-cli] b"
+
+2 tests matched
+
+Labels:
+     2 sig-cli
+     1 Serial
+`
+	if out.String() != want {
+		t.Errorf("unexpected output:\n%s\nwant:\n%s", out.String(), want)
+	}
+}
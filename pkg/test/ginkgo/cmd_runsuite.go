@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"io/ioutil"
+	"net"
 	"os"
 	"os/signal"
 	"path/filepath"
@@ -16,8 +17,13 @@ import (
 	"time"
 
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/kubernetes"
 
+	"github.com/openshift/origin/pkg/chaos"
 	"github.com/openshift/origin/pkg/monitor"
+	"github.com/openshift/origin/pkg/monitor/intervalcreation"
+	"github.com/openshift/origin/pkg/monitor/intervalquery"
+	"github.com/openshift/origin/pkg/monitortestframework"
 	"github.com/openshift/origin/pkg/riskanalysis"
 	"github.com/openshift/origin/pkg/test/ginkgo/junitapi"
 )
@@ -26,6 +32,9 @@ const (
 	// Dump pod displacements with at least 3 instances
 	minChainLen = 3
 
+	// timingFlushTimeout bounds writing the timings still queued once the suite completes
+	timingFlushTimeout = 2 * time.Minute
+
 	setupEvent       = "Setup"
 	upgradeEvent     = "Upgrade"
 	postUpgradeEvent = "PostUpgrade"
@@ -37,15 +46,30 @@ type Options struct {
 	Parallelism int
 	Count       int
 	FailFast    bool
+	// RetryFailed, if set, is the number of times each failed test is retried. A test that passes on a
+	// retry is reported as a flake, and the suite passes when every failure flaked.
+	RetryFailed int
 	Timeout     time.Duration
 	JUnitDir    string
 	TestFile    string
 	OutFile     string
+	// SuiteFile, if set, is a YAML file defining the suite to run instead of a built-in suite
+	SuiteFile string
+	// ResumeFrom, if set, is the junit dir of an interrupted run. Tests that completed in that run
+	// are reported with their previous outcome instead of running again.
+	ResumeFrom string
+
+	// ShardIndex and ShardCount, if ShardCount is set, select the part of the suite run by this
+	// job when the suite is split across independent jobs. ShardIndex starts at 0.
+	ShardIndex int
+	ShardCount int
 
 	// Regex allows a selection of a subset of tests
 	Regex string
 	// MatchFn if set is also used to filter the suite contents
 	MatchFn func(name string) bool
+	// SkipReasonFn, if set, describes why MatchFn excludes a test, for the dry run
+	SkipReasonFn func(name string) string
 
 	// SyntheticEventTests allows the caller to translate events or outside
 	// context into a failure.
@@ -55,8 +79,115 @@ type Options struct {
 
 	IncludeSuccessOutput bool
 
+	// TestEventsNamespace, if set, is the namespace in which an Event is recorded for the outcome
+	// of every test.
+	TestEventsNamespace string
+
+	// WebhookURL, if set, receives a JSON summary of the suite when it completes. The payload is
+	// compatible with Slack incoming webhooks, or Microsoft Teams ones when WebhookFormat is teams.
+	WebhookURL string
+	// WebhookFormat is the format of the webhook payload, slack (the default) or teams.
+	WebhookFormat string
+	// WebhookTemplateFile, if set, is a Go template rendering the text of the webhook message.
+	WebhookTemplateFile string
+	// WebhookArtifactURL, if set, is linked from the webhook message as the artifacts of the run.
+	WebhookArtifactURL string
+
+	// Test2JSONFile, if set, receives an event stream for every test in the format written by
+	// `go test -json`.
+	Test2JSONFile string
+
+	// Ownership, if set, maps tests to the owners recorded in the reports.
+	Ownership *Ownership
+	// Quarantine, if set, lists the tests whose failures are reported as flakes and do not fail
+	// the suite.
+	Quarantine *Quarantine
+
+	// FailureHooks gather diagnostics after each failed test.
+	FailureHooks []FailureHook
+
+	// Events, if set, receives the lifecycle events of the suite.
+	Events *EventBus
+
+	// TestInterceptors wrap the execution of every test in order, the first is outermost.
+	TestInterceptors []TestInterceptor
+
+	// TimingSinks receive the timing of every test as soon as it ends, and the rows still queued when
+	// the suite completes. A CSV export is always written to the junit dir when one is set.
+	TimingSinks []TimingSink
+
+	// ResultUploaders publish the suite results after the reports have been written.
+	ResultUploaders []ResultUploader
+
+	// CloudEventsSinkURL, if set, receives CloudEvents when the suite starts and finishes and
+	// whenever a test fails.
+	CloudEventsSinkURL string
+
+	// StepThrough runs one test at a time and asks the operator before each test whether to run it,
+	// skip it, or stop the suite.
+	StepThrough bool
+	// DeadlineWarning, if set, sends SIGUSR2 to a test this long before its timeout.
+	DeadlineWarning time.Duration
+	// PauseOnFailure holds every failed test before its cleanup deletes its namespaces, for at most
+	// PauseOnFailureTimeout, see runnerapi.PauseOnFailureEnv. The timeout of the test is extended by
+	// PauseOnFailureTimeout, and the suite stops if the operator chooses to abort it.
+	PauseOnFailure        bool
+	PauseOnFailureTimeout time.Duration
+
+	// GitHubAnnotations writes a GitHub Actions error annotation for every failing test.
+	GitHubAnnotations bool
+
+	// ClusterStateResources are the cluster scoped resources, as resource.group, snapshotted before
+	// the suite and after every test has finished. Conditions that regress and objects that are
+	// removed fail the suite. "default" stands for DefaultClusterStateResources. Empty, the default,
+	// disables the snapshots, as disruptive and scaling suites remove nodes on purpose.
+	ClusterStateResources []string
+
+	// ChartSpecs are chart spec presets or files, each rendered as an additional interval chart
+	// next to the preset charts.
+	ChartSpecs []string
+
 	CommandEnv []string
 
+	// FailOnDuplicateTests returns an error instead of a warning when tests share a name or id.
+	FailOnDuplicateTests bool
+	// RequiredLabels and AllowedLabels extend the label invariants of the suite budget.
+	RequiredLabels []string
+	AllowedLabels  []string
+	// IncludeTiers and ExcludeTiers select tests by the stability tier of their [Tier:<name>] label.
+	IncludeTiers []string
+	ExcludeTiers []string
+
+	// ProfileRunner writes CPU and heap profiles of the runner to JUnitDir and records its memory and
+	// goroutines as intervals.
+	ProfileRunner bool
+
+	// Chaos, if set, is the schedule of faults injected into the cluster while the tests run. Tests that
+	// fail while a fault is injected are reported as failed as expected.
+	Chaos *chaos.Profile
+
+	// LiveStatus redraws the running tests and counters of finished tests on ErrOut, which should be a
+	// terminal. The output of each test is still written to Out.
+	LiveStatus bool
+
+	// Color selects whether test results printed to Out are colored, see ColorAuto, ColorAlways, and
+	// ColorNever. Reports are never colored.
+	Color string
+
+	// OutputFormat selects how the progress of a run is written, see OutputFormatText,
+	// OutputFormatJSONStream, and OutputFormatSonobuoy.
+	OutputFormat string
+
+	// DryRunFormat selects the output of a dry run, see DryRunFormatNames and DryRunFormatJSON.
+	DryRunFormat string
+	// DryRunReports writes the reports of a run in which every test passed to JUnitDir during a dry run.
+	DryRunReports bool
+	// EstimateFrom is a JUnit report or CSV timing export from a previous run used to estimate test
+	// durations during a dry run or step-through, and to order tests with LongestFirst.
+	EstimateFrom string
+	// LongestFirst runs the parallel tests in order of their estimated duration, longest first.
+	LongestFirst bool
+
 	DryRun        bool
 	PrintCommands bool
 	Out, ErrOut   io.Writer
@@ -66,9 +197,10 @@ type Options struct {
 
 func NewOptions(out io.Writer, errOut io.Writer) *Options {
 	return &Options{
-		MonitorEventsOptions: NewMonitorEventsOptions(out, errOut),
-		Out:                  out,
-		ErrOut:               errOut,
+		MonitorEventsOptions:  NewMonitorEventsOptions(out, errOut),
+		PauseOnFailureTimeout: time.Hour,
+		Out:                   out,
+		ErrOut:                errOut,
 	}
 }
 
@@ -82,8 +214,19 @@ func (opt *Options) AsEnv() []string {
 func (opt *Options) SelectSuite(suites []*TestSuite, args []string) (*TestSuite, error) {
 	var suite *TestSuite
 
+	if len(opt.SuiteFile) > 0 {
+		fileSuite, err := LoadSuiteFile(opt.SuiteFile)
+		if err != nil {
+			return nil, err
+		}
+		if len(args) > 0 && args[0] != fileSuite.Name {
+			return nil, fmt.Errorf("suite %q does not match suite %q defined in %s", args[0], fileSuite.Name, opt.SuiteFile)
+		}
+		suite = fileSuite
+	}
+
 	// If a test file was provided with no suite, use the "files" suite.
-	if len(opt.TestFile) > 0 && len(args) == 0 {
+	if suite == nil && len(opt.TestFile) > 0 && len(args) == 0 {
 		suite = &TestSuite{
 			Name: "files",
 		}
@@ -138,11 +281,39 @@ func max(a, b int) int {
 func (opt *Options) Run(suite *TestSuite, junitSuiteName string) error {
 	ctx := context.Background()
 
+	if err := validateOutputFormat(opt.OutputFormat); err != nil {
+		return err
+	}
+	if opt.OutputFormat == OutputFormatSonobuoy && len(opt.JUnitDir) == 0 && !opt.DryRun {
+		return fmt.Errorf("--output-format=%s requires --junit-dir", OutputFormatSonobuoy)
+	}
+	if opt.ProfileRunner && len(opt.JUnitDir) == 0 && !opt.DryRun {
+		return fmt.Errorf("--profile-runner requires --junit-dir")
+	}
+	if err := validateShard(opt.ShardIndex, opt.ShardCount); err != nil {
+		return err
+	}
+	if err := validateTiers(opt.IncludeTiers, opt.ExcludeTiers); err != nil {
+		return err
+	}
+	notifier, err := newSuiteNotifier(opt.WebhookURL, opt.WebhookFormat, opt.WebhookTemplateFile, opt.WebhookArtifactURL)
+	if err != nil {
+		return err
+	}
+	for _, nameOrPath := range opt.ChartSpecs {
+		spec, err := intervalcreation.LoadChartSpec(nameOrPath)
+		if err != nil {
+			return fmt.Errorf("--chart-spec: %v", err)
+		}
+		opt.MonitorEventsOptions.RunDataWriters = append(opt.MonitorEventsOptions.RunDataWriters, intervalcreation.NewChartSpecEventIntervalRenderer(spec))
+	}
+
 	if len(opt.Regex) > 0 {
 		if err := filterWithRegex(suite, opt.Regex); err != nil {
 			return err
 		}
 	}
+	suiteMatches := suite.Matches
 	if opt.MatchFn != nil {
 		original := suite.Matches
 		suite.Matches = func(name string) bool {
@@ -153,12 +324,19 @@ func (opt *Options) Run(suite *TestSuite, junitSuiteName string) error {
 	syntheticEventTests := JUnitsForAllEvents{
 		opt.SyntheticEventTests,
 		suite.SyntheticEventTests,
+		JUnitForEventsFunc(monitortestframework.EvaluateInvariants),
 	}
 
 	tests, err := testsForSuite()
 	if err != nil {
 		return err
 	}
+	if duplicates := duplicateTests(tests); len(duplicates) > 0 {
+		if opt.FailOnDuplicateTests {
+			return fmt.Errorf("duplicate tests found:\n%s", strings.Join(duplicates, "\n"))
+		}
+		fmt.Fprintf(opt.ErrOut, "warning: duplicate tests found:\n%s\n", strings.Join(duplicates, "\n"))
+	}
 
 	discoveryClient, err := getDiscoveryClient()
 	if err != nil {
@@ -187,10 +365,31 @@ func (opt *Options) Run(suite *TestSuite, junitSuiteName string) error {
 		}
 	}
 
-	tests = suite.Filter(tests)
+	var excluded []dryRunExcludedTest
+	if opt.DryRun && opt.MatchFn != nil {
+		excluded = excludedByEnvironment(tests, suiteMatches, opt.MatchFn, opt.SkipReasonFn)
+	}
+	tests = filterByTier(suite.Filter(tests), opt.IncludeTiers, opt.ExcludeTiers)
 	if len(tests) == 0 {
 		return fmt.Errorf("suite %q does not contain any tests", suite.Name)
 	}
+	suite.applyTimeoutOverrides(tests)
+	opt.Ownership.assign(tests)
+	opt.Quarantine.assign(tests)
+	if err := enforceBudget(suite.Budget.withLabels(opt.RequiredLabels, opt.AllowedLabels), tests, opt.JUnitDir, opt.ErrOut); err != nil {
+		return err
+	}
+	tests = shardTests(tests, opt.ShardIndex, opt.ShardCount)
+
+	var resumed []*testCase
+	if len(opt.ResumeFrom) > 0 {
+		state, err := loadResumeState(opt.ResumeFrom)
+		if err != nil {
+			return fmt.Errorf("unable to resume from %s: %v", opt.ResumeFrom, err)
+		}
+		tests, resumed = resumeTests(tests, state, opt.ResumeFrom)
+		fmt.Fprintf(opt.ErrOut, "Resuming from %s: %d tests completed, %d tests to run\n", opt.ResumeFrom, len(resumed), len(tests))
+	}
 
 	count := opt.Count
 	if count == 0 {
@@ -202,25 +401,111 @@ func (opt *Options) Run(suite *TestSuite, junitSuiteName string) error {
 		opt.StartTime = start
 	}
 
-	timeout := opt.Timeout
+	timeout, timeoutSource := opt.Timeout, "--timeout flag"
 	if timeout == 0 {
-		timeout = suite.TestTimeout
+		timeout, timeoutSource = suite.TestTimeout, fmt.Sprintf("suite %s", suite.Name)
 	}
 	if timeout == 0 {
-		timeout = 15 * time.Minute
+		timeout, timeoutSource = 15*time.Minute, "default"
 	}
 
-	testRunnerContext := newCommandContext(opt.AsEnv(), timeout)
+	style, err := newStatusStyler(opt.Color, opt.Out)
+	if err != nil {
+		return err
+	}
+
+	var cloudEvents *cloudEventSink
+	failureHooks := opt.FailureHooks
+	if len(opt.CloudEventsSinkURL) > 0 && !opt.DryRun && !opt.PrintCommands {
+		cloudEvents = newCloudEventSink(opt.CloudEventsSinkURL, suite.Name, opt.ErrOut)
+		failureHooks = append(failureHooks, cloudEvents.FailureHook())
+	}
+	if opt.PauseOnFailure && !opt.DryRun && !opt.PrintCommands {
+		// aborting is handled like an interrupt, so the results of completed tests are still reported
+		failureHooks = append(failureHooks, newPauseOnFailureHook(func() {
+			syscall.Kill(os.Getpid(), syscall.SIGINT)
+		}))
+	}
+
+	env := opt.AsEnv()
+	var intervalListener net.Listener
+	if !opt.DryRun && !opt.PrintCommands {
+		// tests query the intervals collected by the monitor through this address once it starts
+		listener, intervalsURL, err := intervalquery.Listen()
+		if err != nil {
+			return fmt.Errorf("unable to listen for interval queries: %v", err)
+		}
+		defer listener.Close()
+		intervalListener = listener
+		env = append(env, fmt.Sprintf("%s=%s", intervalquery.URLEnv, intervalsURL))
+	}
+	interceptors := opt.TestInterceptors
+	var chaosInjector *chaos.Injector
+	if opt.Chaos != nil {
+		chaosInjector = chaos.NewInjector(opt.Chaos)
+		interceptors = append([]TestInterceptor{chaosInterceptor(chaosInjector)}, interceptors...)
+	}
+	testRunnerContext := newCommandContext(env, timeout, opt.DeadlineWarning, opt.JUnitDir, failureHooks, interceptors)
+	if opt.PauseOnFailure {
+		testRunnerContext.pauseOnFailure = opt.PauseOnFailureTimeout
+	}
 
 	if opt.PrintCommands {
 		newParallelTestQueue(testRunnerContext).OutputCommands(ctx, tests, opt.Out)
 		return nil
 	}
+	parallelism := opt.Parallelism
+	if parallelism == 0 {
+		parallelism = suite.Parallelism
+	}
+	if parallelism == 0 {
+		parallelism = 10
+	}
+	if opt.StepThrough {
+		parallelism = 1
+	}
+
+	var estimate *suiteEstimate
+	if len(opt.EstimateFrom) > 0 {
+		history, err := loadHistoricalDurations(opt.EstimateFrom)
+		if err != nil {
+			return err
+		}
+		estimate = estimateSuite(tests, history, parallelism)
+	}
+	if opt.LongestFirst {
+		if estimate == nil {
+			return fmt.Errorf("--longest-first requires --estimate-from")
+		}
+		orderLongestFirst(tests, estimate)
+	}
+
 	if opt.DryRun {
-		for _, test := range sortedTests(tests) {
-			fmt.Fprintf(opt.Out, "%q\n", test.name)
+		if opt.DryRunReports {
+			return writeDryRunReports(context.Background(), opt.JUnitDir, suite.Name, tests, opt.ResultUploaders, opt.TimingSinks, notifier, opt.Out, opt.ErrOut)
 		}
-		return nil
+		return writeDryRun(opt.Out, opt.ErrOut, opt.DryRunFormat, suite.Name, tests, excluded, setting{Value: timeout.String(), Source: timeoutSource}, estimate)
+	}
+
+	if opt.OutputFormat == OutputFormatJSONStream {
+		// standard out carries only the event stream, everything else is logged to standard error
+		if opt.Events == nil {
+			opt.Events = NewEventBus()
+		}
+		defer opt.Events.Subscribe(newJSONStreamSubscriber(opt.Out))()
+		opt.Out = opt.ErrOut
+	}
+	var sonobuoyLog string
+	if opt.OutputFormat == OutputFormatSonobuoy {
+		// the log of the run becomes the e2e.log of the results
+		log, err := ioutil.TempFile("", "openshift-tests-e2e-log-")
+		if err != nil {
+			return err
+		}
+		defer os.Remove(log.Name())
+		defer log.Close()
+		opt.Out = io.MultiWriter(opt.Out, log)
+		sonobuoyLog = log.Name()
 	}
 
 	if len(opt.JUnitDir) > 0 {
@@ -232,24 +517,56 @@ func (opt *Options) Run(suite *TestSuite, junitSuiteName string) error {
 				return fmt.Errorf("could not create --junit-dir: %v", err)
 			}
 		}
-	}
 
-	parallelism := opt.Parallelism
-	if parallelism == 0 {
-		parallelism = suite.Parallelism
+		checkpoint, closeCheckpoint, err := newResumeCheckpointSubscriber(opt.JUnitDir)
+		if err != nil {
+			return fmt.Errorf("could not create the resume checkpoint: %v", err)
+		}
+		defer closeCheckpoint()
+		// record the resumed tests again, so this run can be resumed in turn
+		for _, test := range resumed {
+			checkpoint(TestDidRun{Test: test.name, State: test.state()})
+		}
+		if opt.Events == nil {
+			opt.Events = NewEventBus()
+		}
+		defer opt.Events.Subscribe(checkpoint)()
+
+		if opt.ProfileRunner {
+			profiler, err := startRunnerProfiler(opt.JUnitDir, runnerProfileInterval, opt.ErrOut)
+			if err != nil {
+				return fmt.Errorf("could not profile the runner: %v", err)
+			}
+			defer func() {
+				if err := profiler.Stop(); err != nil {
+					fmt.Fprintf(opt.ErrOut, "error: Unable to write the runner profiles: %v\n", err)
+				}
+			}()
+		}
 	}
-	if parallelism == 0 {
-		parallelism = 10
+
+	var timings *timingStream
+	if len(opt.TimingSinks) > 0 {
+		if opt.Events == nil {
+			opt.Events = NewEventBus()
+		}
+		timings = newTimingStream(opt.TimingSinks, opt.ErrOut)
+		// export the resumed tests again, the sinks hold the timings of the whole suite
+		for _, test := range resumed {
+			timings.observe(TestDidRun{Test: test.name, State: test.state(), Timing: testTiming(test)})
+		}
+		defer opt.Events.Subscribe(timings.observe)()
 	}
 
 	ctx, cancelFn := context.WithCancel(context.Background())
 	defer cancelFn()
 	abortCh := make(chan os.Signal, 2)
 	go func() {
-		<-abortCh
+		sig := <-abortCh
 		fmt.Fprintf(opt.ErrOut, "Interrupted, terminating tests\n")
+		opt.Events.Publish(Interrupt{Signal: sig.String()})
 		cancelFn()
-		sig := <-abortCh
+		sig = <-abortCh
 		fmt.Fprintf(opt.ErrOut, "Interrupted twice, exiting (%s)\n", sig)
 		switch sig {
 		case syscall.SIGINT:
@@ -264,10 +581,37 @@ func (opt *Options) Run(suite *TestSuite, junitSuiteName string) error {
 	if err != nil {
 		return err
 	}
+	if len(opt.JUnitDir) > 0 {
+		opt.MonitorEventsOptions.IntervalStreamFile = filepath.Join(opt.JUnitDir, "e2e-events-stream.jsonl")
+	}
 	monitorEventRecorder, err := opt.MonitorEventsOptions.Start(ctx, restConfig)
 	if err != nil {
 		return err
 	}
+	go intervalquery.Serve(ctx, intervalListener, opt.MonitorEventsOptions)
+
+	runnerResourcesCtx, stopRunnerResources := context.WithCancel(ctx)
+	defer stopRunnerResources()
+	if opt.ProfileRunner {
+		go recordRunnerResources(runnerResourcesCtx, monitorEventRecorder, runnerSampleInterval)
+	}
+	if chaosInjector != nil {
+		if err := chaosInjector.Start(ctx, restConfig, monitorEventRecorder); err != nil {
+			return err
+		}
+		defer chaosInjector.Stop()
+	}
+
+	var clusterState *clusterStateSnapshotter
+	var clusterStateBefore *clusterStateSnapshot
+	if len(opt.ClusterStateResources) > 0 {
+		clusterState, err = newClusterStateSnapshotter(restConfig, expandClusterStateResources(opt.ClusterStateResources))
+		if err != nil {
+			fmt.Fprintf(opt.ErrOut, "error: Unable to snapshot the cluster state: %v\n", err)
+		} else {
+			clusterStateBefore = clusterState.snapshot(ctx)
+		}
+	}
 
 	pc, err := SetupNewPodCollector(ctx)
 	if err != nil {
@@ -282,8 +626,39 @@ func (opt *Options) Run(suite *TestSuite, junitSuiteName string) error {
 	if len(tests) == 1 && count == 1 {
 		includeSuccess = true
 	}
+	var eventRecorder *testEventRecorder
+	if len(opt.TestEventsNamespace) > 0 {
+		kubeClient, err := kubernetes.NewForConfig(restConfig)
+		if err != nil {
+			return err
+		}
+		eventRecorder = newTestEventRecorder(kubeClient, opt.TestEventsNamespace, opt.ErrOut)
+	}
+	var test2json *test2jsonWriter
+	if len(opt.Test2JSONFile) > 0 {
+		f, err := os.Create(opt.Test2JSONFile)
+		if err != nil {
+			return fmt.Errorf("could not create --test2json-file: %v", err)
+		}
+		defer f.Close()
+		test2json = newTest2JSONWriter(f, junitSuiteName)
+	}
 	testOutputLock := &sync.Mutex{}
-	testOutputConfig := newTestOutputConfig(testOutputLock, opt.Out, monitorEventRecorder, includeSuccess)
+	var live *liveStatus
+	if opt.LiveStatus {
+		live = newLiveStatus(opt.ErrOut)
+		liveCtx, stopLive := context.WithCancel(ctx)
+		liveDone := make(chan struct{})
+		go func() {
+			defer close(liveDone)
+			live.Run(liveCtx, time.Second)
+		}()
+		defer func() {
+			stopLive()
+			<-liveDone
+		}()
+	}
+	testOutputConfig := newTestOutputConfig(testOutputLock, opt.Out, monitorEventRecorder, eventRecorder, test2json, style, live, opt.Events, includeSuccess)
 
 	early, notEarly := splitTests(tests, func(t *testCase) bool {
 		return strings.Contains(t.name, "[Early]")
@@ -330,8 +705,20 @@ func (opt *Options) Run(suite *TestSuite, junitSuiteName string) error {
 
 	tests = nil
 
+	cloudEvents.SuiteStarted(suite.Name, expectedTestCount)
+	opt.Events.Publish(SuiteWillBegin{Suite: suite.Name, TestCount: expectedTestCount})
+
+	var stepThrough *stepThroughPrompt
+	if opt.StepThrough {
+		// quitting is handled like an interrupt, so the results of completed tests are still reported
+		stepThrough = newStepThroughPrompt(os.Stdin, opt.Out, estimate, func() {
+			syscall.Kill(os.Getpid(), syscall.SIGINT)
+		})
+	}
+
 	// run our Early tests
 	q := newParallelTestQueue(testRunnerContext)
+	q.stepThrough = stepThrough
 	q.Execute(testCtx, early, parallelism, testOutputConfig, abortFn)
 	tests = append(tests, early...)
 
@@ -386,6 +773,7 @@ func (opt *Options) Run(suite *TestSuite, junitSuiteName string) error {
 
 	// calculate the effective test set we ran, excluding any incompletes
 	tests, _ = splitTests(tests, func(t *testCase) bool { return t.success || t.flake || t.failed || t.skipped })
+	tests = append(tests, resumed...)
 
 	end := time.Now()
 	duration := end.Sub(start).Round(time.Second / 10)
@@ -396,36 +784,32 @@ func (opt *Options) Run(suite *TestSuite, junitSuiteName string) error {
 	pass, fail, skip, failing := summarizeTests(tests)
 
 	// attempt to retry failures to do flake detection
-	if fail > 0 && fail <= suite.MaximumAllowedFlakes {
-		var retries []*testCase
+	retryAttempts, maxRetried := 0, 0
+	switch {
+	case fail > 0 && opt.RetryFailed > 0:
+		retryAttempts, maxRetried = opt.RetryFailed, len(failing)
+	case fail > 0 && fail <= suite.MaximumAllowedFlakes:
+		retryAttempts, maxRetried = 1, suite.MaximumAllowedFlakes
+	}
+	if retryAttempts > 0 {
+		var toRetry []*testCase
 
-		// Make a copy of the all failing tests (subject to the max allowed flakes) so we can have
-		// a list of tests to retry.
+		// Make a list of the failing tests (subject to the max allowed flakes) to retry.
 		for _, test := range failing {
-			retry := test.Retry()
-			retries = append(retries, retry)
-			if len(retries) > suite.MaximumAllowedFlakes {
+			toRetry = append(toRetry, test)
+			if len(toRetry) > maxRetried {
 				break
 			}
 		}
 
-		fmt.Fprintf(opt.Out, "Retry count: %d\n", len(retries))
+		fmt.Fprintf(opt.Out, "Retry count: %d\n", len(toRetry))
 
-		// Run the tests in the retries list.
+		// Run the tests in the retries list until they pass or run out of attempts.
 		q := newParallelTestQueue(testRunnerContext)
-		q.Execute(testCtx, retries, parallelism, testOutputConfig, abortFn)
-
-		var flaky, skipped []string
-		var repeatFailures []*testCase
-		for _, test := range retries {
-			if test.success {
-				flaky = append(flaky, test.name)
-			} else if test.skipped {
-				skipped = append(skipped, test.name)
-			} else {
-				repeatFailures = append(repeatFailures, test)
-			}
-		}
+		q.stepThrough = stepThrough
+		retries, flaky, skipped, repeatFailures := retryFailedTests(testCtx, toRetry, retryAttempts, func(ctx context.Context, tests []*testCase) {
+			q.Execute(ctx, tests, parallelism, testOutputConfig, abortFn)
+		})
 
 		// Add the list of retries into the list of all tests.
 		for _, retry := range retries {
@@ -462,6 +846,12 @@ func (opt *Options) Run(suite *TestSuite, junitSuiteName string) error {
 		}
 	}
 
+	// every test, including retries, has finished
+	var clusterStateChanges *clusterStateDiff
+	if clusterStateBefore != nil {
+		clusterStateChanges = diffClusterState(clusterStateBefore, clusterState.snapshot(ctx))
+	}
+
 	// monitor the cluster while the tests are running and report any detected anomalies
 	var syntheticTestResults []*junitapi.JUnitTestCase
 	var syntheticFailure bool
@@ -469,6 +859,12 @@ func (opt *Options) Run(suite *TestSuite, junitSuiteName string) error {
 	timeSuffix := fmt.Sprintf("_%s", opt.MonitorEventsOptions.GetStartTime().
 		UTC().Format("20060102-150405"))
 
+	stopRunnerResources()
+	if chaosInjector != nil {
+		// wait for the cluster to recover from the faults in progress before the monitor ends
+		chaosInjector.Stop()
+		writeChaosSummary(opt.Out, chaosInjector.Injections())
+	}
 	if err := opt.MonitorEventsOptions.End(ctx, restConfig, opt.JUnitDir); err != nil {
 		return err
 	}
@@ -525,14 +921,32 @@ func (opt *Options) Run(suite *TestSuite, junitSuiteName string) error {
 		wasMasterNodeUpdated = monitor.WasMasterNodeUpdated(events)
 	}
 
+	if clusterStateChanges != nil {
+		result := clusterStateChanges.junit()
+		syntheticTestResults = append(syntheticTestResults, result)
+		if result.FailureOutput != nil {
+			fmt.Fprintf(opt.Out, "Cluster state drifted during the suite:\n\n%s\n\n", result.FailureOutput.Output)
+			syntheticFailure = true
+		}
+	}
+
+	writeResourceSummary(opt.Out, tests, 10)
+	owners := ownerRollups(tests)
+	writeOwnerSummary(opt.Out, owners)
+
 	// report the outcome of the test
 	if len(failing) > 0 {
 		names := sets.NewString(testNames(failing)...).List()
 		fmt.Fprintf(opt.Out, "Failing tests:\n\n%s\n\n", strings.Join(names, "\n"))
+		fmt.Fprintf(opt.Out, "Rerun failing tests with:\n\n%s\n\n", strings.Join(rerunCommands(testRunnerContext, failing), "\n"))
+		if opt.GitHubAnnotations {
+			writeGitHubAnnotations(opt.Out, failing)
+		}
 	}
 
 	if len(opt.JUnitDir) > 0 {
 		finalSuiteResults := generateJUnitTestSuiteResults(junitSuiteName, duration, tests, syntheticTestResults...)
+		finalSuiteResults.Properties = append(finalSuiteResults.Properties, shardProperties(opt.ShardIndex, opt.ShardCount)...)
 		if err := writeJUnitReport(finalSuiteResults, "junit_e2e", timeSuffix, opt.JUnitDir, opt.ErrOut); err != nil {
 			fmt.Fprintf(opt.Out, "error: Unable to write e2e JUnit xml results: %v", err)
 		}
@@ -540,10 +954,75 @@ func (opt *Options) Run(suite *TestSuite, junitSuiteName string) error {
 		if err := riskanalysis.WriteJobRunTestFailureSummary(opt.JUnitDir, timeSuffix, finalSuiteResults, wasMasterNodeUpdated); err != nil {
 			fmt.Fprintf(opt.Out, "error: Unable to write e2e job run failures summary: %v", err)
 		}
+
+		if err := writeTimingsToDir(opt.JUnitDir, timeSuffix, testTimings(tests)); err != nil {
+			fmt.Fprintf(opt.Out, "error: Unable to write test timings: %v", err)
+		}
+
+		if err := writeOwnerRollupToDir(opt.JUnitDir, timeSuffix, owners); err != nil {
+			fmt.Fprintf(opt.Out, "error: Unable to write test owners: %v", err)
+		}
+
+		if err := writeTimelineToDir(opt.JUnitDir, timeSuffix, tests); err != nil {
+			fmt.Fprintf(opt.Out, "error: Unable to write test timeline: %v", err)
+		}
+
+		if err := writeExternalIDResults(opt.JUnitDir, timeSuffix, tests); err != nil {
+			fmt.Fprintf(opt.Out, "error: Unable to write test case management results: %v", err)
+		}
+
+		if err := writeTestArtifactIndex(opt.JUnitDir, tests); err != nil {
+			fmt.Fprintf(opt.Out, "error: Unable to write test artifact index: %v", err)
+		}
+
+		if err := writeFailureFingerprints(opt.JUnitDir, timeSuffix, tests); err != nil {
+			fmt.Fprintf(opt.Out, "error: Unable to write failure fingerprints: %v", err)
+		}
+
+		if len(sonobuoyLog) > 0 {
+			if err := writeSonobuoyResults(opt.JUnitDir, timeSuffix, finalSuiteResults, sonobuoyLog); err != nil {
+				fmt.Fprintf(opt.Out, "error: Unable to write Sonobuoy results: %v", err)
+			}
+		}
+
+		if clusterStateChanges != nil {
+			if err := writeClusterStateDiff(opt.JUnitDir, timeSuffix, clusterStateChanges); err != nil {
+				fmt.Fprintf(opt.Out, "error: Unable to write cluster state diff: %v", err)
+			}
+		}
+
+		for _, uploader := range opt.ResultUploaders {
+			if err := uploader.UploadResults(ctx, opt.JUnitDir, finalSuiteResults); err != nil {
+				fmt.Fprintf(opt.ErrOut, "error: Unable to upload results: %v\n", err)
+			}
+		}
+	}
+
+	if timings != nil {
+		// the suite may have been interrupted, the rows still queued are written regardless
+		flushCtx, cancel := context.WithTimeout(context.Background(), timingFlushTimeout)
+		timings.Flush(flushCtx)
+		cancel()
+	}
+
+	suiteFailed := syntheticFailure || ctx.Err() != nil ||
+		(fail > 0 && (len(failing) > 0 || (suite.MaximumAllowedFlakes == 0 && opt.RetryFailed == 0)))
+	test2json.SuiteEnded(!suiteFailed, time.Since(start))
+
+	cloudEvents.SuiteFinished(suite.Name, pass, fail, skip, duration)
+	opt.Events.Publish(SuiteDidEnd{Suite: suite.Name, Pass: pass, Fail: fail, Skip: skip, Duration: duration})
+
+	if notifier != nil {
+		// ctx is cancelled when the suite is interrupted, which is when the notification matters most
+		notifyCtx, cancel := context.WithTimeout(context.Background(), notifyTimeout)
+		if err := notifier.notify(notifyCtx, suite.Name, pass, fail, skip, duration, sets.NewString(testNames(failing)...).List()); err != nil {
+			fmt.Fprintf(opt.ErrOut, "error: Unable to notify webhook of suite results: %v\n", err)
+		}
+		cancel()
 	}
 
 	if fail > 0 {
-		if len(failing) > 0 || suite.MaximumAllowedFlakes == 0 {
+		if len(failing) > 0 || (suite.MaximumAllowedFlakes == 0 && opt.RetryFailed == 0) {
 			return fmt.Errorf("%d fail, %d pass, %d skip (%s)", fail, pass, skip, duration)
 		}
 		fmt.Fprintf(opt.Out, "%d flakes detected, suite allows passing with only flakes\n\n", fail)
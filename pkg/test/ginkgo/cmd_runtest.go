@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"os"
 	"regexp"
 	"strings"
 	"time"
@@ -12,7 +13,9 @@ import (
 	"github.com/onsi/ginkgo/v2/types"
 
 	"github.com/openshift/origin/pkg/monitor"
+	"github.com/openshift/origin/pkg/monitortestframework"
 	"github.com/openshift/origin/pkg/test/ginkgo/result"
+	"github.com/openshift/origin/pkg/test/ginkgo/runnerapi"
 )
 
 type ExitError struct {
@@ -30,6 +33,25 @@ type TestOptions struct {
 	EnableMonitor        bool
 	MonitorEventsOptions *MonitorEventsOptions
 
+	// DebugOnFailure pauses the process or runs a command when the test fails or panics, see
+	// DebugOnFailureWait.
+	DebugOnFailure string
+	// PauseOnFailure holds a failed test before its cleanup deletes its namespaces, for at most
+	// PauseOnFailureTimeout, see runnerapi.PauseOnFailureEnv. The test also pauses when the runner sets
+	// the variable.
+	PauseOnFailure        bool
+	PauseOnFailureTimeout time.Duration
+	// ElideStackPackages are packages, such as assertion libraries and shared helpers, whose frames are
+	// removed from reported stacks. A failure raised in one of them is reported at its caller.
+	ElideStackPackages []string
+	// GoroutineLeakCheck reports goroutines the test leaves running, see LeakCheckWarn and
+	// LeakCheckFail. GoroutineLeakAllowList adds to the functions whose goroutines are ignored.
+	GoroutineLeakCheck     string
+	GoroutineLeakAllowList []string
+	// FileLeakCheck reports file descriptors and temporary files the test leaves behind, see
+	// LeakCheckWarn and LeakCheckFail.
+	FileLeakCheck string
+
 	DryRun bool
 	Out    io.Writer
 	ErrOut io.Writer
@@ -39,9 +61,10 @@ var _ ginkgo.GinkgoTestingT = &TestOptions{}
 
 func NewTestOptions(out io.Writer, errOut io.Writer) *TestOptions {
 	return &TestOptions{
-		MonitorEventsOptions: NewMonitorEventsOptions(out, errOut),
-		Out:                  out,
-		ErrOut:               errOut,
+		MonitorEventsOptions:  NewMonitorEventsOptions(out, errOut),
+		PauseOnFailureTimeout: time.Hour,
+		Out:                   out,
+		ErrOut:                errOut,
 	}
 }
 
@@ -68,6 +91,8 @@ func (opt *TestOptions) Run(args []string) error {
 	if test == nil {
 		return fmt.Errorf("no test exists with that name: %s", args[0])
 	}
+	// the spec tree is built, monitor tests registered by the spec would never be evaluated
+	monitortestframework.CloseRegistration()
 
 	if opt.DryRun {
 		fmt.Fprintf(opt.Out, "Running test (dry-run)\n")
@@ -101,6 +126,22 @@ func (opt *TestOptions) Run(args []string) error {
 	reporterConfig.NoColor = true
 
 	ginkgo.SetReporterConfig(reporterConfig)
+	if opt.PauseOnFailure {
+		os.Setenv(runnerapi.PauseOnFailureEnv, opt.PauseOnFailureTimeout.String())
+	}
+	var goroutinesBefore map[string]string
+	if len(opt.GoroutineLeakCheck) > 0 {
+		goroutinesBefore = goroutineSnapshot()
+	}
+	var filesBefore *fileSnapshot
+	if len(opt.FileLeakCheck) > 0 {
+		tempDir, restore, err := usePrivateTempDir()
+		if err != nil {
+			return err
+		}
+		defer restore()
+		filesBefore = takeFileSnapshot(tempDir)
+	}
 	ginkgo.GetSuite().RunSpec(test.spec, ginkgo.Labels{}, "", ginkgo.GetFailer(), ginkgo.GetWriter(), suiteConfig)
 
 	if opt.EnableMonitor {
@@ -121,6 +162,38 @@ func (opt *TestOptions) Run(args []string) error {
 		}
 	}
 
+	summary.Failure.Location = trimLocation(summary.Failure.Location, opt.ElideStackPackages)
+
+	if goroutinesBefore != nil {
+		leaked := leakedGoroutines(goroutinesBefore, append(defaultGoroutineLeakAllowList, opt.GoroutineLeakAllowList...), 5*time.Second)
+		if len(leaked) > 0 {
+			fmt.Fprintf(opt.ErrOut, "\nThe test left %d goroutines running:\n\n%s\n\n", len(leaked), strings.Join(leaked, "\n\n"))
+			if opt.GoroutineLeakCheck == LeakCheckFail && summary.State == types.SpecStatePassed {
+				fmt.Fprintf(opt.ErrOut, "fail [goroutine leak]: the test left %d goroutines running\n", len(leaked))
+				return ExitError{Code: 1}
+			}
+		}
+	}
+	if filesBefore != nil {
+		leaked := leakedFiles(filesBefore)
+		if len(leaked) > 0 {
+			fmt.Fprintf(opt.ErrOut, "\nThe test left %d files behind:\n\n%s\n\n", len(leaked), strings.Join(leaked, "\n"))
+			if opt.FileLeakCheck == LeakCheckFail && summary.State == types.SpecStatePassed {
+				fmt.Fprintf(opt.ErrOut, "fail [file leak]: the test left %d files behind\n", len(leaked))
+				return ExitError{Code: 1}
+			}
+		}
+	}
+
+	for _, artifact := range specArtifacts(summary) {
+		fmt.Fprintf(opt.ErrOut, "%s%s\n", attachedArtifactPrefix, artifact)
+	}
+	for _, entry := range summary.ReportEntries {
+		if entry.Name == runnerapi.PauseChoiceReportEntry {
+			fmt.Fprintf(opt.ErrOut, "%s%s\n", runnerapi.PauseChoicePrefix, entry.Value.String())
+		}
+	}
+
 	switch {
 	case summary.State == types.SpecStatePassed:
 		if s, ok := result.LastFlake(); ok {
@@ -136,6 +209,15 @@ func (opt *TestOptions) Run(args []string) error {
 		}
 		return ExitError{Code: 3}
 	case summary.State == types.SpecStateFailed, summary.State == types.SpecStatePanicked, summary.State == types.SpecStateInterrupted:
+		if err := debugOnFailure(opt.DebugOnFailure, test.name, opt.ErrOut); err != nil {
+			fmt.Fprintf(opt.ErrOut, "error: Debug command failed: %v\n", err)
+		}
+		if namespaces := specNamespaces(summary); len(namespaces) > 0 {
+			fmt.Fprintf(opt.ErrOut, "\nThe test ran in namespaces: %s\n", strings.Join(namespaces, ", "))
+		}
+		if snapshots := specMetrics(summary); len(snapshots) > 0 {
+			fmt.Fprintf(opt.ErrOut, "\nCluster metrics during the test:\n  %s\n", strings.Join(snapshots, "\n  "))
+		}
 		if len(summary.Failure.ForwardedPanic) > 0 {
 			if len(summary.Failure.Location.FullStackTrace) > 0 {
 				fmt.Fprintf(opt.ErrOut, "\n%s\n", summary.Failure.Location.FullStackTrace)
@@ -156,6 +238,41 @@ func (opt *TestOptions) Fail() {
 	// it's empty becase we have failure check mechanism implemented above.
 }
 
+// specNamespaces returns the namespaces the test created, as recorded in its report.
+func specNamespaces(report types.SpecReport) []string {
+	var namespaces []string
+	for _, entry := range report.ReportEntries {
+		if entry.Name == runnerapi.NamespaceReportEntry {
+			namespaces = append(namespaces, entry.Value.String())
+		}
+	}
+	return namespaces
+}
+
+// specMetrics returns the cluster metrics recorded at the start and end of the test.
+func specMetrics(report types.SpecReport) []string {
+	var snapshots []string
+	for _, entry := range report.ReportEntries {
+		if entry.Name == runnerapi.SpecMetricsReportEntry {
+			snapshots = append(snapshots, entry.Value.String())
+		}
+	}
+	return snapshots
+}
+
+// specArtifacts returns the artifacts the test attached, as recorded in its report.
+func specArtifacts(report types.SpecReport) []string {
+	var artifacts []string
+	seen := map[string]bool{}
+	for _, entry := range report.ReportEntries {
+		if entry.Name == runnerapi.ArtifactReportEntry && !seen[entry.Value.String()] {
+			seen[entry.Value.String()] = true
+			artifacts = append(artifacts, entry.Value.String())
+		}
+	}
+	return artifacts
+}
+
 func lastFilenameSegment(filename string) string {
 	if parts := strings.Split(filename, "/vendor/"); len(parts) > 1 {
 		return parts[len(parts)-1]
@@ -0,0 +1,41 @@
+package ginkgo
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/onsi/ginkgo/v2/types"
+
+	"github.com/openshift/origin/pkg/test/ginkgo/runnerapi"
+	exutil "github.com/openshift/origin/test/extended/util"
+)
+
+func TestSpecNamespaces(t *testing.T) {
+	report := types.SpecReport{
+		ReportEntries: types.ReportEntries{
+			{Name: runnerapi.NamespaceReportEntry, Value: types.WrapEntryValue("e2e-test-a")},
+			{Name: "By Step", Value: types.WrapEntryValue("creating a pod")},
+			{Name: runnerapi.NamespaceReportEntry, Value: types.WrapEntryValue("e2e-test-b")},
+		},
+	}
+	want := []string{"e2e-test-a", "e2e-test-b"}
+	if got := specNamespaces(report); !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+	if got := specNamespaces(types.SpecReport{}); got != nil {
+		t.Errorf("expected no namespaces, got %v", got)
+	}
+}
+
+func TestSpecMetrics(t *testing.T) {
+	report := types.SpecReport{
+		ReportEntries: types.ReportEntries{
+			{Name: runnerapi.SpecMetricsReportEntry, Value: types.WrapEntryValue(exutil.SpecMetricsSnapshot{Boundary: "start"})},
+			{Name: runnerapi.NamespaceReportEntry, Value: types.WrapEntryValue("e2e-test-a")},
+		},
+	}
+	want := []string{"start 0001-01-01T00:00:00Z: "}
+	if got := specMetrics(report); !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+}
@@ -0,0 +1,40 @@
+package ginkgo
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"os/signal"
+	"syscall"
+)
+
+// DebugOnFailureWait pauses a failed test process until it receives SIGUSR1, so a debugger can be
+// attached. Any other non-empty value of TestOptions.DebugOnFailure is run as a shell command.
+const DebugOnFailureWait = "wait"
+
+// debugOnFailure gives a developer the chance to inspect the test process after a test fails or
+// panics, before the process runs its cleanup and exits. The command is run with the TEST_PID and
+// TEST_NAME environment variables, e.g. 'dlv attach $TEST_PID'. The parent suite still enforces the
+// test timeout, so this is intended for tests run directly with run-test.
+func debugOnFailure(mode, testName string, out io.Writer) error {
+	pid := os.Getpid()
+	switch mode {
+	case "":
+		return nil
+	case DebugOnFailureWait:
+		ch := make(chan os.Signal, 1)
+		signal.Notify(ch, syscall.SIGUSR1)
+		defer signal.Stop(ch)
+		fmt.Fprintf(out, "Test %q failed, process %d is paused. Attach a debugger with 'dlv attach %d' and run 'kill -USR1 %d' to continue.\n", testName, pid, pid, pid)
+		<-ch
+		return nil
+	default:
+		cmd := exec.Command("/bin/sh", "-c", mode)
+		cmd.Env = append(os.Environ(), fmt.Sprintf("TEST_PID=%d", pid), fmt.Sprintf("TEST_NAME=%s", testName))
+		cmd.Stdin = os.Stdin
+		cmd.Stdout = out
+		cmd.Stderr = out
+		return cmd.Run()
+	}
+}
@@ -0,0 +1,26 @@
+package ginkgo
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"testing"
+)
+
+func Test_debugOnFailure(t *testing.T) {
+	out := &bytes.Buffer{}
+	if err := debugOnFailure("", "[sig-cli] a", out); err != nil || out.Len() > 0 {
+		t.Errorf("expected nothing to happen when disabled, got %v %q", err, out.String())
+	}
+
+	if err := debugOnFailure(`echo "$TEST_PID $TEST_NAME"`, "[sig-cli] a", out); err != nil {
+		t.Fatal(err)
+	}
+	if want := fmt.Sprintf("%d [sig-cli] a\n", os.Getpid()); out.String() != want {
+		t.Errorf("output = %q, want %q", out.String(), want)
+	}
+
+	if err := debugOnFailure("exit 2", "[sig-cli] a", out); err == nil {
+		t.Error("expected the failing command to return an error")
+	}
+}
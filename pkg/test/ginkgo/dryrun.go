@@ -0,0 +1,165 @@
+package ginkgo
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+const (
+	// DryRunFormatNames prints the name of every test, one per line.
+	DryRunFormatNames = ""
+	// DryRunFormatJSON prints a JSON document describing every test.
+	DryRunFormatJSON = "json"
+)
+
+// setting is the effective value of a runner setting for a test and where that value was configured.
+type setting struct {
+	Value  string `json:"value"`
+	Source string `json:"source"`
+}
+
+// dryRunTest describes a test the suite would run, including everything the runner computes
+// about it before execution.
+type dryRunTest struct {
+	Name        string   `json:"name"`
+	ID          string   `json:"id"`
+	Owner       string   `json:"owner,omitempty"`
+	Labels      []string `json:"labels,omitempty"`
+	Annotations string   `json:"annotations,omitempty"`
+	// AnnotationRules are the rules of the annotation generator that added each annotated label
+	AnnotationRules []annotationRule `json:"annotationRules,omitempty"`
+	// Metadata is recorded with the test by the registered annotators
+	Metadata    map[string]string `json:"metadata,omitempty"`
+	APIGroups   []string          `json:"apiGroups,omitempty"`
+	ExternalIDs []string          `json:"externalIDs,omitempty"`
+	Serial      bool              `json:"serial"`
+	// Timeout is the effective timeout of the test, which is either set on the test or inherited
+	// from the suite
+	Timeout string `json:"timeout"`
+	// Locations are the code locations of the containers and the test itself, outermost first
+	Locations  []string `json:"locations,omitempty"`
+	Skipped    bool     `json:"skipped,omitempty"`
+	SkipReason string   `json:"skipReason,omitempty"`
+	// EstimatedDuration is predicted from a previous run, when one is provided
+	EstimatedDuration string `json:"estimatedDuration,omitempty"`
+	// Settings explains the value of every setting the runner applies to the test and where it came from
+	Settings map[string]setting `json:"settings"`
+}
+
+type dryRunListing struct {
+	Suite    string         `json:"suite"`
+	Estimate *suiteEstimate `json:"estimate,omitempty"`
+	Tests    []dryRunTest   `json:"tests"`
+	// Excluded are the tests of the suite that are not run because of the environment, such as the
+	// platform or network of the cluster
+	Excluded []dryRunExcludedTest `json:"excluded,omitempty"`
+}
+
+// dryRunExcludedTest is a test of the suite that cannot run in the environment.
+type dryRunExcludedTest struct {
+	Name   string `json:"name"`
+	Reason string `json:"reason"`
+}
+
+func newDryRunTest(test *testCase, suiteTimeout setting, estimate *suiteEstimate) dryRunTest {
+	timeout := suiteTimeout
+	if test.testTimeout != 0 {
+		timeout = setting{Value: test.testTimeout.String(), Source: "[Timeout] label on the test"}
+		if len(test.timeoutSource) > 0 {
+			timeout.Source = test.timeoutSource
+		}
+	}
+	execution := setting{Value: "parallel", Source: "default"}
+	if isSerialTest(test) {
+		execution = setting{Value: "serial", Source: "[Serial] label on the test"}
+	}
+	t := dryRunTest{
+		Name:            test.name,
+		ID:              test.id(),
+		Owner:           test.owner(),
+		Labels:          test.labels(),
+		Annotations:     test.annotations,
+		AnnotationRules: explainAnnotations(test, loadAnnotationRules()),
+		Metadata:        test.metadata,
+		APIGroups:       test.apigroups,
+		Serial:          isSerialTest(test),
+		Timeout:         timeout.Value,
+		Skipped:         test.skipped,
+
+		EstimatedDuration: estimate.durationFor(test),
+		Settings: map[string]setting{
+			"timeout":   timeout,
+			"execution": execution,
+		},
+	}
+	for _, id := range test.externalIDs() {
+		t.ExternalIDs = append(t.ExternalIDs, id.String())
+	}
+	for _, location := range test.locations {
+		t.Locations = append(t.Locations, fmt.Sprintf("%s:%d", location.FileName, location.LineNumber))
+	}
+	if len(test.expectedFailure) > 0 {
+		t.Settings["expected-failure"] = setting{Value: test.expectedFailure, Source: "[ExpectedFailure] label on the test"}
+	}
+	if len(test.quarantine) > 0 {
+		t.Settings["quarantine"] = setting{Value: test.quarantine, Source: "--quarantine-file"}
+	}
+	if test.skipped {
+		t.SkipReason = string(test.testOutputBytes)
+		t.Settings["skip"] = setting{Value: "true", Source: t.SkipReason}
+	}
+	return t
+}
+
+// writeDryRun prints the tests in the requested format. If an estimate is provided, the projected
+// duration of the suite is written to errOut so the list of names remains usable as a test file.
+// The tests excluded by the environment are only described in the JSON format.
+func writeDryRun(out, errOut io.Writer, format, suite string, tests []*testCase, excluded []dryRunExcludedTest, suiteTimeout setting, estimate *suiteEstimate) error {
+	switch format {
+	case DryRunFormatNames:
+		for _, test := range sortedTests(tests) {
+			fmt.Fprintf(out, "%q\n", test.name)
+		}
+		if estimate != nil {
+			fmt.Fprintf(errOut, "Estimated duration with %d parallel tests: %s (%s serial, %d tests without history)\n", estimate.Parallelism, estimate.WallTime, estimate.Serial, estimate.Unknown)
+		}
+		return nil
+	case DryRunFormatJSON:
+		listing := dryRunListing{Suite: suite, Estimate: estimate, Excluded: excluded}
+		for _, test := range sortedTests(tests) {
+			listing.Tests = append(listing.Tests, newDryRunTest(test, suiteTimeout, estimate))
+		}
+		data, err := json.MarshalIndent(listing, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(out, string(data))
+		return nil
+	default:
+		return fmt.Errorf("unrecognized dry-run format %q", format)
+	}
+}
+
+// excludedByEnvironment returns the tests that belong to the suite but are excluded by matchFn, with
+// the reason given by skipReasonFn.
+func excludedByEnvironment(tests []*testCase, suiteMatches, matchFn func(name string) bool, skipReasonFn func(name string) string) []dryRunExcludedTest {
+	var excluded []dryRunExcludedTest
+	for _, test := range sortedTests(tests) {
+		if !suiteMatches(test.name) || matchFn(test.name) {
+			continue
+		}
+		reason := "excluded by the cluster configuration"
+		if skipReasonFn != nil {
+			switch label := skipReasonFn(test.name); {
+			case strings.HasPrefix(label, "[Requires:"):
+				reason = fmt.Sprintf("the test name contains %s, which the cluster does not provide", label)
+			case len(label) > 0:
+				reason = fmt.Sprintf("the test name contains %s, which the cluster configuration excludes", label)
+			}
+		}
+		excluded = append(excluded, dryRunExcludedTest{Name: test.name, Reason: reason})
+	}
+	return excluded
+}
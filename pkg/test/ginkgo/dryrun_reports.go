@@ -0,0 +1,114 @@
+package ginkgo
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/openshift/origin/pkg/test/ginkgo/runnerapi"
+)
+
+// DryRunner is implemented by the result uploaders and timing sinks that can check their
+// configuration, such as their credentials, without publishing anything. DryRun returns a
+// description of where they would write.
+type DryRunner interface {
+	DryRun(ctx context.Context) (string, error)
+}
+
+// writeDryRunReports marks every test that is not skipped as passed and writes the reports a real run
+// would produce into dir, so report paths, formats, and the directory layout can be checked without a
+// cluster. Result uploaders, timing sinks, and the notifier publish outside the run, so they are only
+// dry run: their configuration is checked and their destinations are listed.
+func writeDryRunReports(ctx context.Context, dir, suiteName string, tests []*testCase, uploaders []ResultUploader, sinks []TimingSink, notifier *suiteNotifier, out, errOut io.Writer) error {
+	if len(dir) == 0 {
+		return fmt.Errorf("writing reports from a dry run requires --junit-dir")
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("could not create --junit-dir: %v", err)
+	}
+
+	now := time.Now()
+	for _, test := range tests {
+		test.start, test.end = now, now
+		if !test.skipped {
+			test.success = true
+		}
+	}
+
+	timeSuffix := "_dry-run"
+	if err := writeJUnitReport(generateJUnitTestSuiteResults(suiteName, 0, tests), "junit_e2e", timeSuffix, dir, errOut); err != nil {
+		return fmt.Errorf("unable to write e2e JUnit xml results: %v", err)
+	}
+	if err := writeTimingsToDir(dir, timeSuffix, testTimings(tests)); err != nil {
+		return fmt.Errorf("unable to write test timings: %v", err)
+	}
+	if err := writeExternalIDResults(dir, timeSuffix, tests); err != nil {
+		return fmt.Errorf("unable to write test case management results: %v", err)
+	}
+	if err := writeTestArtifactIndex(dir, tests); err != nil {
+		return fmt.Errorf("unable to write test artifact index: %v", err)
+	}
+
+	files, err := filepath.Glob(filepath.Join(dir, "*"+timeSuffix+"*"))
+	if err != nil {
+		return err
+	}
+	var names []string
+	for _, file := range files {
+		names = append(names, filepath.Base(file))
+	}
+	names = append(names, filepath.ToSlash(filepath.Join(runnerapi.TestArtifactsDir, runnerapi.TestArtifactsIndex)))
+	sort.Strings(names)
+	fmt.Fprintf(out, "Wrote reports for %d tests to %s:\n", len(tests), dir)
+	for _, name := range names {
+		fmt.Fprintf(out, "  %s\n", name)
+	}
+
+	type destination struct {
+		kind   string
+		target interface{}
+	}
+	var destinations []destination
+	for _, uploader := range uploaders {
+		destinations = append(destinations, destination{kind: "results", target: uploader})
+	}
+	for _, sink := range sinks {
+		destinations = append(destinations, destination{kind: "timings", target: sink})
+	}
+	if len(destinations) == 0 && notifier == nil {
+		return nil
+	}
+	failed := 0
+	fmt.Fprintf(out, "Would publish outside the run:\n")
+	for _, d := range destinations {
+		dryRunner, ok := d.target.(DryRunner)
+		if !ok {
+			fmt.Fprintf(out, "  %s: %T, which cannot be checked in a dry run\n", d.kind, d.target)
+			continue
+		}
+		description, err := dryRunner.DryRun(ctx)
+		if err != nil {
+			fmt.Fprintf(errOut, "error: The %s destination is misconfigured: %v\n", d.kind, err)
+			failed++
+			continue
+		}
+		fmt.Fprintf(out, "  %s: %s\n", d.kind, description)
+	}
+	if notifier != nil {
+		description, err := notifier.dryRun(suiteName)
+		if err != nil {
+			fmt.Fprintf(errOut, "error: The notification is misconfigured: %v\n", err)
+			failed++
+		} else {
+			fmt.Fprintf(out, "  notification: %s\n", description)
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d destinations of the run are misconfigured", failed)
+	}
+	return nil
+}
@@ -0,0 +1,129 @@
+package ginkgo
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/onsi/ginkgo/v2/types"
+)
+
+func Test_writeDryRun(t *testing.T) {
+	tests := []*testCase{
+		{
+			name:        "[sig-network] b [Serial] [Timeout:30m]",
+			testTimeout: 30 * time.Minute,
+			locations:   []types.CodeLocation{{FileName: "network.go", LineNumber: 10}, {FileName: "network.go", LineNumber: 20}},
+		},
+		{
+			name:            "[sig-apps] a [apigroup:apps.openshift.io]",
+			apigroups:       []string{"apps.openshift.io"},
+			skipped:         true,
+			testOutputBytes: []byte("skipped because the following required API groups are missing: apps.openshift.io"),
+		},
+	}
+
+	names := &bytes.Buffer{}
+	if err := writeDryRun(names, ioutil.Discard, DryRunFormatNames, "suite", tests, nil, setting{Value: "15m0s", Source: "default"}, nil); err != nil {
+		t.Fatal(err)
+	}
+	if want := "\"[sig-apps] a [apigroup:apps.openshift.io]\"\n\"[sig-network] b [Serial] [Timeout:30m]\"\n"; names.String() != want {
+		t.Errorf("unexpected names %q", names.String())
+	}
+
+	out := &bytes.Buffer{}
+	if err := writeDryRun(out, ioutil.Discard, DryRunFormatJSON, "suite", tests, nil, setting{Value: "15m0s", Source: "default"}, nil); err != nil {
+		t.Fatal(err)
+	}
+	listing := dryRunListing{}
+	if err := json.Unmarshal(out.Bytes(), &listing); err != nil {
+		t.Fatal(err)
+	}
+	if len(listing.Tests) != 2 || listing.Suite != "suite" {
+		t.Fatalf("unexpected listing %#v", listing)
+	}
+	skipped, serial := listing.Tests[0], listing.Tests[1]
+	if !skipped.Skipped || skipped.SkipReason != string(tests[1].testOutputBytes) || skipped.Timeout != "15m0s" || skipped.Owner != "sig-apps" {
+		t.Errorf("unexpected skipped test %#v", skipped)
+	}
+	if !serial.Serial || serial.Timeout != "30m0s" || len(serial.Locations) != 2 || serial.Locations[1] != "network.go:20" {
+		t.Errorf("unexpected serial test %#v", serial)
+	}
+	wantSettings := map[string]setting{
+		"timeout":   {Value: "30m0s", Source: "[Timeout] label on the test"},
+		"execution": {Value: "serial", Source: "[Serial] label on the test"},
+	}
+	if !reflect.DeepEqual(serial.Settings, wantSettings) {
+		t.Errorf("unexpected settings %#v", serial.Settings)
+	}
+	if got := skipped.Settings["timeout"]; got.Source != "default" {
+		t.Errorf("expected the skipped test to inherit the default timeout, got %#v", got)
+	}
+
+	if err := writeDryRun(out, ioutil.Discard, "yaml", "suite", tests, nil, setting{Value: "15m0s", Source: "default"}, nil); err == nil {
+		t.Error("expected an error for an unknown format")
+	}
+}
+
+func TestSuite_applyTimeoutOverrides(t *testing.T) {
+	suite := &TestSuite{
+		Name: "suite",
+		TimeoutOverrides: []TestTimeoutOverride{
+			{Matches: func(name string) bool { return strings.Contains(name, "[sig-storage]") }, Timeout: 40 * time.Minute},
+			{Matches: func(name string) bool { return true }, Timeout: 20 * time.Minute},
+		},
+	}
+	labeled := &testCase{name: "[sig-storage] a [Timeout:10m]", testTimeout: 10 * time.Minute}
+	storage := &testCase{name: "[sig-storage] b"}
+	other := &testCase{name: "[sig-cli] c"}
+	suite.applyTimeoutOverrides([]*testCase{labeled, storage, other})
+
+	if labeled.testTimeout != 10*time.Minute || storage.testTimeout != 40*time.Minute || other.testTimeout != 20*time.Minute {
+		t.Errorf("unexpected timeouts %s, %s, %s", labeled.testTimeout, storage.testTimeout, other.testTimeout)
+	}
+	if retry := storage.Retry(); retry.testTimeout != 40*time.Minute {
+		t.Errorf("expected the retry to keep the timeout, got %s", retry.testTimeout)
+	}
+	if got := newDryRunTest(storage, setting{Value: "15m0s", Source: "default"}, nil).Settings["timeout"]; got != (setting{Value: "40m0s", Source: "timeout override 1 of suite suite"}) {
+		t.Errorf("unexpected timeout setting %#v", got)
+	}
+	if got := newDryRunTest(labeled, setting{Value: "15m0s", Source: "default"}, nil).Settings["timeout"]; got.Source != "[Timeout] label on the test" {
+		t.Errorf("unexpected timeout setting %#v", got)
+	}
+}
+
+func Test_excludedByEnvironment(t *testing.T) {
+	tests := []*testCase{
+		{name: "[sig-network] b [Skipped:aws]"},
+		{name: "[sig-network] a [Feature:SCTPConnectivity]"},
+		{name: "[sig-cli] c"},
+		{name: "[sig-storage] d [Skipped:aws]"},
+		{name: "[sig-builds] e [Requires:CapabilityBuild]"},
+	}
+	suiteMatches := func(name string) bool { return !strings.Contains(name, "[sig-storage]") }
+	skipReasonFn := func(name string) string {
+		if strings.Contains(name, "[Skipped:aws]") {
+			return "[Skipped:aws]"
+		}
+		if strings.Contains(name, "[Requires:CapabilityBuild]") {
+			return "[Requires:CapabilityBuild]"
+		}
+		return ""
+	}
+	matchFn := func(name string) bool {
+		return len(skipReasonFn(name)) == 0 && !strings.Contains(name, "SCTP")
+	}
+
+	want := []dryRunExcludedTest{
+		{Name: "[sig-builds] e [Requires:CapabilityBuild]", Reason: "the test name contains [Requires:CapabilityBuild], which the cluster does not provide"},
+		{Name: "[sig-network] a [Feature:SCTPConnectivity]", Reason: "excluded by the cluster configuration"},
+		{Name: "[sig-network] b [Skipped:aws]", Reason: "the test name contains [Skipped:aws], which the cluster configuration excludes"},
+	}
+	if got := excludedByEnvironment(tests, suiteMatches, matchFn, skipReasonFn); !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected excluded tests:\n%#v", got)
+	}
+}
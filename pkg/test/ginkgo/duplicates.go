@@ -0,0 +1,54 @@
+package ginkgo
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// duplicateTests describes every group of tests that share a name or a stable id. Duplicate names
+// make focus and retry by name ambiguous, and duplicate ids conflate results in anything keyed on
+// them.
+func duplicateTests(tests []*testCase) []string {
+	byName := map[string]int{}
+	byID := map[string][]string{}
+	for _, test := range tests {
+		byName[test.name]++
+		byID[test.id()] = append(byID[test.id()], test.name)
+	}
+
+	var duplicates []string
+	for name, count := range byName {
+		if count > 1 {
+			duplicates = append(duplicates, fmt.Sprintf("%d tests are named %q", count, name))
+		}
+	}
+	for id, names := range byID {
+		if unique := uniqueStrings(names); len(unique) > 1 {
+			duplicates = append(duplicates, fmt.Sprintf("tests share the id %s: %s", id, strings.Join(quoteStrings(unique), ", ")))
+		}
+	}
+	sort.Strings(duplicates)
+	return duplicates
+}
+
+func uniqueStrings(values []string) []string {
+	seen := map[string]bool{}
+	var unique []string
+	for _, value := range values {
+		if !seen[value] {
+			seen[value] = true
+			unique = append(unique, value)
+		}
+	}
+	sort.Strings(unique)
+	return unique
+}
+
+func quoteStrings(values []string) []string {
+	quoted := make([]string, 0, len(values))
+	for _, value := range values {
+		quoted = append(quoted, fmt.Sprintf("%q", value))
+	}
+	return quoted
+}
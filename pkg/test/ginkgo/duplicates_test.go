@@ -0,0 +1,26 @@
+package ginkgo
+
+import (
+	"reflect"
+	"testing"
+)
+
+func Test_duplicateTests(t *testing.T) {
+	tests := []*testCase{
+		{name: "[sig-cli] unique"},
+		{name: "[sig-cli] repeated"},
+		{name: "[sig-cli] repeated"},
+		{name: "[sig-cli] annotated [Suite:a]", annotations: " [Suite:a]"},
+		{name: "[sig-cli] annotated [Suite:b]", annotations: " [Suite:b]"},
+	}
+	want := []string{
+		`2 tests are named "[sig-cli] repeated"`,
+		`tests share the id ` + tests[3].id() + `: "[sig-cli] annotated [Suite:a]", "[sig-cli] annotated [Suite:b]"`,
+	}
+	if got := duplicateTests(tests); !reflect.DeepEqual(got, want) {
+		t.Errorf("duplicateTests() = %q, want %q", got, want)
+	}
+	if got := duplicateTests(tests[:1]); len(got) != 0 {
+		t.Errorf("expected no duplicates, got %q", got)
+	}
+}
@@ -0,0 +1,190 @@
+package ginkgo
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/openshift/origin/pkg/test/ginkgo/junitapi"
+)
+
+// loadHistoricalDurations reads the duration of every test from a JUnit report or a CSV timing export
+// written by a previous run. Both a single test suite and a collection of suites are accepted. When a
+// test appears more than once the longest duration is used.
+func loadHistoricalDurations(path string) (map[string]time.Duration, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if filepath.Ext(path) == ".csv" {
+		return loadTimingDurations(data)
+	}
+	var suites []*junitapi.JUnitTestSuite
+	collection := &junitapi.JUnitTestSuites{}
+	if err := xml.Unmarshal(data, collection); err == nil {
+		suites = collection.Suites
+	} else {
+		suite := &junitapi.JUnitTestSuite{}
+		if err := xml.Unmarshal(data, suite); err != nil {
+			return nil, fmt.Errorf("unable to parse %s as a JUnit report: %v", path, err)
+		}
+		suites = []*junitapi.JUnitTestSuite{suite}
+	}
+
+	durations := map[string]time.Duration{}
+	var collect func(suites []*junitapi.JUnitTestSuite)
+	collect = func(suites []*junitapi.JUnitTestSuite) {
+		for _, suite := range suites {
+			for _, test := range suite.TestCases {
+				if test.SkipMessage != nil {
+					continue
+				}
+				duration := time.Duration(test.Duration * float64(time.Second))
+				if duration > durations[test.Name] {
+					durations[test.Name] = duration
+				}
+			}
+			collect(suite.Children)
+		}
+	}
+	collect(suites)
+	return durations, nil
+}
+
+// loadTimingDurations reads the durations of the tests that were not skipped from a CSV timing export.
+func loadTimingDurations(data []byte) (map[string]time.Duration, error) {
+	rows, err := csv.NewReader(bytes.NewReader(data)).ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, fmt.Errorf("the timing export has no header")
+	}
+	columns := map[string]int{}
+	for i, column := range rows[0] {
+		columns[column] = i
+	}
+	name, hasName := columns["name"]
+	seconds, hasDuration := columns["duration_seconds"]
+	state, hasState := columns["state"]
+	if !hasName || !hasDuration {
+		return nil, fmt.Errorf("the timing export must have name and duration_seconds columns")
+	}
+
+	durations := map[string]time.Duration{}
+	for _, row := range rows[1:] {
+		if hasState && TestState(row[state]) == TestSkipped {
+			continue
+		}
+		value, err := strconv.ParseFloat(row[seconds], 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid duration for %q: %v", row[name], err)
+		}
+		if duration := time.Duration(value * float64(time.Second)); duration > durations[row[name]] {
+			durations[row[name]] = duration
+		}
+	}
+	return durations, nil
+}
+
+// orderLongestFirst sorts the tests by their estimated duration, longest first, so that parallel
+// workers that take the next test from the queue finish at about the same time. Tests with the same
+// estimate keep their order.
+func orderLongestFirst(tests []*testCase, estimate *suiteEstimate) {
+	sort.SliceStable(tests, func(i, j int) bool {
+		return estimate.durations[tests[i].name] > estimate.durations[tests[j].name]
+	})
+}
+
+// suiteEstimate predicts how long a suite will take to run.
+type suiteEstimate struct {
+	Parallelism int `json:"parallelism"`
+	// WallTime is the projected duration of the whole suite
+	WallTime string `json:"wallTime"`
+	// Workers is the projected busy time of each parallel worker
+	Workers []string `json:"workers"`
+	// Serial is the projected time spent running serial tests after the parallel tests finish
+	Serial string `json:"serial"`
+	// Unknown is the number of tests without history, which are estimated at the median duration
+	Unknown int `json:"unknown"`
+
+	durations map[string]time.Duration
+}
+
+// estimateSuite projects the wall time of the tests by assigning parallel tests longest first to the
+// least busy worker and then running serial tests one at a time, as the queue does. Skipped tests
+// take no time.
+func estimateSuite(tests []*testCase, history map[string]time.Duration, parallelism int) *suiteEstimate {
+	if parallelism < 1 {
+		parallelism = 1
+	}
+	var known []time.Duration
+	for _, duration := range history {
+		known = append(known, duration)
+	}
+	sort.Slice(known, func(i, j int) bool { return known[i] < known[j] })
+	var median time.Duration
+	if len(known) > 0 {
+		median = known[len(known)/2]
+	}
+
+	estimate := &suiteEstimate{Parallelism: parallelism, durations: map[string]time.Duration{}}
+	var parallel []time.Duration
+	var serial time.Duration
+	for _, test := range tests {
+		if test.skipped {
+			continue
+		}
+		duration, ok := history[test.name]
+		if !ok {
+			estimate.Unknown++
+			duration = median
+		}
+		estimate.durations[test.name] = duration
+		if isSerialTest(test) {
+			serial += duration
+			continue
+		}
+		parallel = append(parallel, duration)
+	}
+
+	sort.Slice(parallel, func(i, j int) bool { return parallel[i] > parallel[j] })
+	workers := make([]time.Duration, parallelism)
+	for _, duration := range parallel {
+		least := 0
+		for i := range workers {
+			if workers[i] < workers[least] {
+				least = i
+			}
+		}
+		workers[least] += duration
+	}
+	var longest time.Duration
+	for _, worker := range workers {
+		if worker > longest {
+			longest = worker
+		}
+		estimate.Workers = append(estimate.Workers, worker.Round(time.Second).String())
+	}
+	estimate.Serial = serial.Round(time.Second).String()
+	estimate.WallTime = (longest + serial).Round(time.Second).String()
+	return estimate
+}
+
+// durationFor returns the estimated duration of a test, or an empty string without an estimate.
+func (e *suiteEstimate) durationFor(test *testCase) string {
+	if e == nil {
+		return ""
+	}
+	duration, ok := e.durations[test.name]
+	if !ok {
+		return ""
+	}
+	return duration.Round(time.Second / 10).String()
+}
@@ -0,0 +1,93 @@
+package ginkgo
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func Test_loadHistoricalDurations(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "junit.xml")
+	if err := ioutil.WriteFile(path, []byte(`<testsuite name="openshift-tests" tests="4" skipped="1" failures="0" time="10">
+<testcase name="a" time="2.5"></testcase>
+<testcase name="a" time="3"></testcase>
+<testcase name="b" time="1"></testcase>
+<testcase name="c" time="0"><skipped message="skip"></skipped></testcase>
+</testsuite>`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	got, err := loadHistoricalDurations(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]time.Duration{"a": 3 * time.Second, "b": time.Second}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("loadHistoricalDurations() = %v, want %v", got, want)
+	}
+}
+
+func Test_estimateSuite(t *testing.T) {
+	history := map[string]time.Duration{
+		"a":          4 * time.Minute,
+		"b":          3 * time.Minute,
+		"c":          2 * time.Minute,
+		"d [Serial]": time.Minute,
+	}
+	tests := []*testCase{
+		{name: "a"}, {name: "b"}, {name: "c"}, {name: "d [Serial]"},
+		{name: "new"},
+		{name: "skipped", skipped: true},
+	}
+
+	estimate := estimateSuite(tests, history, 2)
+	// the unknown test takes the median of 3m: a and c run on one worker, b and the unknown test on the
+	// other, for 6m each, then the serial test runs alone
+	if estimate.WallTime != "7m0s" || estimate.Serial != "1m0s" || estimate.Unknown != 1 {
+		t.Errorf("unexpected estimate %#v", estimate)
+	}
+	if got := estimate.durationFor(&testCase{name: "skipped"}); got != "" {
+		t.Errorf("skipped tests should not have an estimate, got %q", got)
+	}
+	if got := estimate.durationFor(&testCase{name: "new"}); got != "3m0s" {
+		t.Errorf("unexpected estimate for an unknown test %q", got)
+	}
+}
+
+func Test_loadHistoricalDurationsFromTimings(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "test-timings.csv")
+	if err := ioutil.WriteFile(path, []byte(`name,id,owner,state,start,end,duration_seconds,attempt
+a,1,,Failed,,,2.500,1
+a,1,,Success,,,3.000,2
+b,2,,Success,,,1.000,1
+c,3,,Skipped,,,0.000,1
+`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	durations, err := loadHistoricalDurations(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := map[string]time.Duration{"a": 3 * time.Second, "b": time.Second}; !reflect.DeepEqual(durations, want) {
+		t.Errorf("unexpected durations %v", durations)
+	}
+
+	if _, err := loadTimingDurations([]byte("name,state\na,Success\n")); err == nil {
+		t.Error("expected an error without a duration column")
+	}
+}
+
+func Test_orderLongestFirst(t *testing.T) {
+	tests := []*testCase{{name: "short"}, {name: "unknown"}, {name: "long"}, {name: "other unknown"}}
+	estimate := estimateSuite(tests, map[string]time.Duration{"short": time.Second, "long": time.Minute, "median": 10 * time.Second}, 2)
+	orderLongestFirst(tests, estimate)
+
+	var names []string
+	for _, test := range tests {
+		names = append(names, test.name)
+	}
+	if want := []string{"long", "unknown", "other unknown", "short"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("unexpected order %v", names)
+	}
+}
@@ -0,0 +1,128 @@
+package ginkgo
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// SuiteEvent is implemented by every event published on an EventBus. Subscribers switch on the
+// concrete type.
+type SuiteEvent interface {
+	suiteEvent()
+}
+
+// SuiteWillBegin is published once the tests of the suite are known, before any of them run.
+type SuiteWillBegin struct {
+	Suite     string
+	TestCount int
+}
+
+// TestWillRun is published before a test is started.
+type TestWillRun struct {
+	Test string
+}
+
+// TestDidRun is published after a test ends and its result is reported.
+type TestDidRun struct {
+	Test     string
+	State    TestState
+	Duration time.Duration
+	// Timing is the row of the timing export for this run of the test
+	Timing TestTiming
+}
+
+// ProgressReport is published after every test ends with the progress of the tests being executed.
+type ProgressReport struct {
+	Started  int
+	Finished int
+	Failures int
+	Total    int
+}
+
+// Interrupt is published when the suite receives a signal telling it to stop running tests.
+type Interrupt struct {
+	Signal string
+}
+
+// SuiteDidEnd is published after all the tests have run, including retries, and the results have
+// been reported.
+type SuiteDidEnd struct {
+	Suite    string
+	Pass     int
+	Fail     int
+	Skip     int
+	Duration time.Duration
+}
+
+func (SuiteWillBegin) suiteEvent() {}
+func (TestWillRun) suiteEvent()    {}
+func (TestDidRun) suiteEvent()     {}
+func (ProgressReport) suiteEvent() {}
+func (Interrupt) suiteEvent()      {}
+func (SuiteDidEnd) suiteEvent()    {}
+
+// EventBus delivers the lifecycle events of a suite to subscribers in the same process, so callers
+// can add behavior such as warming caches, watchdogs or metrics without writing a reporter. Events are
+// delivered synchronously and, because tests run in parallel, concurrently; subscribers must return
+// quickly and be safe for concurrent use. All methods are safe to call on a nil EventBus.
+type EventBus struct {
+	lock        sync.RWMutex
+	nextID      int
+	subscribers map[int]func(SuiteEvent)
+}
+
+func NewEventBus() *EventBus {
+	return &EventBus{
+		subscribers: map[int]func(SuiteEvent){},
+	}
+}
+
+// Subscribe calls fn with every event published after it returns, until the returned function is
+// called.
+func (b *EventBus) Subscribe(fn func(SuiteEvent)) (unsubscribe func()) {
+	if b == nil {
+		return func() {}
+	}
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	id := b.nextID
+	b.nextID++
+	b.subscribers[id] = fn
+	return func() {
+		b.lock.Lock()
+		defer b.lock.Unlock()
+		delete(b.subscribers, id)
+	}
+}
+
+// Publish delivers event to every subscriber in the order they subscribed.
+func (b *EventBus) Publish(event SuiteEvent) {
+	if b == nil {
+		return
+	}
+	b.lock.RLock()
+	ids := make([]int, 0, len(b.subscribers))
+	for id := range b.subscribers {
+		ids = append(ids, id)
+	}
+	subscribers := make([]func(SuiteEvent), 0, len(ids))
+	sort.Ints(ids)
+	for _, id := range ids {
+		subscribers = append(subscribers, b.subscribers[id])
+	}
+	b.lock.RUnlock()
+
+	for _, fn := range subscribers {
+		fn(event)
+	}
+}
+
+// testDidRun publishes the result of a test and the progress of the suite once the test has ended.
+func (b *EventBus) testDidRun(test *testCase, testRunResult *testRunResultHandle, progress *testSuiteProgress) {
+	if b == nil || testRunResult.testRunResult == nil {
+		return
+	}
+	b.Publish(TestDidRun{Test: testRunResult.name, State: testRunResult.testState, Duration: testRunResult.duration(), Timing: testTiming(test)})
+	b.Publish(progress.report())
+}
@@ -0,0 +1,52 @@
+package ginkgo
+
+import (
+	"io/ioutil"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestEventBus(t *testing.T) {
+	var nilBus *EventBus
+	nilBus.Subscribe(func(SuiteEvent) {})()
+	nilBus.Publish(SuiteWillBegin{})
+
+	bus := NewEventBus()
+	var first, second []SuiteEvent
+	bus.Subscribe(func(event SuiteEvent) { first = append(first, event) })
+	unsubscribe := bus.Subscribe(func(event SuiteEvent) { second = append(second, event) })
+
+	bus.Publish(SuiteWillBegin{Suite: "suite", TestCount: 2})
+	unsubscribe()
+
+	progress := newTestSuiteProgress(2)
+	progress.LogTestStart(ioutil.Discard, "a")
+	progress.LogTestStart(ioutil.Discard, "b")
+	result := &testRunResultHandle{&testRunResult{name: "a", testState: TestFailed, start: time.Unix(0, 0), end: time.Unix(3, 0)}}
+	progress.TestEnded("a", result)
+	test := &testCase{name: "a"}
+	mutateTestCaseWithResults(test, result)
+	bus.testDidRun(test, result, progress)
+	bus.testDidRun(test, &testRunResultHandle{}, progress)
+
+	want := []SuiteEvent{
+		SuiteWillBegin{Suite: "suite", TestCount: 2},
+		TestDidRun{Test: "a", State: TestFailed, Duration: 3 * time.Second, Timing: TestTiming{
+			Name:     "a",
+			ID:       test.id(),
+			State:    TestFailed,
+			Start:    time.Unix(0, 0),
+			End:      time.Unix(3, 0),
+			Duration: 3 * time.Second,
+			Attempt:  1,
+		}},
+		ProgressReport{Started: 2, Finished: 1, Failures: 1, Total: 2},
+	}
+	if !reflect.DeepEqual(first, want) {
+		t.Errorf("unexpected events %#v", first)
+	}
+	if !reflect.DeepEqual(second, want[:1]) {
+		t.Errorf("expected no events after unsubscribing, got %#v", second)
+	}
+}
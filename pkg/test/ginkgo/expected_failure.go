@@ -0,0 +1,28 @@
+package ginkgo
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// expectedFailureRe matches the label that marks a test as expected to fail, with the reason it is
+// expected to fail, usually a link to the known bug.
+var expectedFailureRe = regexp.MustCompile(`\[ExpectedFailure:([^\]]*)\]`)
+
+// applyExpectedFailure inverts the result of a test that is expected to fail so that it can keep
+// running while a known bug is open. A failure is reported as failed as expected, which counts as a
+// pass, and a pass is reported as an unexpected pass, which counts as a failure so the label is
+// removed once the bug is fixed. Timeouts and skips are not changed.
+func applyExpectedFailure(test *testCase, result *testRunResult) {
+	if len(test.expectedFailure) == 0 || result == nil {
+		return
+	}
+	switch result.testState {
+	case TestFailed:
+		result.testState = TestFailedAsExpected
+		result.testOutputBytes = append(result.testOutputBytes, fmt.Sprintf("\nfailed as expected: %s\n", test.expectedFailure)...)
+	case TestSucceeded, TestFlaked:
+		result.testState = TestUnexpectedPass
+		result.testOutputBytes = append(result.testOutputBytes, fmt.Sprintf("\nfail [unexpected pass]: the test passed but is expected to fail: %s\n", test.expectedFailure)...)
+	}
+}
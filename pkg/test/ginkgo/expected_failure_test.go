@@ -0,0 +1,64 @@
+package ginkgo
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/onsi/ginkgo/v2/types"
+)
+
+func Test_applyExpectedFailure(t *testing.T) {
+	tests := []struct {
+		state      TestState
+		want       TestState
+		wantOutput string
+	}{
+		{state: TestFailed, want: TestFailedAsExpected, wantOutput: "failed as expected: bug 123"},
+		{state: TestSucceeded, want: TestUnexpectedPass, wantOutput: "fail [unexpected pass]: the test passed but is expected to fail: bug 123"},
+		{state: TestFlaked, want: TestUnexpectedPass},
+		{state: TestFailedTimeout, want: TestFailedTimeout},
+		{state: TestSkipped, want: TestSkipped},
+	}
+	for _, tt := range tests {
+		result := &testRunResult{testState: tt.state}
+		applyExpectedFailure(&testCase{expectedFailure: "bug 123"}, result)
+		if result.testState != tt.want {
+			t.Errorf("%s: got state %s, want %s", tt.state, result.testState, tt.want)
+		}
+		if !strings.Contains(string(result.testOutputBytes), tt.wantOutput) {
+			t.Errorf("%s: output %q does not contain %q", tt.state, result.testOutputBytes, tt.wantOutput)
+		}
+	}
+
+	result := &testRunResult{testState: TestFailed}
+	applyExpectedFailure(&testCase{}, result)
+	if result.testState != TestFailed {
+		t.Errorf("a test without the label should not change, got %s", result.testState)
+	}
+}
+
+func Test_expectedFailureLabel(t *testing.T) {
+	tc, err := newTestCaseFromGinkgoSpec(fakeSpec("[sig-network] a [ExpectedFailure:https://issues.redhat.com/browse/OCPBUGS-1]"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tc.expectedFailure != "https://issues.redhat.com/browse/OCPBUGS-1" {
+		t.Errorf("unexpected reason %q", tc.expectedFailure)
+	}
+
+	tc.failed, tc.unexpectedPass = true, true
+	if tc.state() != TestUnexpectedPass || !isTestFailed(tc.state()) {
+		t.Errorf("unexpected state %s", tc.state())
+	}
+	tc.failed, tc.unexpectedPass, tc.success, tc.failedAsExpected = false, false, true, true
+	if tc.state() != TestFailedAsExpected || isTestFailed(tc.state()) {
+		t.Errorf("unexpected state %s", tc.state())
+	}
+}
+
+// fakeSpec is a test spec with a name and no code locations.
+type fakeSpec string
+
+func (s fakeSpec) CodeLocations() []types.CodeLocation { return nil }
+func (s fakeSpec) Text() string                        { return string(s) }
+func (s fakeSpec) AppendText(string)                   {}
@@ -0,0 +1,93 @@
+package ginkgo
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// externalIDSystems are the test case management systems whose identifiers may be attached to a
+// test with a label such as [Polarion:OCP-12345].
+var externalIDSystems = []string{"Polarion", "TestRail", "Xray"}
+
+// externalID identifies a test case in an external test case management system.
+type externalID struct {
+	System string `json:"system"`
+	ID     string `json:"id"`
+}
+
+func (e externalID) String() string {
+	return e.System + ":" + e.ID
+}
+
+// externalIDs returns the external test case identifiers attached to the test.
+func (t *testCase) externalIDs() []externalID {
+	var ids []externalID
+	for _, label := range t.labels() {
+		for _, system := range externalIDSystems {
+			if id := strings.TrimPrefix(label, system+":"); id != label && len(id) > 0 {
+				ids = append(ids, externalID{System: system, ID: id})
+			}
+		}
+	}
+	return ids
+}
+
+// validateExternalIDs returns an error if the same external identifier is attached to more than
+// one test, since results could not be synced back unambiguously.
+func validateExternalIDs(tests []*testCase) error {
+	owners := map[externalID]string{}
+	var duplicates []string
+	for _, test := range tests {
+		for _, id := range test.externalIDs() {
+			existing, ok := owners[id]
+			if !ok {
+				owners[id] = test.name
+				continue
+			}
+			if existing != test.name {
+				duplicates = append(duplicates, fmt.Sprintf("%s is used by %q and %q", id, existing, test.name))
+			}
+		}
+	}
+	if len(duplicates) > 0 {
+		sort.Strings(duplicates)
+		return fmt.Errorf("external test case identifiers must be unique:\n%s", strings.Join(duplicates, "\n"))
+	}
+	return nil
+}
+
+type externalIDResult struct {
+	externalID
+	Name  string    `json:"name"`
+	State TestState `json:"state"`
+}
+
+// writeExternalIDResults writes the result of every test with an external identifier so that the
+// results can be synced to the test case management system. When a test ran more than once the
+// last result is recorded.
+func writeExternalIDResults(dir, fileSuffix string, tests []*testCase) error {
+	results := map[externalID]externalIDResult{}
+	for _, test := range tests {
+		for _, id := range test.externalIDs() {
+			results[id] = externalIDResult{externalID: id, Name: test.name, State: test.state()}
+		}
+	}
+	if len(results) == 0 {
+		return nil
+	}
+	list := make([]externalIDResult, 0, len(results))
+	for _, result := range results {
+		list = append(list, result)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].externalID.String() < list[j].externalID.String() })
+
+	data, err := json.MarshalIndent(list, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filepath.Join(dir, fmt.Sprintf("test-case-ids%s.json", fileSuffix)), data, 0644)
+}
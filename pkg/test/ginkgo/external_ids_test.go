@@ -0,0 +1,52 @@
+package ginkgo
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func Test_validateExternalIDs(t *testing.T) {
+	unique := []*testCase{
+		{name: "[sig-cli] a [Polarion:OCP-1] [TestRail:C1]"},
+		{name: "[sig-cli] b [Polarion:OCP-2]"},
+		{name: "[sig-cli] a [Polarion:OCP-1] [TestRail:C1]"},
+	}
+	if err := validateExternalIDs(unique); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	duplicated := append(unique, &testCase{name: "[sig-cli] c [Polarion:OCP-2]"})
+	if err := validateExternalIDs(duplicated); err == nil {
+		t.Error("expected an error for a duplicate identifier")
+	}
+}
+
+func Test_writeExternalIDResults(t *testing.T) {
+	dir := t.TempDir()
+	failed := &testCase{name: "[sig-cli] a [Polarion:OCP-1] [Xray:CLI-7]", failed: true}
+	retried := failed.Retry()
+	retried.success = true
+	tests := []*testCase{failed, {name: "[sig-cli] b", success: true}, retried}
+
+	if err := writeExternalIDResults(dir, "_suffix", tests); err != nil {
+		t.Fatal(err)
+	}
+	data, err := ioutil.ReadFile(filepath.Join(dir, "test-case-ids_suffix.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []externalIDResult
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatal(err)
+	}
+	want := []externalIDResult{
+		{externalID: externalID{System: "Polarion", ID: "OCP-1"}, Name: failed.name, State: TestSucceeded},
+		{externalID: externalID{System: "Xray", ID: "CLI-7"}, Name: failed.name, State: TestSucceeded},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected results %#v", got)
+	}
+}
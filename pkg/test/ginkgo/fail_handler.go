@@ -0,0 +1,45 @@
+package ginkgo
+
+import (
+	"fmt"
+	"io"
+	"runtime"
+	"strings"
+)
+
+// maxGoroutineDumpBytes bounds the size of the goroutine stacks captured on failure.
+const maxGoroutineDumpBytes = 1024 * 1024
+
+// NewGoroutineDumpFailHandler wraps fail so the goroutines that may be relevant to a failed assertion
+// are written to out before the failure is recorded, preserving the state of any polling or
+// asynchronous work at the moment of the failure rather than only on timeouts. The dump is reduced to
+// goroutines with a frame in one of the given package prefixes, such as the packages of the tests.
+// Register the result as the gomega fail handler.
+func NewGoroutineDumpFailHandler(fail func(message string, callerSkip ...int), out io.Writer, packages []string) func(message string, callerSkip ...int) {
+	return func(message string, callerSkip ...int) {
+		buf := make([]byte, maxGoroutineDumpBytes)
+		buf = buf[:runtime.Stack(buf, true)]
+		goroutines := relevantGoroutines(string(buf), packages)
+		fmt.Fprintf(out, "\nGoroutines at the time of the failure (%d):\n\n%s\n", len(goroutines), strings.Join(goroutines, "\n\n"))
+
+		skip := 1
+		if len(callerSkip) > 0 {
+			skip += callerSkip[0]
+		}
+		fail(message, skip)
+	}
+}
+
+// relevantGoroutines splits a dump of all goroutines and keeps those with a frame in one of packages.
+func relevantGoroutines(dump string, packages []string) []string {
+	var relevant []string
+	for _, goroutine := range strings.Split(strings.TrimSpace(dump), "\n\n") {
+		for _, pkg := range packages {
+			if strings.Contains(goroutine, "\n"+pkg) {
+				relevant = append(relevant, goroutine)
+				break
+			}
+		}
+	}
+	return relevant
+}
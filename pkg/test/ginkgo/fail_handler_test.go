@@ -0,0 +1,33 @@
+package ginkgo
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func Test_NewGoroutineDumpFailHandler(t *testing.T) {
+	var gotMessage string
+	var gotSkip []int
+	fail := func(message string, callerSkip ...int) {
+		gotMessage, gotSkip = message, callerSkip
+	}
+	out := &bytes.Buffer{}
+
+	NewGoroutineDumpFailHandler(fail, out, []string{"github.com/openshift/origin/pkg/test/ginkgo"})("boom", 2)
+
+	if gotMessage != "boom" || len(gotSkip) != 1 || gotSkip[0] != 3 {
+		t.Errorf("unexpected failure %q %v", gotMessage, gotSkip)
+	}
+	if !strings.Contains(out.String(), "Test_NewGoroutineDumpFailHandler") {
+		t.Errorf("expected the dump to include the calling goroutine:\n%s", out.String())
+	}
+}
+
+func Test_relevantGoroutines(t *testing.T) {
+	dump := "goroutine 1 [running]:\nexample.com/test.A()\n\t/a.go:1\n\n" +
+		"goroutine 2 [select]:\nnet/http.(*persistConn).readLoop()\n\t/b.go:1\n"
+	if got := relevantGoroutines(dump, []string{"example.com/test"}); len(got) != 1 || !strings.HasPrefix(got[0], "goroutine 1 ") {
+		t.Errorf("unexpected goroutines %q", got)
+	}
+}
@@ -0,0 +1,110 @@
+package ginkgo
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+// sensitiveEnvRe matches the names of environment variables whose values are redacted from failure
+// bundles.
+var sensitiveEnvRe = regexp.MustCompile(`(?i)(secret|token|password|passwd|credential|key)`)
+
+// failureBundle is the summary of a failed test written to failure.json.
+type failureBundle struct {
+	Name     string    `json:"name"`
+	ID       string    `json:"id"`
+	Owner    string    `json:"owner,omitempty"`
+	State    TestState `json:"state"`
+	Attempt  int       `json:"attempt"`
+	Start    time.Time `json:"start"`
+	End      time.Time `json:"end"`
+	Duration string    `json:"duration"`
+	TimedOut bool      `json:"timedOut,omitempty"`
+	// Artifacts are the files the test wrote to its artifact directory, relative to that directory
+	Artifacts []string `json:"artifacts,omitempty"`
+}
+
+// writeFailureBundle writes everything known about a failed test to a single directory under its
+// artifact directory: a JSON summary, the full output of the test process, and the environment it
+// ran with. It returns the directory, or an empty string when artifacts are not being collected.
+// Each attempt of a retried test gets its own bundle.
+func writeFailureBundle(artifactDir string, test *testCase, env []string) (string, error) {
+	if len(artifactDir) == 0 {
+		return "", nil
+	}
+	bundle := failureBundle{
+		Name:     test.name,
+		ID:       test.id(),
+		Owner:    test.owner(),
+		State:    test.state(),
+		Attempt:  test.attempt(),
+		Start:    test.start,
+		End:      test.end,
+		Duration: test.duration.String(),
+		TimedOut: test.timedOut,
+	}
+	dir := filepath.Join(artifactDir, fmt.Sprintf("failure-%d", bundle.Attempt))
+	err := filepath.Walk(artifactDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if info.IsDir() {
+			if strings.HasPrefix(info.Name(), "failure-") {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		rel, err := filepath.Rel(artifactDir, path)
+		if err != nil {
+			return err
+		}
+		bundle.Artifacts = append(bundle.Artifacts, rel)
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", err
+	}
+
+	data, err := json.MarshalIndent(bundle, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "failure.json"), data, 0644); err != nil {
+		return "", err
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "output.txt"), test.testOutputBytes, 0644); err != nil {
+		return "", err
+	}
+	environment := redactEnv(append(os.Environ(), env...))
+	if err := ioutil.WriteFile(filepath.Join(dir, "environment.txt"), []byte(strings.Join(environment, "\n")+"\n"), 0644); err != nil {
+		return "", err
+	}
+	return dir, nil
+}
+
+// redactEnv returns the sorted environment with the values of sensitive variables replaced.
+func redactEnv(env []string) []string {
+	redacted := make([]string, 0, len(env))
+	for _, e := range env {
+		parts := strings.SplitN(e, "=", 2)
+		if len(parts) == 2 && sensitiveEnvRe.MatchString(parts[0]) {
+			e = parts[0] + "=<redacted>"
+		}
+		redacted = append(redacted, e)
+	}
+	sort.Strings(redacted)
+	return redacted
+}
@@ -0,0 +1,62 @@
+package ginkgo
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func Test_writeFailureBundle(t *testing.T) {
+	if dir, err := writeFailureBundle("", &testCase{name: "a"}, nil); err != nil || len(dir) > 0 {
+		t.Errorf("expected no bundle without an artifact dir, got %q %v", dir, err)
+	}
+
+	artifactDir := filepath.Join(t.TempDir(), "test")
+	if err := os.MkdirAll(filepath.Join(artifactDir, "must-gather"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(artifactDir, "must-gather", "pods.yaml"), nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+	first := &testCase{name: "[sig-cli] a", failed: true, duration: time.Minute, testOutputBytes: []byte("fail [a.go:1]: boom\n")}
+	if _, err := writeFailureBundle(artifactDir, first, nil); err != nil {
+		t.Fatal(err)
+	}
+	retry := first.Retry()
+	retry.failed = true
+	dir, err := writeFailureBundle(artifactDir, retry, []string{"TEST_SUITE_START_TIME=1", "AWS_SECRET_ACCESS_KEY=hunter2"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if dir != filepath.Join(artifactDir, "failure-2") {
+		t.Errorf("unexpected bundle dir %s", dir)
+	}
+
+	data, err := ioutil.ReadFile(filepath.Join(dir, "failure.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	bundle := failureBundle{}
+	if err := json.Unmarshal(data, &bundle); err != nil {
+		t.Fatal(err)
+	}
+	if bundle.Attempt != 2 || bundle.State != TestFailed || bundle.Owner != "sig-cli" {
+		t.Errorf("unexpected bundle %#v", bundle)
+	}
+	if want := []string{"must-gather/pods.yaml"}; !reflect.DeepEqual(bundle.Artifacts, want) {
+		t.Errorf("artifacts = %v, want %v", bundle.Artifacts, want)
+	}
+	env, err := ioutil.ReadFile(filepath.Join(dir, "environment.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := "\n" + string(env)
+	if !strings.Contains(lines, "\nAWS_SECRET_ACCESS_KEY=<redacted>\n") || strings.Contains(lines, "hunter2") || !strings.Contains(lines, "\nTEST_SUITE_START_TIME=1\n") {
+		t.Errorf("unexpected environment:\n%s", env)
+	}
+}
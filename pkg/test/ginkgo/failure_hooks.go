@@ -0,0 +1,72 @@
+package ginkgo
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"sync"
+	"time"
+)
+
+// failureHookTimeout bounds how long a single hook may run so a hung diagnostic does not stall the suite.
+const failureHookTimeout = 5 * time.Minute
+
+// FailureHook gathers diagnostics after a test fails, while the cluster is still in the state that
+// caused the failure. artifactDir is the directory of the failed test and is empty when the suite is
+// not writing artifacts. Anything the hook writes to out is printed once the hooks of the test have
+// run, without interleaving with the output of other tests.
+type FailureHook interface {
+	TestFailed(ctx context.Context, testName string, testOutput []byte, artifactDir string, out io.Writer) error
+}
+
+// FailureHookFunc converts a function into the FailureHook interface.
+type FailureHookFunc func(ctx context.Context, testName string, testOutput []byte, artifactDir string, out io.Writer) error
+
+func (fn FailureHookFunc) TestFailed(ctx context.Context, testName string, testOutput []byte, artifactDir string, out io.Writer) error {
+	return fn(ctx, testName, testOutput, artifactDir, out)
+}
+
+// NewCommandFailureHook returns a hook that runs command with a shell. The name of the failed test
+// and its artifact directory are passed in the TEST_NAME and TEST_ARTIFACT_DIR environment variables,
+// and the output of the command is written to the output of the hook.
+func NewCommandFailureHook(command string) FailureHook {
+	return FailureHookFunc(func(ctx context.Context, testName string, _ []byte, artifactDir string, out io.Writer) error {
+		cmd := exec.CommandContext(ctx, "/bin/sh", "-c", command)
+		cmd.Env = append(os.Environ(), fmt.Sprintf("TEST_NAME=%s", testName), fmt.Sprintf("TEST_ARTIFACT_DIR=%s", artifactDir))
+		cmd.Stdout = out
+		cmd.Stderr = out
+		return cmd.Run()
+	})
+}
+
+// runFailureHooks invokes every hook if the test failed. The output of the hooks and their errors,
+// which do not change the result of the test, are collected while the hooks run and written to out
+// at once while holding testOutputLock, as the hooks of parallel tests run at the same time.
+func runFailureHooks(ctx context.Context, hooks []FailureHook, test *testCase, artifactDir string, testOutputLock *sync.Mutex, out io.Writer) {
+	if len(hooks) == 0 || !isTestFailed(test.state()) {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, failureHookTimeout)
+	defer cancel()
+	output := &bytes.Buffer{}
+	for _, hook := range hooks {
+		if err := hook.TestFailed(ctx, test.name, test.testOutputBytes, artifactDir, output); err != nil {
+			fmt.Fprintf(output, "error: Failure hook for %q failed: %v\n", test.name, err)
+		}
+	}
+	if output.Len() == 0 {
+		return
+	}
+
+	testOutputLock.Lock()
+	defer testOutputLock.Unlock()
+	fmt.Fprintf(out, "Failure hooks for %q:\n", test.name)
+	out.Write(output.Bytes())
+	if !bytes.HasSuffix(output.Bytes(), []byte("\n")) {
+		fmt.Fprintln(out)
+	}
+	fmt.Fprintln(out)
+}
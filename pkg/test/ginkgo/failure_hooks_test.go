@@ -0,0 +1,81 @@
+package ginkgo
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func Test_runFailureHooks(t *testing.T) {
+	var called []string
+	hooks := []FailureHook{
+		FailureHookFunc(func(ctx context.Context, testName string, testOutput []byte, artifactDir string, out io.Writer) error {
+			called = append(called, testName)
+			fmt.Fprint(out, "gathering")
+			return fmt.Errorf("no diagnostics")
+		}),
+	}
+	out := &bytes.Buffer{}
+	lock := &sync.Mutex{}
+
+	runFailureHooks(context.TODO(), hooks, &testCase{name: "passed", success: true}, "", lock, out)
+	runFailureHooks(context.TODO(), hooks, &testCase{name: "flaked", flake: true}, "", lock, out)
+	runFailureHooks(context.TODO(), hooks, &testCase{name: "failed", failed: true}, "", lock, out)
+	runFailureHooks(context.TODO(), hooks, &testCase{name: "timed out", failed: true, timedOut: true}, "", lock, out)
+
+	if got := strings.Join(called, ","); got != "failed,timed out" {
+		t.Errorf("hooks called for %q", got)
+	}
+	want := "Failure hooks for \"failed\":\ngatheringerror: Failure hook for \"failed\" failed: no diagnostics\n\n" +
+		"Failure hooks for \"timed out\":\ngatheringerror: Failure hook for \"timed out\" failed: no diagnostics\n\n"
+	if out.String() != want {
+		t.Errorf("unexpected output %q", out.String())
+	}
+}
+
+func Test_runFailureHooksHoldsOutputLock(t *testing.T) {
+	started, release := make(chan struct{}), make(chan struct{})
+	hooks := []FailureHook{
+		FailureHookFunc(func(ctx context.Context, testName string, testOutput []byte, artifactDir string, out io.Writer) error {
+			fmt.Fprintln(out, "first")
+			close(started)
+			<-release
+			fmt.Fprintln(out, "second")
+			return nil
+		}),
+	}
+	out := &bytes.Buffer{}
+	lock := &sync.Mutex{}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		runFailureHooks(context.TODO(), hooks, &testCase{name: "failed", failed: true}, "", lock, out)
+	}()
+
+	// another test writes its output while the hook runs
+	<-started
+	lock.Lock()
+	out.WriteString("other test\n")
+	lock.Unlock()
+	close(release)
+	<-done
+
+	if want := "other test\nFailure hooks for \"failed\":\nfirst\nsecond\n\n"; out.String() != want {
+		t.Errorf("unexpected output %q", out.String())
+	}
+}
+
+func TestNewCommandFailureHook(t *testing.T) {
+	out := &bytes.Buffer{}
+	hook := NewCommandFailureHook(`echo "$TEST_NAME $TEST_ARTIFACT_DIR"`)
+	if err := hook.TestFailed(context.TODO(), "[sig-network] failed", nil, "/tmp/artifacts", out); err != nil {
+		t.Fatal(err)
+	}
+	if got := out.String(); got != "[sig-network] failed /tmp/artifacts\n" {
+		t.Errorf("unexpected output %q", got)
+	}
+}
@@ -0,0 +1,95 @@
+package ginkgo
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// ignoredDescriptorTargets are the prefixes of the targets of descriptors that are not reported. The
+// keep-alive connections of clients and the pipes and event descriptors of the runtime outlive the
+// test that caused them to be opened.
+var ignoredDescriptorTargets = []string{"socket:", "pipe:", "anon_inode:"}
+
+// usePrivateTempDir points TMPDIR at a new directory, so the temporary files of the test are not mixed
+// with those of tests running at the same time. The returned function restores TMPDIR and removes
+// the directory.
+func usePrivateTempDir() (string, func(), error) {
+	dir, err := ioutil.TempDir("", "openshift-tests-")
+	if err != nil {
+		return "", nil, err
+	}
+	previous, set := os.LookupEnv("TMPDIR")
+	os.Setenv("TMPDIR", dir)
+	return dir, func() {
+		if set {
+			os.Setenv("TMPDIR", previous)
+		} else {
+			os.Unsetenv("TMPDIR")
+		}
+		os.RemoveAll(dir)
+	}, nil
+}
+
+// fileSnapshot records the open file descriptors of the process and the entries of the private
+// temporary directory of the test, so files a test leaves behind can be attributed to it.
+type fileSnapshot struct {
+	fds     map[string]string
+	tempDir string
+	temp    map[string]bool
+}
+
+func takeFileSnapshot(tempDir string) *fileSnapshot {
+	snapshot := &fileSnapshot{fds: map[string]string{}, tempDir: tempDir, temp: map[string]bool{}}
+	// open descriptors are only listed on Linux, other platforms only check temporary files
+	if entries, err := ioutil.ReadDir("/proc/self/fd"); err == nil {
+		for _, entry := range entries {
+			target, _ := os.Readlink(filepath.Join("/proc/self/fd", entry.Name()))
+			snapshot.fds[entry.Name()] = target
+		}
+	}
+	if entries, err := ioutil.ReadDir(tempDir); err == nil {
+		for _, entry := range entries {
+			snapshot.temp[entry.Name()] = true
+		}
+	}
+	return snapshot
+}
+
+// leakedFiles describes the descriptors opened and temporary entries created since before that still
+// exist.
+func leakedFiles(before *fileSnapshot) []string {
+	after := takeFileSnapshot(before.tempDir)
+	var leaked []string
+	for fd, target := range after.fds {
+		if ignoredDescriptor(target) {
+			continue
+		}
+		if previous, ok := before.fds[fd]; !ok || previous != target {
+			leaked = append(leaked, fmt.Sprintf("file descriptor %s open on %s", fd, target))
+		}
+	}
+	for name := range after.temp {
+		if !before.temp[name] {
+			leaked = append(leaked, fmt.Sprintf("temporary file %s", filepath.Join(before.tempDir, name)))
+		}
+	}
+	sort.Strings(leaked)
+	return leaked
+}
+
+func ignoredDescriptor(target string) bool {
+	// the descriptor that listed /proc/self/fd is closed before its target can be read
+	if len(target) == 0 {
+		return true
+	}
+	for _, prefix := range ignoredDescriptorTargets {
+		if strings.HasPrefix(target, prefix) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,86 @@
+package ginkgo
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+func Test_leakedFiles(t *testing.T) {
+	tempDir := t.TempDir()
+	before := takeFileSnapshot(tempDir)
+
+	f, err := ioutil.TempFile(tempDir, "leak")
+	if err != nil {
+		t.Fatal(err)
+	}
+	leaked := leakedFiles(before)
+	wantTemp := "temporary file " + filepath.Join(tempDir, filepath.Base(f.Name()))
+	if !containsString(leaked, wantTemp) {
+		t.Errorf("expected %q in %q", wantTemp, leaked)
+	}
+	if runtime.GOOS == "linux" {
+		found := false
+		for _, l := range leaked {
+			if strings.HasPrefix(l, "file descriptor ") && strings.HasSuffix(l, f.Name()) {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("expected the open descriptor in %q", leaked)
+		}
+	}
+
+	f.Close()
+	os.Remove(f.Name())
+	if leaked := leakedFiles(before); len(leaked) != 0 {
+		t.Errorf("expected no leaks after cleanup, got %q", leaked)
+	}
+}
+
+func containsString(values []string, s string) bool {
+	for _, value := range values {
+		if value == s {
+			return true
+		}
+	}
+	return false
+}
+
+func Test_leakedFilesIgnoresPipes(t *testing.T) {
+	if runtime.GOOS != "linux" {
+		t.Skip("descriptors are only listed on Linux")
+	}
+	before := takeFileSnapshot(t.TempDir())
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+	defer w.Close()
+	if leaked := leakedFiles(before); len(leaked) != 0 {
+		t.Errorf("expected pipes to be ignored, got %q", leaked)
+	}
+}
+
+func Test_usePrivateTempDir(t *testing.T) {
+	t.Setenv("TMPDIR", t.TempDir())
+	shared := os.TempDir()
+	dir, restore, err := usePrivateTempDir()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if os.TempDir() != dir || filepath.Dir(dir) != shared {
+		t.Errorf("expected the temporary directory to be a new directory in %s, got %s", shared, os.TempDir())
+	}
+	restore()
+	if os.TempDir() != shared {
+		t.Errorf("expected the temporary directory to be restored to %s, got %s", shared, os.TempDir())
+	}
+	if _, err := os.Stat(dir); !os.IsNotExist(err) {
+		t.Errorf("expected the private directory to be removed, got %v", err)
+	}
+}
@@ -0,0 +1,119 @@
+package ginkgo
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// fingerprintNormalizers replace the parts of a failure message that differ between runs of the
+// same failure, in order. Timestamps go first so their digits are not taken for other values.
+var fingerprintNormalizers = []struct {
+	re          *regexp.Regexp
+	replacement string
+}{
+	// 2023-01-02T15:04:05.999Z, 2023-01-02 15:04:05 +0000 UTC
+	{regexp.MustCompile(`\d{4}-\d{2}-\d{2}[T ]\d{2}:\d{2}:\d{2}(\.\d+)?(Z|[+-]\d{2}:?\d{2})?( [A-Z]{3,4})?`), "<time>"},
+	// Jan  2 15:04:05.999, Mon Jan 2 15:04:05
+	{regexp.MustCompile(`\b((Mon|Tue|Wed|Thu|Fri|Sat|Sun) )?(Jan|Feb|Mar|Apr|May|Jun|Jul|Aug|Sep|Oct|Nov|Dec)\s+\d{1,2} \d{2}:\d{2}:\d{2}(\.\d+)?`), "<time>"},
+	{regexp.MustCompile(`\b\d{2}:\d{2}:\d{2}(\.\d+)?\b`), "<time>"},
+	{regexp.MustCompile(`(?i)\b[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}\b`), "<uid>"},
+	{regexp.MustCompile(`\b\d{1,3}(\.\d{1,3}){3}(:\d+)?\b`), "<ip>"},
+	{regexp.MustCompile(`(?i)\b([0-9a-f]{1,4}:){2,7}[0-9a-f]{1,4}\b|\[[0-9a-f:]+\](:\d+)?`), "<ip>"},
+	// namespaces created for a test, such as e2e-test-build-xk2p9 or e2e-kubectl-1234
+	{regexp.MustCompile(`\b(e2e-[a-z0-9-]+)-[a-z0-9]{4,5}\b`), "$1-<id>"},
+	// generated pod names, such as router-default-5c7d8f9b4-x2x9z
+	{regexp.MustCompile(`-[a-f0-9]{8,10}-[a-z0-9]{5}\b`), "-<id>"},
+	{regexp.MustCompile(`(?i)\b[0-9a-f]{12,}\b`), "<hex>"},
+	{regexp.MustCompile(`\b\d+(\.\d+)?(ns|us|µs|ms|s|m|h)\b`), "<duration>"},
+	{regexp.MustCompile(`\b\d{4,}\b`), "<number>"},
+}
+
+// normalizeFailure removes the values that vary between runs of the same failure, such as times,
+// uids, addresses, and generated names, so identical failures produce the same text.
+func normalizeFailure(message string) string {
+	for _, n := range fingerprintNormalizers {
+		message = n.re.ReplaceAllString(message, n.replacement)
+	}
+	return strings.TrimSpace(message)
+}
+
+// failureMessage returns the final message of a failed or flaked test.
+func failureMessage(test *testCase) string {
+	return lastLinesUntil(string(test.testOutputBytes), 20, "fail [", "flake:")
+}
+
+// failureFingerprint identifies the failure of a test so that identical failures can be grouped
+// across tests and runs. It returns an empty string if the test did not fail or flake.
+func failureFingerprint(test *testCase) string {
+	if !test.failed && !test.flake {
+		return ""
+	}
+	message := normalizeFailure(failureMessage(test))
+	if len(message) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%x", sha256.Sum256([]byte(message)))[:16]
+}
+
+// failureFingerprints groups the failed and flaked tests of a run by the fingerprint of their
+// failure, written to flake-fingerprints.json so tooling can cluster identical failures.
+type failureFingerprints struct {
+	Fingerprints []failureFingerprintGroup `json:"fingerprints"`
+}
+
+type failureFingerprintGroup struct {
+	Fingerprint string `json:"fingerprint"`
+	// Message is the normalized failure message the fingerprint was computed from
+	Message string                   `json:"message"`
+	Tests   []failureFingerprintTest `json:"tests"`
+}
+
+type failureFingerprintTest struct {
+	Name  string    `json:"name"`
+	ID    string    `json:"id"`
+	State TestState `json:"state"`
+}
+
+// groupFailureFingerprints returns the groups of failures, the largest first.
+func groupFailureFingerprints(tests []*testCase) failureFingerprints {
+	groups := map[string]*failureFingerprintGroup{}
+	for _, test := range tests {
+		fingerprint := failureFingerprint(test)
+		if len(fingerprint) == 0 {
+			continue
+		}
+		group, ok := groups[fingerprint]
+		if !ok {
+			group = &failureFingerprintGroup{Fingerprint: fingerprint, Message: normalizeFailure(failureMessage(test))}
+			groups[fingerprint] = group
+		}
+		group.Tests = append(group.Tests, failureFingerprintTest{Name: test.name, ID: test.id(), State: test.state()})
+	}
+
+	result := failureFingerprints{Fingerprints: []failureFingerprintGroup{}}
+	for _, group := range groups {
+		result.Fingerprints = append(result.Fingerprints, *group)
+	}
+	sort.Slice(result.Fingerprints, func(i, j int) bool {
+		a, b := result.Fingerprints[i], result.Fingerprints[j]
+		if len(a.Tests) != len(b.Tests) {
+			return len(a.Tests) > len(b.Tests)
+		}
+		return a.Fingerprint < b.Fingerprint
+	})
+	return result
+}
+
+func writeFailureFingerprints(dir, fileSuffix string, tests []*testCase) error {
+	data, err := json.MarshalIndent(groupFailureFingerprints(tests), "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filepath.Join(dir, fmt.Sprintf("flake-fingerprints%s.json", fileSuffix)), data, 0644)
+}
@@ -0,0 +1,70 @@
+package ginkgo
+
+import (
+	"testing"
+)
+
+func TestNormalizeFailure(t *testing.T) {
+	tests := []struct {
+		name    string
+		message string
+		want    string
+	}{
+		{
+			name:    "timestamps",
+			message: "fail [test.go:10]: timed out at 2023-01-02T15:04:05.123Z after Jan  2 15:04:05.123",
+			want:    "fail [test.go:10]: timed out at <time> after <time>",
+		},
+		{
+			name:    "uids and addresses",
+			message: "pod 0f3c2b8a-5d1e-4c3b-9a7f-2e6d1c0b9a8f unreachable at 10.128.2.15:8080 and [fd01::2]:443",
+			want:    "pod <uid> unreachable at <ip> and <ip>",
+		},
+		{
+			name:    "generated names",
+			message: "namespace e2e-test-build-xk2p9 pod router-default-5c7d8f9b4-x2x9z took 12.5s",
+			want:    "namespace e2e-test-build-<id> pod router-default-<id> took <duration>",
+		},
+		{
+			name:    "file and line numbers are kept",
+			message: "fail [github.com/openshift/origin/test/extended/builds/start.go:123]: expected 3 builds",
+			want:    "fail [github.com/openshift/origin/test/extended/builds/start.go:123]: expected 3 builds",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := normalizeFailure(test.message); got != test.want {
+				t.Errorf("expected %q, got %q", test.want, got)
+			}
+		})
+	}
+}
+
+func TestGroupFailureFingerprints(t *testing.T) {
+	tests := []*testCase{
+		{name: "a", failed: true, testOutputBytes: []byte("some output\nfail [a.go:1]: pod e2e-test-a-abcde failed at 10.0.0.1")},
+		{name: "b", flake: true, testOutputBytes: []byte("other output\nflake: pod e2e-test-a-xyz12 failed at 10.0.0.2")},
+		{name: "c", failed: true, testOutputBytes: []byte("fail [a.go:1]: pod e2e-test-a-fghij failed at 10.0.0.3")},
+		{name: "d", success: true, testOutputBytes: []byte("fail [a.go:1]: pod e2e-test-a-fghij failed at 10.0.0.3")},
+	}
+	if failureFingerprint(tests[0]) != failureFingerprint(tests[2]) {
+		t.Errorf("expected identical failures to share a fingerprint")
+	}
+	if failureFingerprint(tests[3]) != "" {
+		t.Errorf("expected no fingerprint for a passing test")
+	}
+
+	groups := groupFailureFingerprints(tests).Fingerprints
+	if len(groups) != 2 {
+		t.Fatalf("expected 2 groups, got %#v", groups)
+	}
+	if len(groups[0].Tests) != 2 || groups[0].Tests[0].Name != "a" || groups[0].Tests[1].Name != "c" {
+		t.Errorf("unexpected largest group: %#v", groups[0])
+	}
+	if groups[0].Message != "fail [a.go:1]: pod e2e-test-a-<id> failed at <ip>" {
+		t.Errorf("unexpected message: %q", groups[0].Message)
+	}
+	if len(groups[1].Tests) != 1 || groups[1].Tests[0].State != TestFlaked {
+		t.Errorf("unexpected flake group: %#v", groups[1])
+	}
+}
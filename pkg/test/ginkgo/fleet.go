@@ -0,0 +1,278 @@
+package ginkgo
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"github.com/openshift/origin/pkg/test/ginkgo/junitapi"
+)
+
+// FleetOptions runs the same suite against every cluster with a kubeconfig in a directory at the same
+// time. Every cluster is tested by the run command in its own process with KUBECONFIG pointing at the
+// cluster, and its reports and artifacts are written to a directory named after the cluster. When all
+// clusters complete, an aggregate report and a matrix of the result of every test on every cluster are
+// written.
+type FleetOptions struct {
+	// Binary runs the suite, and defaults to the current binary
+	Binary string
+	// Args are passed to the run command of every cluster
+	Args []string
+	// ClusterArgs, if set, returns the arguments passed to the run command of a cluster after Args
+	ClusterArgs func(cluster string) []string
+	// KubeconfigDir holds a kubeconfig file for each cluster, or a directory for each cluster holding a
+	// file named kubeconfig. The cluster is named after the file, without its extension, or the directory.
+	KubeconfigDir string
+	// JUnitDir, if set, receives a directory of reports for each cluster, the aggregate report, and the matrix
+	JUnitDir string
+	// ArtifactDir, if set, receives a directory of artifacts for each cluster. Otherwise the artifacts of a
+	// cluster are written with its reports.
+	ArtifactDir string
+
+	Out, ErrOut io.Writer
+}
+
+// fleetCluster is a cluster found in the kubeconfig directory.
+type fleetCluster struct {
+	name       string
+	kubeconfig string
+}
+
+// Run runs the suite against every cluster and returns an error if it failed on any of them.
+func (opt *FleetOptions) Run(ctx context.Context, suite string) error {
+	clusters, err := findFleetClusters(opt.KubeconfigDir)
+	if err != nil {
+		return err
+	}
+	junitDir := opt.JUnitDir
+	if len(junitDir) == 0 {
+		dir, err := ioutil.TempDir("", "openshift-tests-fleet")
+		if err != nil {
+			return err
+		}
+		defer os.RemoveAll(dir)
+		junitDir = dir
+	}
+
+	// the suite is named by the suite file in the arguments when it is empty
+	runArgs := []string{"run"}
+	if len(suite) > 0 {
+		runArgs = append(runArgs, suite)
+	}
+	fmt.Fprintf(opt.Out, "Running the suite against %d clusters\n\n", len(clusters))
+	runs := make([]*suiteRun, 0, len(clusters))
+	lock := &sync.Mutex{}
+	var wg sync.WaitGroup
+	for _, cluster := range clusters {
+		run := &suiteRun{name: cluster.name, dir: filepath.Join(junitDir, cluster.name)}
+		runs = append(runs, run)
+		artifactDir := run.dir
+		if len(opt.ArtifactDir) > 0 {
+			artifactDir = filepath.Join(opt.ArtifactDir, cluster.name)
+		}
+		env := []string{fmt.Sprintf("KUBECONFIG=%s", cluster.kubeconfig), fmt.Sprintf("ARTIFACT_DIR=%s", artifactDir)}
+
+		wg.Add(1)
+		go func(run *suiteRun) {
+			defer wg.Done()
+			out := newLinePrefixWriter(lock, opt.Out, fmt.Sprintf("[%s] ", run.name))
+			defer out.Flush()
+
+			start := time.Now()
+			defer func() { run.duration = time.Since(start).Round(time.Second) }()
+			for _, dir := range []string{run.dir, artifactDir} {
+				if err := os.MkdirAll(dir, 0755); err != nil {
+					run.err = err
+					return
+				}
+			}
+			args := append(append(append([]string{}, runArgs...), "--junit-dir", run.dir), opt.Args...)
+			if opt.ClusterArgs != nil {
+				args = append(args, opt.ClusterArgs(run.name)...)
+			}
+			runSuiteProcess(ctx, opt.Binary, args, env, run, out)
+		}(run)
+	}
+	wg.Wait()
+
+	var failed []string
+	aggregate := &junitapi.JUnitTestSuites{}
+	for _, run := range runs {
+		results, err := readSuiteResults(run.dir)
+		if err != nil {
+			fmt.Fprintf(opt.ErrOut, "error: Unable to read the results of cluster %s: %v\n", run.name, err)
+		}
+		if results != nil {
+			results.Name = run.name
+			run.results = results
+			aggregate.Suites = append(aggregate.Suites, results)
+		}
+		if run.err != nil {
+			failed = append(failed, run.name)
+		}
+	}
+	writeMultiSuiteSummary(opt.Out, "Clusters", runs)
+	matrix := newFleetMatrix(runs)
+	matrix.writeFailures(opt.Out)
+
+	if len(opt.JUnitDir) > 0 {
+		timeSuffix := time.Now().UTC().Format("20060102-150405")
+		out, err := xml.Marshal(aggregate)
+		if err != nil {
+			return err
+		}
+		path := filepath.Join(opt.JUnitDir, fmt.Sprintf("junit_aggregate_%s.xml", timeSuffix))
+		fmt.Fprintf(opt.ErrOut, "Writing JUnit report to %s\n\n", path)
+		if err := ioutil.WriteFile(path, out, 0640); err != nil {
+			return err
+		}
+		path = filepath.Join(opt.JUnitDir, fmt.Sprintf("fleet-matrix_%s.csv", timeSuffix))
+		fmt.Fprintf(opt.ErrOut, "Writing the results of every test on every cluster to %s\n\n", path)
+		if err := matrix.writeCSV(path); err != nil {
+			return err
+		}
+	}
+
+	if len(failed) > 0 {
+		return fmt.Errorf("the suite failed on %d of %d clusters: %s", len(failed), len(runs), strings.Join(failed, ", "))
+	}
+	return ctx.Err()
+}
+
+// findFleetClusters returns the clusters of the kubeconfig directory sorted by name. Hidden files are ignored.
+func findFleetClusters(dir string) ([]fleetCluster, error) {
+	if len(dir) == 0 {
+		return nil, fmt.Errorf("a directory of kubeconfigs must be specified")
+	}
+	entries, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read the kubeconfig directory: %v", err)
+	}
+	var clusters []fleetCluster
+	names := map[string]string{}
+	for _, entry := range entries {
+		if strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		cluster := fleetCluster{name: entry.Name(), kubeconfig: filepath.Join(dir, entry.Name())}
+		if entry.IsDir() {
+			cluster.kubeconfig = filepath.Join(cluster.kubeconfig, "kubeconfig")
+			if _, err := os.Stat(cluster.kubeconfig); err != nil {
+				continue
+			}
+		} else if ext := filepath.Ext(cluster.name); len(ext) > 0 && len(ext) < len(cluster.name) {
+			cluster.name = strings.TrimSuffix(cluster.name, ext)
+		}
+		if other, ok := names[cluster.name]; ok {
+			return nil, fmt.Errorf("the kubeconfigs %s and %s are both for cluster %s", other, cluster.kubeconfig, cluster.name)
+		}
+		names[cluster.name] = cluster.kubeconfig
+		clusters = append(clusters, cluster)
+	}
+	if len(clusters) == 0 {
+		return nil, fmt.Errorf("no kubeconfigs found in %s", dir)
+	}
+	sort.Slice(clusters, func(i, j int) bool { return clusters[i].name < clusters[j].name })
+	return clusters, nil
+}
+
+// fleetMatrix is the result of every test on every cluster of a fleet run.
+type fleetMatrix struct {
+	clusters []string
+	tests    []string
+	// results maps a test to its result on each cluster: pass, fail, flake, or skip. A test that did not
+	// run on a cluster has no result for it.
+	results map[string]map[string]string
+}
+
+func newFleetMatrix(runs []*suiteRun) *fleetMatrix {
+	m := &fleetMatrix{results: map[string]map[string]string{}}
+	for _, run := range runs {
+		m.clusters = append(m.clusters, run.name)
+		if run.results == nil {
+			continue
+		}
+		for _, test := range run.results.TestCases {
+			if _, ok := m.results[test.Name]; !ok {
+				m.results[test.Name] = map[string]string{}
+				m.tests = append(m.tests, test.Name)
+			}
+			result := "pass"
+			switch {
+			case test.SkipMessage != nil:
+				result = "skip"
+			case test.FailureOutput != nil:
+				result = "fail"
+			}
+			// a flaky test is reported as a failure and a success
+			switch previous := m.results[test.Name][run.name]; {
+			case previous == "flake",
+				previous == "fail" && result == "pass",
+				previous == "pass" && result == "fail":
+				result = "flake"
+			}
+			m.results[test.Name][run.name] = result
+		}
+	}
+	sort.Strings(m.tests)
+	return m
+}
+
+// writeFailures prints the tests that failed on any cluster and the clusters they failed on.
+func (m *fleetMatrix) writeFailures(out io.Writer) {
+	w := tabwriter.NewWriter(out, 0, 4, 2, ' ', 0)
+	count := 0
+	for _, test := range m.tests {
+		var failedOn []string
+		for _, cluster := range m.clusters {
+			if m.results[test][cluster] == "fail" {
+				failedOn = append(failedOn, cluster)
+			}
+		}
+		if len(failedOn) == 0 {
+			continue
+		}
+		if count == 0 {
+			fmt.Fprintf(out, "Failing tests:\n\n")
+		}
+		count++
+		fmt.Fprintf(w, "  %s\t%d/%d\t%s\n", test, len(failedOn), len(m.clusters), strings.Join(failedOn, ", "))
+	}
+	w.Flush()
+	if count > 0 {
+		fmt.Fprintln(out)
+	}
+}
+
+// writeCSV writes a row for every test with its result on each cluster.
+func (m *fleetMatrix) writeCSV(path string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	w := csv.NewWriter(f)
+	w.Write(append([]string{"test"}, m.clusters...))
+	for _, test := range m.tests {
+		row := []string{test}
+		for _, cluster := range m.clusters {
+			row = append(row, m.results[test][cluster])
+		}
+		w.Write(row)
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return err
+	}
+	return f.Close()
+}
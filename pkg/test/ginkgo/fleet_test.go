@@ -0,0 +1,109 @@
+package ginkgo
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestFleetOptions_Run(t *testing.T) {
+	dir := t.TempDir()
+	binary := filepath.Join(dir, "openshift-tests")
+	// the fake run command fails test b on cluster-b, which also flakes test c
+	script := `#!/bin/sh
+dir=$4
+echo "running $2 with $5 against $KUBECONFIG"
+case "$KUBECONFIG" in
+*cluster-b*)
+	printf '<testsuite name="openshift-tests" tests="4" failures="2" skipped="0"><testcase name="a"></testcase><testcase name="b"><failure message=""></failure></testcase><testcase name="c"><failure message=""></failure></testcase><testcase name="c"></testcase></testsuite>' > $dir/junit_e2e_20230101-000000.xml
+	exit 1 ;;
+esac
+printf '<testsuite name="openshift-tests" tests="3" failures="0" skipped="1"><testcase name="a"></testcase><testcase name="b"></testcase><testcase name="c"><skipped message=""></skipped></testcase></testsuite>' > $dir/junit_e2e_20230101-000000.xml
+echo "$ARTIFACT_DIR" > $dir/artifact-dir
+`
+	if err := ioutil.WriteFile(binary, []byte(script), 0755); err != nil {
+		t.Fatal(err)
+	}
+	kubeconfigDir := t.TempDir()
+	for _, name := range []string{"cluster-a.kubeconfig", "cluster-b.kubeconfig", ".hidden"} {
+		if err := ioutil.WriteFile(filepath.Join(kubeconfigDir, name), nil, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	junitDir, artifactDir := t.TempDir(), t.TempDir()
+	out := &bytes.Buffer{}
+	opt := &FleetOptions{Binary: binary, Args: []string{"--max-parallel-tests=5"}, KubeconfigDir: kubeconfigDir, JUnitDir: junitDir, ArtifactDir: artifactDir, Out: out, ErrOut: ioutil.Discard}
+	err := opt.Run(context.TODO(), "openshift/conformance")
+	if err == nil || err.Error() != "the suite failed on 1 of 2 clusters: cluster-b" {
+		t.Errorf("unexpected error %v", err)
+	}
+	if !strings.Contains(out.String(), "[cluster-a] running openshift/conformance with --max-parallel-tests=5 against "+filepath.Join(kubeconfigDir, "cluster-a.kubeconfig")+"\n") {
+		t.Errorf("expected prefixed output of each cluster, got:\n%s", out.String())
+	}
+	if !strings.Contains(out.String(), "cluster-a  passed  2 pass, 0 fail, 1 skip") || !strings.Contains(out.String(), "cluster-b  failed  2 pass, 2 fail, 0 skip") {
+		t.Errorf("unexpected summary:\n%s", out.String())
+	}
+	if !strings.Contains(out.String(), "Failing tests:\n\n  b  1/2  cluster-b\n") {
+		t.Errorf("expected the failing tests, got:\n%s", out.String())
+	}
+	data, _ := ioutil.ReadFile(filepath.Join(junitDir, "cluster-a", "artifact-dir"))
+	if got := strings.TrimSpace(string(data)); got != filepath.Join(artifactDir, "cluster-a") {
+		t.Errorf("expected the artifacts of the cluster in its own directory, got %q", got)
+	}
+
+	matches, _ := filepath.Glob(filepath.Join(junitDir, "junit_aggregate_*.xml"))
+	if len(matches) != 1 {
+		t.Fatalf("expected an aggregate report, got %v", matches)
+	}
+	data, _ = ioutil.ReadFile(matches[0])
+	if !strings.Contains(string(data), `<testsuite name="cluster-a" tests="3" skipped="1" failures="0"`) || !strings.Contains(string(data), `<testsuite name="cluster-b" tests="4" skipped="0" failures="2"`) {
+		t.Errorf("unexpected aggregate report %s", data)
+	}
+	matches, _ = filepath.Glob(filepath.Join(junitDir, "fleet-matrix_*.csv"))
+	if len(matches) != 1 {
+		t.Fatalf("expected a matrix, got %v", matches)
+	}
+	data, _ = ioutil.ReadFile(matches[0])
+	if expected := "test,cluster-a,cluster-b\na,pass,pass\nb,pass,fail\nc,skip,flake\n"; string(data) != expected {
+		t.Errorf("unexpected matrix:\n%s", data)
+	}
+}
+
+func Test_findFleetClusters(t *testing.T) {
+	dir := t.TempDir()
+	for _, path := range []string{"a.kubeconfig", "b", "c/kubeconfig", "d/other", ".e"} {
+		path = filepath.Join(dir, path)
+		os.MkdirAll(filepath.Dir(path), 0755)
+		if err := ioutil.WriteFile(path, nil, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	clusters, err := findFleetClusters(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []fleetCluster{
+		{name: "a", kubeconfig: filepath.Join(dir, "a.kubeconfig")},
+		{name: "b", kubeconfig: filepath.Join(dir, "b")},
+		{name: "c", kubeconfig: filepath.Join(dir, "c", "kubeconfig")},
+	}
+	if !reflect.DeepEqual(clusters, expected) {
+		t.Errorf("unexpected clusters %#v", clusters)
+	}
+
+	if err := ioutil.WriteFile(filepath.Join(dir, "a.yaml"), nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := findFleetClusters(dir); err == nil || !strings.Contains(err.Error(), "are both for cluster a") {
+		t.Errorf("expected kubeconfigs with the same cluster name to be rejected, got %v", err)
+	}
+	if _, err := findFleetClusters(t.TempDir()); err == nil {
+		t.Errorf("expected an empty directory to be rejected")
+	}
+}
@@ -7,11 +7,23 @@ import (
 	"github.com/onsi/ginkgo/v2/types"
 
 	"k8s.io/apimachinery/pkg/util/errors"
-
-	"github.com/openshift/origin/test/extended/util/annotate/generated"
 )
 
+// testsForSuite returns every test in the suite, shuffled with the ginkgo random seed. Each call
+// generates the specs from the test tree again, with annotations appended to their own copies of the
+// nodes, so it may be called any number of times.
 func testsForSuite() ([]*testCase, error) {
+	tests, err := loadTests()
+	if err != nil {
+		return nil, err
+	}
+	suiteConfig, _ := ginkgo.GinkgoConfiguration()
+	r := rand.New(rand.NewSource(suiteConfig.RandomSeed))
+	r.Shuffle(len(tests), func(i, j int) { tests[i], tests[j] = tests[j], tests[i] })
+	return tests, nil
+}
+
+func loadTests() ([]*testCase, error) {
 	var tests []*testCase
 	var errs []error
 
@@ -21,20 +33,24 @@ func testsForSuite() ([]*testCase, error) {
 	}
 
 	ginkgo.GetSuite().WalkTests(func(name string, spec types.TestSpec) {
-		if append, ok := generated.Annotations[name]; ok {
-			spec.AppendText(append)
+		annotation, metadata := annotateTest(name)
+		if len(annotation) > 0 {
+			spec.AppendText(annotation)
 		}
 		tc, err := newTestCaseFromGinkgoSpec(spec)
 		if err != nil {
 			errs = append(errs, err)
+			return
 		}
+		tc.annotations = annotation
+		tc.metadata = metadata
 		tests = append(tests, tc)
 	})
+	if err := validateExternalIDs(tests); err != nil {
+		errs = append(errs, err)
+	}
 	if len(errs) > 0 {
 		return nil, errors.NewAggregate(errs)
 	}
-	suiteConfig, _ := ginkgo.GinkgoConfiguration()
-	r := rand.New(rand.NewSource(suiteConfig.RandomSeed))
-	r.Shuffle(len(tests), func(i, j int) { tests[i], tests[j] = tests[j], tests[i] })
 	return tests, nil
 }
@@ -0,0 +1,61 @@
+package ginkgo
+
+import (
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// failureLocationRe matches the summary line written by run-test for a failed test, for instance
+// "fail [github.com/openshift/origin/test/extended/builds/build.go:42]: message".
+var failureLocationRe = regexp.MustCompile(`(?m)^fail \[([^\]]+):(\d+)\]: (.*)$`)
+
+// writeGitHubAnnotations writes a GitHub Actions workflow command for every failing test so that
+// failures are shown as annotations on the pull request and in the check run summary.
+func writeGitHubAnnotations(out io.Writer, tests []*testCase) {
+	for _, test := range tests {
+		file, line, message := githubFailureLocation(test)
+		var properties []string
+		if len(file) > 0 {
+			properties = append(properties, "file="+escapeGitHubProperty(file), "line="+strconv.Itoa(line))
+		}
+		properties = append(properties, "title="+escapeGitHubProperty(test.name))
+		fmt.Fprintf(out, "::error %s::%s\n", strings.Join(properties, ","), escapeGitHubData(message))
+	}
+}
+
+// githubFailureLocation returns the repository relative location and message of the failure, falling
+// back to the location of the test itself when the output does not identify one.
+func githubFailureLocation(test *testCase) (string, int, string) {
+	output := string(test.testOutputBytes)
+	if matches := failureLocationRe.FindAllStringSubmatch(output, -1); len(matches) > 0 {
+		match := matches[len(matches)-1]
+		line, _ := strconv.Atoi(match[2])
+		return repositoryRelativePath(match[1]), line, match[3]
+	}
+	message := lastLinesUntil(output, 5)
+	if len(message) == 0 {
+		message = "test failed"
+	}
+	if len(test.locations) > 0 {
+		location := test.locations[len(test.locations)-1]
+		return repositoryRelativePath(location.FileName), location.LineNumber, message
+	}
+	return "", 0, message
+}
+
+// repositoryRelativePath strips the module path from file names so they resolve within the repository.
+func repositoryRelativePath(file string) string {
+	file = lastFilenameSegment(file)
+	return strings.TrimPrefix(file, "github.com/openshift/origin/")
+}
+
+func escapeGitHubData(s string) string {
+	return strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A").Replace(s)
+}
+
+func escapeGitHubProperty(s string) string {
+	return strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A", ":", "%3A", ",", "%2C").Replace(s)
+}
@@ -0,0 +1,34 @@
+package ginkgo
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/onsi/ginkgo/v2/types"
+)
+
+func Test_writeGitHubAnnotations(t *testing.T) {
+	tests := []*testCase{
+		{
+			name:            "[sig-builds] builds should work, really",
+			testOutputBytes: []byte("STEP: building\nfail [github.com/openshift/origin/test/extended/builds/build.go:42]: Expected 100%\n"),
+		},
+		{
+			name:            "[sig-network] timed out",
+			locations:       []types.CodeLocation{{FileName: "/go/src/github.com/openshift/origin/test/extended/networking/services.go", LineNumber: 7}},
+			testOutputBytes: []byte("interrupted\n"),
+		},
+		{
+			name: "[sig-node] no output",
+		},
+	}
+	out := &bytes.Buffer{}
+	writeGitHubAnnotations(out, tests)
+
+	want := "::error file=test/extended/builds/build.go,line=42,title=[sig-builds] builds should work%2C really::Expected 100%25\n" +
+		"::error file=test/extended/networking/services.go,line=7,title=[sig-network] timed out::interrupted\n" +
+		"::error title=[sig-node] no output::test failed\n"
+	if out.String() != want {
+		t.Errorf("unexpected annotations:\n%s\nwant:\n%s", out.String(), want)
+	}
+}
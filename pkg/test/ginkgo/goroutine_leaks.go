@@ -0,0 +1,81 @@
+package ginkgo
+
+import (
+	"regexp"
+	"runtime"
+	"strings"
+	"time"
+)
+
+const (
+	// LeakCheckWarn reports goroutines or files a test leaves behind in its output.
+	LeakCheckWarn = "warn"
+	// LeakCheckFail fails an otherwise passing test that leaves goroutines or files behind.
+	LeakCheckFail = "fail"
+)
+
+// defaultGoroutineLeakAllowList are functions that start long lived goroutines shared by all tests,
+// such as client caches and log flushing, which are not leaks of the test that first used them.
+var defaultGoroutineLeakAllowList = []string{
+	"k8s.io/klog/v2.",
+	"k8s.io/client-go/tools/cache.",
+	"k8s.io/client-go/util/workqueue.",
+	"k8s.io/apimachinery/pkg/util/wait.",
+	"net/http.",
+	"golang.org/x/net/http2.",
+	"google.golang.org/grpc.",
+	"go.opencensus.io/",
+	"github.com/onsi/ginkgo/v2/",
+	"os/signal.",
+}
+
+var goroutineIDRe = regexp.MustCompile(`^goroutine (\d+) `)
+
+// goroutineSnapshot maps the id of every running goroutine to its stack.
+func goroutineSnapshot() map[string]string {
+	buf := make([]byte, maxGoroutineDumpBytes)
+	for {
+		n := runtime.Stack(buf, true)
+		if n < len(buf) {
+			buf = buf[:n]
+			break
+		}
+		buf = make([]byte, 2*len(buf))
+	}
+	goroutines := map[string]string{}
+	for _, goroutine := range strings.Split(strings.TrimSpace(string(buf)), "\n\n") {
+		if m := goroutineIDRe.FindStringSubmatch(goroutine); m != nil {
+			goroutines[m[1]] = goroutine
+		}
+	}
+	return goroutines
+}
+
+// leakedGoroutines returns the stacks of goroutines started since before that are still running and
+// have no frame matching allowList. Goroutines often take a moment to observe cancellation, so the
+// check is repeated until grace has passed.
+func leakedGoroutines(before map[string]string, allowList []string, grace time.Duration) []string {
+	deadline := time.Now().Add(grace)
+	for {
+		var leaked []string
+		for id, stack := range goroutineSnapshot() {
+			if _, ok := before[id]; ok || allowedGoroutine(stack, allowList) {
+				continue
+			}
+			leaked = append(leaked, stack)
+		}
+		if len(leaked) == 0 || time.Now().After(deadline) {
+			return uniqueStrings(leaked)
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+}
+
+func allowedGoroutine(stack string, allowList []string) bool {
+	for _, allowed := range allowList {
+		if strings.Contains(stack, "\n"+allowed) || strings.Contains(stack, "created by "+allowed) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,32 @@
+package ginkgo
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func leakForTest(started, stop chan struct{}) {
+	close(started)
+	<-stop
+}
+
+func Test_leakedGoroutines(t *testing.T) {
+	before := goroutineSnapshot()
+	started, stop := make(chan struct{}), make(chan struct{})
+	go leakForTest(started, stop)
+	<-started
+
+	leaked := leakedGoroutines(before, nil, 0)
+	if len(leaked) != 1 || !strings.Contains(leaked[0], "leakForTest") {
+		t.Errorf("expected the started goroutine to leak, got %q", leaked)
+	}
+	if leaked := leakedGoroutines(before, []string{"github.com/openshift/origin/pkg/test/ginkgo.leakForTest"}, 0); len(leaked) != 0 {
+		t.Errorf("expected the allowed goroutine to be ignored, got %q", leaked)
+	}
+
+	close(stop)
+	if leaked := leakedGoroutines(before, nil, 5*time.Second); len(leaked) != 0 {
+		t.Errorf("expected no leak after the goroutine exits, got %q", leaked)
+	}
+}
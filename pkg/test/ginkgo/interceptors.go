@@ -0,0 +1,66 @@
+package ginkgo
+
+import (
+	"context"
+	"time"
+)
+
+// TestResult is the outcome of a test as seen by a TestInterceptor.
+type TestResult struct {
+	State TestState
+	// Output is appended to the output of the test. The result returned by next holds the output
+	// added by the inner interceptors, so interceptors append to it and cannot drop the output of the
+	// test.
+	Output []byte
+}
+
+// TestRunFunc runs the remaining interceptors and then the test itself.
+type TestRunFunc func(ctx context.Context) TestResult
+
+// TestInterceptor wraps the execution of every test, for cross-cutting concerns such as metrics,
+// tracing, environment checks or chaos injection. The interceptor calls next to run the test and
+// may change the context passed to it or the result it returns. An interceptor that returns without
+// calling next prevents the test from running; if it does not set a state the test is reported as
+// skipped.
+type TestInterceptor interface {
+	InterceptTest(ctx context.Context, test *testCase, next TestRunFunc) TestResult
+}
+
+// TestInterceptorFunc converts a function into the TestInterceptor interface.
+type TestInterceptorFunc func(ctx context.Context, test *testCase, next TestRunFunc) TestResult
+
+func (fn TestInterceptorFunc) InterceptTest(ctx context.Context, test *testCase, next TestRunFunc) TestResult {
+	return fn(ctx, test, next)
+}
+
+// interceptTest invokes run through the interceptors, the first of which is outermost. The timing and
+// resources of the result are those of the last call to run, and the output of the interceptors follows
+// the output of the test.
+func interceptTest(ctx context.Context, interceptors []TestInterceptor, test *testCase, run func(ctx context.Context, test *testCase) *testRunResult) *testRunResult {
+	if len(interceptors) == 0 {
+		return run(ctx, test)
+	}
+
+	start := time.Now()
+	var ran *testRunResult
+	next := TestRunFunc(func(ctx context.Context) TestResult {
+		ran = run(ctx, test)
+		return TestResult{State: ran.testState}
+	})
+	for i := len(interceptors) - 1; i >= 0; i-- {
+		interceptor, inner := interceptors[i], next
+		next = func(ctx context.Context) TestResult {
+			return interceptor.InterceptTest(ctx, test, inner)
+		}
+	}
+	result := next(ctx)
+
+	if ran == nil {
+		ran = &testRunResult{name: test.name, start: start, end: time.Now(), testState: TestSkipped}
+	}
+	if len(result.State) > 0 {
+		ran.testState = result.State
+	}
+	ran.testOutputBytes = append(ran.testOutputBytes, result.Output...)
+	return ran
+}
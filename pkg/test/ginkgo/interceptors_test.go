@@ -0,0 +1,64 @@
+package ginkgo
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func Test_interceptTest(t *testing.T) {
+	var calls []string
+	record := func(name string) TestInterceptor {
+		return TestInterceptorFunc(func(ctx context.Context, test *testCase, next TestRunFunc) TestResult {
+			calls = append(calls, name+" before "+test.name)
+			result := next(ctx)
+			calls = append(calls, name+" after")
+			return result
+		})
+	}
+	run := func(ctx context.Context, test *testCase) *testRunResult {
+		calls = append(calls, "run")
+		return &testRunResult{name: "a", testState: TestFailed, testOutputBytes: []byte("failed")}
+	}
+	test := &testCase{name: "a"}
+
+	result := interceptTest(context.TODO(), []TestInterceptor{record("outer"), record("inner")}, test, run)
+	if got := strings.Join(calls, ","); got != "outer before a,inner before a,run,inner after,outer after" {
+		t.Errorf("unexpected order %q", got)
+	}
+	if result.testState != TestFailed || string(result.testOutputBytes) != "failed" {
+		t.Errorf("unexpected result %#v", result)
+	}
+
+	calls = nil
+	override := TestInterceptorFunc(func(ctx context.Context, test *testCase, next TestRunFunc) TestResult {
+		result := next(ctx)
+		return TestResult{State: TestFlaked, Output: append(result.Output, " and retried"...)}
+	})
+	result = interceptTest(context.TODO(), []TestInterceptor{override}, test, run)
+	if result.testState != TestFlaked || string(result.testOutputBytes) != "failed and retried" {
+		t.Errorf("expected the interceptor to override the result, got %#v", result)
+	}
+
+	calls = nil
+	replace := TestInterceptorFunc(func(ctx context.Context, test *testCase, next TestRunFunc) TestResult {
+		next(ctx)
+		return TestResult{State: TestFlaked, Output: []byte("\nretried")}
+	})
+	result = interceptTest(context.TODO(), []TestInterceptor{replace}, test, run)
+	if string(result.testOutputBytes) != "failed\nretried" {
+		t.Errorf("expected the output of the interceptor to follow the output of the test, got %q", result.testOutputBytes)
+	}
+
+	calls = nil
+	skip := TestInterceptorFunc(func(ctx context.Context, test *testCase, next TestRunFunc) TestResult {
+		return TestResult{Output: []byte("environment not ready")}
+	})
+	result = interceptTest(context.TODO(), []TestInterceptor{skip, record("inner")}, test, run)
+	if len(calls) != 0 {
+		t.Errorf("expected the test not to run, got %v", calls)
+	}
+	if result.testState != TestSkipped || result.start.IsZero() || string(result.testOutputBytes) != "environment not ready" {
+		t.Errorf("unexpected short-circuited result %#v", result)
+	}
+}
@@ -0,0 +1,89 @@
+package ginkgo
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+)
+
+const (
+	// OutputFormatText writes the human readable log of the suite to standard out.
+	OutputFormatText = ""
+	// OutputFormatJSONStream writes one JSON object per suite and test event to standard out as it
+	// happens, and the human readable log to standard error.
+	OutputFormatJSONStream = "json-stream"
+)
+
+// jsonStreamEvent is a line of the json-stream output.
+type jsonStreamEvent struct {
+	Time time.Time `json:"time"`
+	// Action is one of suite-start, start, pass, fail, timeout, flake, skip, failed-as-expected,
+	// unexpected-pass, unknown, progress, interrupt, or suite-end
+	Action   string  `json:"action"`
+	Suite    string  `json:"suite,omitempty"`
+	Test     string  `json:"test,omitempty"`
+	Duration float64 `json:"durationSeconds,omitempty"`
+	Signal   string  `json:"signal,omitempty"`
+
+	Total    *int `json:"total,omitempty"`
+	Started  *int `json:"started,omitempty"`
+	Finished *int `json:"finished,omitempty"`
+	Pass     *int `json:"pass,omitempty"`
+	Fail     *int `json:"fail,omitempty"`
+	Skip     *int `json:"skip,omitempty"`
+}
+
+var jsonStreamActions = map[TestState]string{
+	TestSucceeded:        "pass",
+	TestFailed:           "fail",
+	TestFailedTimeout:    "timeout",
+	TestFlaked:           "flake",
+	TestSkipped:          "skip",
+	TestFailedAsExpected: "failed-as-expected",
+	TestUnexpectedPass:   "unexpected-pass",
+	TestUnknown:          "unknown",
+}
+
+// newJSONStreamSubscriber returns an event bus subscriber that writes every event to out as a line of
+// JSON, so that dashboards can follow a suite while it runs.
+func newJSONStreamSubscriber(out io.Writer) func(SuiteEvent) {
+	var lock sync.Mutex
+	encoder := json.NewEncoder(out)
+	return func(event SuiteEvent) {
+		e := jsonStreamEvent{Time: time.Now().UTC()}
+		switch event := event.(type) {
+		case SuiteWillBegin:
+			e.Action, e.Suite, e.Total = "suite-start", event.Suite, &event.TestCount
+		case TestWillRun:
+			e.Action, e.Test = "start", event.Test
+		case TestDidRun:
+			e.Action, e.Test, e.Duration = jsonStreamActions[event.State], event.Test, event.Duration.Seconds()
+			if len(e.Action) == 0 {
+				e.Action = "unknown"
+			}
+		case ProgressReport:
+			e.Action, e.Started, e.Finished, e.Fail, e.Total = "progress", &event.Started, &event.Finished, &event.Failures, &event.Total
+		case Interrupt:
+			e.Action, e.Signal = "interrupt", event.Signal
+		case SuiteDidEnd:
+			e.Action, e.Suite, e.Duration = "suite-end", event.Suite, event.Duration.Seconds()
+			e.Pass, e.Fail, e.Skip = &event.Pass, &event.Fail, &event.Skip
+		default:
+			return
+		}
+		lock.Lock()
+		defer lock.Unlock()
+		encoder.Encode(e)
+	}
+}
+
+// validateOutputFormat returns an error for an unknown output format.
+func validateOutputFormat(format string) error {
+	switch format {
+	case OutputFormatText, OutputFormatJSONStream, OutputFormatSonobuoy:
+		return nil
+	}
+	return fmt.Errorf("unrecognized output format %q, must be empty, %q, or %q", format, OutputFormatJSONStream, OutputFormatSonobuoy)
+}
@@ -0,0 +1,52 @@
+package ginkgo
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+)
+
+func Test_newJSONStreamSubscriber(t *testing.T) {
+	out := &bytes.Buffer{}
+	bus := NewEventBus()
+	bus.Subscribe(newJSONStreamSubscriber(out))
+
+	bus.Publish(SuiteWillBegin{Suite: "suite", TestCount: 2})
+	bus.Publish(TestWillRun{Test: "a"})
+	bus.Publish(TestDidRun{Test: "a", State: TestFlaked, Duration: 2 * time.Second})
+	bus.Publish(ProgressReport{Started: 1, Finished: 1, Failures: 0, Total: 2})
+	bus.Publish(Interrupt{Signal: "interrupt"})
+	bus.Publish(SuiteDidEnd{Suite: "suite", Pass: 1, Fail: 0, Skip: 1, Duration: time.Minute})
+
+	var actions []string
+	var events []jsonStreamEvent
+	for _, line := range strings.Split(strings.TrimSpace(out.String()), "\n") {
+		e := jsonStreamEvent{}
+		if err := json.Unmarshal([]byte(line), &e); err != nil {
+			t.Fatalf("invalid line %q: %v", line, err)
+		}
+		actions = append(actions, e.Action)
+		events = append(events, e)
+	}
+	if got := strings.Join(actions, ","); got != "suite-start,start,flake,progress,interrupt,suite-end" {
+		t.Fatalf("unexpected actions %s", got)
+	}
+	if e := events[2]; e.Test != "a" || e.Duration != 2 {
+		t.Errorf("unexpected test event %#v", e)
+	}
+	if e := events[3]; *e.Fail != 0 || *e.Finished != 1 || *e.Total != 2 {
+		t.Errorf("unexpected progress event %#v", e)
+	}
+	if !strings.Contains(out.String(), `"fail":0`) {
+		t.Errorf("expected zero counts to be written: %s", out.String())
+	}
+	if e := events[5]; *e.Pass != 1 || *e.Skip != 1 || e.Duration != 60 {
+		t.Errorf("unexpected suite event %#v", e)
+	}
+
+	if err := validateOutputFormat("xml"); err == nil {
+		t.Error("expected an error for an unknown format")
+	}
+}
@@ -6,9 +6,13 @@ import (
 	"io"
 	"io/ioutil"
 	"path/filepath"
+	"runtime/debug"
+	"strconv"
 	"strings"
 	"time"
 
+	"k8s.io/apimachinery/pkg/util/sets"
+
 	"github.com/openshift/origin/pkg/test"
 	"github.com/openshift/origin/pkg/test/ginkgo/junitapi"
 
@@ -22,14 +26,9 @@ func generateJUnitTestSuiteResults(
 	syntheticTestResults ...*junitapi.JUnitTestCase) *junitapi.JUnitTestSuite {
 
 	s := &junitapi.JUnitTestSuite{
-		Name:     name,
-		Duration: duration.Seconds(),
-		Properties: []*junitapi.TestSuiteProperty{
-			{
-				Name:  "TestVersion",
-				Value: version.Get().String(),
-			},
-		},
+		Name:       name,
+		Duration:   duration.Seconds(),
+		Properties: append(buildProperties(), ciProperties()...),
 	}
 	for _, test := range tests {
 		switch {
@@ -37,9 +36,10 @@ func generateJUnitTestSuiteResults(
 			s.NumTests++
 			s.NumSkipped++
 			s.TestCases = append(s.TestCases, &junitapi.JUnitTestCase{
-				Name:      test.name,
-				SystemOut: string(test.testOutputBytes),
-				Duration:  test.duration.Seconds(),
+				Name:       test.name,
+				SystemOut:  string(test.testOutputBytes),
+				Duration:   test.duration.Seconds(),
+				Properties: junitPropertiesForTest(test),
 				SkipMessage: &junitapi.SkipMessage{
 					Message: lastLinesUntil(string(test.testOutputBytes), 100, "skip ["),
 				},
@@ -48,9 +48,10 @@ func generateJUnitTestSuiteResults(
 			s.NumTests++
 			s.NumFailed++
 			s.TestCases = append(s.TestCases, &junitapi.JUnitTestCase{
-				Name:      test.name,
-				SystemOut: string(test.testOutputBytes),
-				Duration:  test.duration.Seconds(),
+				Name:       test.name,
+				SystemOut:  string(test.testOutputBytes),
+				Duration:   test.duration.Seconds(),
+				Properties: junitPropertiesForTest(test),
 				FailureOutput: &junitapi.FailureOutput{
 					Output: lastLinesUntil(string(test.testOutputBytes), 100, "fail ["),
 				},
@@ -59,9 +60,10 @@ func generateJUnitTestSuiteResults(
 			s.NumTests++
 			s.NumFailed++
 			s.TestCases = append(s.TestCases, &junitapi.JUnitTestCase{
-				Name:      test.name,
-				SystemOut: string(test.testOutputBytes),
-				Duration:  test.duration.Seconds(),
+				Name:       test.name,
+				SystemOut:  string(test.testOutputBytes),
+				Duration:   test.duration.Seconds(),
+				Properties: junitPropertiesForTest(test),
 				FailureOutput: &junitapi.FailureOutput{
 					Output: lastLinesUntil(string(test.testOutputBytes), 100, "flake:"),
 				},
@@ -70,14 +72,16 @@ func generateJUnitTestSuiteResults(
 			// also add the successful junit result:
 			s.NumTests++
 			s.TestCases = append(s.TestCases, &junitapi.JUnitTestCase{
-				Name:     test.name,
-				Duration: test.duration.Seconds(),
+				Name:       test.name,
+				Duration:   test.duration.Seconds(),
+				Properties: junitPropertiesForTest(test),
 			})
 		case test.success:
 			s.NumTests++
 			s.TestCases = append(s.TestCases, &junitapi.JUnitTestCase{
-				Name:     test.name,
-				Duration: test.duration.Seconds(),
+				Name:       test.name,
+				Duration:   test.duration.Seconds(),
+				Properties: junitPropertiesForTest(test),
 			})
 		}
 	}
@@ -94,6 +98,88 @@ func generateJUnitTestSuiteResults(
 	return s
 }
 
+// buildProperties describes the build of the test binary, including the version control state it was
+// built from, so that results can be traced back to the exact source that produced them.
+func buildProperties() []*junitapi.TestSuiteProperty {
+	info := version.Get()
+	properties := []*junitapi.TestSuiteProperty{
+		{Name: "TestVersion", Value: info.String()},
+	}
+	for _, property := range []struct{ name, value string }{
+		{"GitCommit", info.GitCommit},
+		{"GitTreeState", info.GitTreeState},
+		{"BuildDate", info.BuildDate},
+		{"GoVersion", info.GoVersion},
+		{"Platform", info.Platform},
+	} {
+		if len(property.value) > 0 {
+			properties = append(properties, &junitapi.TestSuiteProperty{Name: property.name, Value: property.value})
+		}
+	}
+
+	// the go toolchain stamps the vcs state into binaries built from a checkout, which is available even
+	// when the version was not injected with -ldflags
+	if buildInfo, ok := debug.ReadBuildInfo(); ok {
+		for _, setting := range buildInfo.Settings {
+			if strings.HasPrefix(setting.Key, "vcs") && len(setting.Value) > 0 {
+				properties = append(properties, &junitapi.TestSuiteProperty{Name: setting.Key, Value: setting.Value})
+			}
+		}
+	}
+	return properties
+}
+
+// junitPropertiesForTest describes the labels, annotations, owner, and stable identifier of a test
+// so that consumers of the JUnit results do not need to parse them out of the test name.
+func junitPropertiesForTest(test *testCase) []*junitapi.TestCaseProperty {
+	properties := []*junitapi.TestCaseProperty{
+		{Name: "id", Value: test.id()},
+	}
+	if owner := test.owner(); len(owner) > 0 {
+		properties = append(properties, &junitapi.TestCaseProperty{Name: "owner", Value: owner})
+	}
+	for _, label := range test.labels() {
+		properties = append(properties, &junitapi.TestCaseProperty{Name: "label", Value: label})
+	}
+	for _, id := range test.externalIDs() {
+		properties = append(properties, &junitapi.TestCaseProperty{Name: "external-id", Value: id.String()})
+	}
+	if len(test.expectedFailure) > 0 {
+		properties = append(properties, &junitapi.TestCaseProperty{Name: "expected-failure", Value: test.expectedFailure})
+	}
+	if len(test.quarantine) > 0 {
+		properties = append(properties, &junitapi.TestCaseProperty{Name: "quarantine", Value: test.quarantine})
+	}
+	if annotations := strings.TrimSpace(test.annotations); len(annotations) > 0 {
+		properties = append(properties, &junitapi.TestCaseProperty{Name: "annotations", Value: annotations})
+	}
+	for _, key := range sets.StringKeySet(test.metadata).List() {
+		properties = append(properties, &junitapi.TestCaseProperty{Name: "metadata." + key, Value: test.metadata[key]})
+	}
+	if attempt := test.attempt(); attempt > 1 {
+		properties = append(properties, &junitapi.TestCaseProperty{Name: "attempt", Value: strconv.Itoa(attempt)})
+	}
+	if len(test.rerunCommand) > 0 {
+		properties = append(properties, &junitapi.TestCaseProperty{Name: "rerun-command", Value: test.rerunCommand})
+	}
+	if r := test.resources; r != nil {
+		properties = append(properties,
+			&junitapi.TestCaseProperty{Name: "max-rss-bytes", Value: strconv.FormatInt(r.MaxRSSBytes, 10)},
+			&junitapi.TestCaseProperty{Name: "cpu-seconds", Value: strconv.FormatFloat((r.UserCPU + r.SystemCPU).Seconds(), 'f', 2, 64)},
+		)
+	}
+	if fingerprint := failureFingerprint(test); len(fingerprint) > 0 {
+		properties = append(properties, &junitapi.TestCaseProperty{Name: "failure-fingerprint", Value: fingerprint})
+	}
+	if len(test.failureBundle) > 0 {
+		properties = append(properties, &junitapi.TestCaseProperty{Name: "failure-bundle", Value: test.failureBundle})
+	}
+	for _, artifact := range test.artifacts {
+		properties = append(properties, &junitapi.TestCaseProperty{Name: "artifact", Value: artifact})
+	}
+	return properties
+}
+
 func writeJUnitReport(s *junitapi.JUnitTestSuite, filePrefix, fileSuffix, dir string, errOut io.Writer) error {
 	out, err := xml.Marshal(s)
 	if err != nil {
@@ -1,6 +1,9 @@
 package ginkgo
 
-import "testing"
+import (
+	"reflect"
+	"testing"
+)
 
 func Test_lastLines(t *testing.T) {
 	tests := []struct {
@@ -33,3 +36,45 @@ func Test_lastLines(t *testing.T) {
 		})
 	}
 }
+
+func Test_junitPropertiesForTest(t *testing.T) {
+	test := &testCase{
+		name:        "[sig-network] Services should serve endpoints [Serial] [Suite:openshift/conformance/serial]",
+		annotations: " [Suite:openshift/conformance/serial]",
+		metadata:    map[string]string{"component": "kube-proxy"},
+	}
+	got := map[string][]string{}
+	for _, property := range junitPropertiesForTest(test) {
+		got[property.Name] = append(got[property.Name], property.Value)
+	}
+
+	if want := []string{"sig-network", "Serial", "Suite:openshift/conformance/serial"}; !reflect.DeepEqual(got["label"], want) {
+		t.Errorf("label properties = %v, want %v", got["label"], want)
+	}
+	if want := []string{"sig-network"}; !reflect.DeepEqual(got["owner"], want) {
+		t.Errorf("owner properties = %v, want %v", got["owner"], want)
+	}
+	if want := []string{"[Suite:openshift/conformance/serial]"}; !reflect.DeepEqual(got["annotations"], want) {
+		t.Errorf("annotations properties = %v, want %v", got["annotations"], want)
+	}
+	if want := []string{"kube-proxy"}; !reflect.DeepEqual(got["metadata.component"], want) {
+		t.Errorf("metadata properties = %v, want %v", got["metadata.component"], want)
+	}
+
+	unannotated := &testCase{name: "[sig-network] Services should serve endpoints [Serial]"}
+	if test.id() != unannotated.id() {
+		t.Errorf("id %q of annotated test does not match id %q of unannotated test", test.id(), unannotated.id())
+	}
+}
+
+func Test_buildProperties(t *testing.T) {
+	properties := map[string]string{}
+	for _, property := range buildProperties() {
+		properties[property.Name] = property.Value
+	}
+	for _, name := range []string{"TestVersion", "GoVersion", "Platform"} {
+		if len(properties[name]) == 0 {
+			t.Errorf("expected property %s to be set, got %v", name, properties)
+		}
+	}
+}
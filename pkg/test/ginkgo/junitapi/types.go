@@ -77,6 +77,16 @@ type JUnitTestCase struct {
 
 	// SystemErr is output written to stderr during the execution of this test case
 	SystemErr string `xml:"system-err,omitempty"`
+
+	// Properties holds metadata about the test case, such as its labels and owner
+	Properties []*TestCaseProperty `xml:"properties>property,omitempty"`
+}
+
+// TestCaseProperty contains a mapping of a property name to a value for a single test case.
+// Names may repeat, for instance once for every label on the test.
+type TestCaseProperty struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value,attr"`
 }
 
 // SkipMessage holds a message explaining why a test was skipped
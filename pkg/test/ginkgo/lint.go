@@ -0,0 +1,217 @@
+package ginkgo
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"sort"
+	"strings"
+
+	"github.com/onsi/ginkgo/v2/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// maxTestNesting is the deepest container nesting expected of a test. Deeper nesting usually means
+// containers wrap a single child and could be collapsed.
+const maxTestNesting = 12
+
+// topLevelSetupNodeTypes run around every test when they are declared outside of a container.
+var topLevelSetupNodeTypes = types.NodeTypeBeforeEach | types.NodeTypeJustBeforeEach |
+	types.NodeTypeAfterEach | types.NodeTypeJustAfterEach
+
+// lintTests flags problems in the spec tree of the tests: containers and tests with empty text or
+// text with surrounding whitespace, which leave doubled or trailing spaces in the name, containers
+// that only wrap another container, nesting deeper than maxTestNesting, focused or pending nodes,
+// containers and tests without a code location, and setup nodes declared at the top level, which
+// run for every test in the binary rather than for the tests of a container. Names are checked for
+// malformed labels. Problems with a node are reported once at its location, no matter how many
+// tests share it, and problems with a test are reported with its name. Containers are only
+// compared with the tests passed in, so a container whose other children were filtered out may be
+// reported as wrapping a single container.
+//
+// The source files that declare the tests are checked by lintSource for tests with an empty body
+// and containers holding only setup nodes, which have no tests and so are missing from the tree.
+func lintTests(tests []TestInfo) []string {
+	findings := map[string]bool{}
+	testProblem := func(test TestInfo, format string, args ...interface{}) {
+		findings[fmt.Sprintf("%q: %s", test.Name, fmt.Sprintf(format, args...))] = true
+	}
+	nodeProblem := func(node SpecNode, format string, args ...interface{}) {
+		findings[fmt.Sprintf("%s: %s %s", node.Location, nodeKind(node), fmt.Sprintf(format, args...))] = true
+	}
+
+	// the distinct children and the number of setup nodes of every container
+	children := map[uint]map[uint]SpecNode{}
+	setups := map[uint]int{}
+	containers := map[uint]SpecNode{}
+	// the source files that declare the nodes
+	files := map[string]bool{}
+
+	for _, test := range tests {
+		if strings.Contains(test.Name, "[]") {
+			testProblem(test, "name contains an empty label")
+		}
+		if strings.Count(test.Name, "[") != strings.Count(test.Name, "]") {
+			testProblem(test, "name contains unbalanced brackets")
+		}
+
+		var path []SpecNode
+		for _, node := range test.Nodes {
+			if node.Focused {
+				nodeProblem(node, "is focused, which keeps every other test from running")
+			}
+			if node.Pending {
+				nodeProblem(node, "is pending")
+			}
+			if len(node.Location.FileName) > 0 {
+				files[node.Location.FileName] = true
+			}
+			if !node.Type.Is(types.NodeTypesForContainerAndIt) {
+				switch {
+				case node.NestingLevel > 0 && node.NestingLevel <= len(path):
+					setups[path[node.NestingLevel-1].ID]++
+				case node.NestingLevel == 0 && node.Type.Is(topLevelSetupNodeTypes):
+					nodeProblem(node, "is declared at the top level, so it runs for every test in the binary")
+				}
+				continue
+			}
+			if len(node.Location.FileName) == 0 {
+				testProblem(test, "the %s node has no code location", nodeKind(node))
+			}
+			switch {
+			case len(node.Text) == 0:
+				nodeProblem(node, "has no text, which leaves extra spaces in the names of its tests")
+			case strings.TrimSpace(node.Text) != node.Text:
+				nodeProblem(node, "text %q has leading or trailing whitespace", node.Text)
+			}
+			if len(path) > 0 {
+				parent := path[len(path)-1].ID
+				if children[parent] == nil {
+					children[parent] = map[uint]SpecNode{}
+				}
+				children[parent][node.ID] = node
+			}
+			if node.Type.Is(types.NodeTypeContainer) {
+				containers[node.ID] = node
+				path = append(path, node)
+			}
+		}
+		if len(path) > maxTestNesting {
+			testProblem(test, "test is nested %d containers deep", len(path))
+		}
+	}
+
+	for id, container := range containers {
+		if len(children[id]) != 1 || setups[id] > 0 {
+			continue
+		}
+		for _, child := range children[id] {
+			if child.Type.Is(types.NodeTypeContainer) {
+				nodeProblem(container, "only wraps the container at %s and could be merged with it", child.Location)
+			}
+		}
+	}
+
+	for file := range files {
+		for _, finding := range lintSource(file) {
+			findings[finding] = true
+		}
+	}
+
+	sorted := make([]string, 0, len(findings))
+	for finding := range findings {
+		sorted = append(sorted, finding)
+	}
+	sort.Strings(sorted)
+	return sorted
+}
+
+// nodeKind names a node in a finding, with its text when it has one.
+func nodeKind(node SpecNode) string {
+	kind := node.Type.String()
+	if node.Type.Is(types.NodeTypeContainer) {
+		kind = "container"
+	}
+	if len(strings.TrimSpace(node.Text)) > 0 {
+		return fmt.Sprintf("%s %q", kind, strings.TrimSpace(node.Text))
+	}
+	return kind
+}
+
+// the ginkgo functions looked for by lintSource, by the name they are called with
+var (
+	sourceItFuncs = sets.NewString("It", "FIt", "PIt", "XIt", "Specify", "FSpecify", "PSpecify", "XSpecify")
+	// containers are also declared with helpers named after Describe, such as SIGDescribe
+	sourceContainerFuncs = sets.NewString("Context", "FContext", "PContext", "XContext", "When", "FWhen", "PWhen", "XWhen")
+	sourceSetupFuncs     = sets.NewString("BeforeEach", "JustBeforeEach", "AfterEach", "JustAfterEach", "BeforeAll", "AfterAll", "DeferCleanup")
+)
+
+// lintSource flags the tests declared in the Go source file at path with an empty body, and the
+// containers whose body only declares setup nodes, variables and assignments, which register no
+// tests. Containers that call other functions may declare their tests there and are not flagged.
+// A file that cannot be read or parsed, as when the binary runs away from the tree it was built
+// from, is skipped.
+func lintSource(path string) []string {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, path, nil, 0)
+	if err != nil {
+		return nil
+	}
+	var findings []string
+	ast.Inspect(file, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok || len(call.Args) == 0 {
+			return true
+		}
+		body, ok := call.Args[len(call.Args)-1].(*ast.FuncLit)
+		if !ok {
+			return true
+		}
+		name := sourceFuncName(call.Fun)
+		location := fset.Position(call.Pos())
+		switch {
+		case sourceItFuncs.Has(name):
+			if len(body.Body.List) == 0 {
+				findings = append(findings, fmt.Sprintf("%s:%d: %s has an empty body and always passes", location.Filename, location.Line, name))
+			}
+		case sourceContainerFuncs.Has(name) || strings.HasSuffix(name, "Describe"):
+			if onlySetupStatements(body.Body.List) {
+				findings = append(findings, fmt.Sprintf("%s:%d: container only declares setup nodes, which never run without a test", location.Filename, location.Line))
+			}
+		}
+		return true
+	})
+	return findings
+}
+
+// onlySetupStatements returns true if stmts call at least one setup function and otherwise only
+// declare or assign variables.
+func onlySetupStatements(stmts []ast.Stmt) bool {
+	setups := 0
+	for _, stmt := range stmts {
+		switch stmt := stmt.(type) {
+		case *ast.DeclStmt, *ast.AssignStmt:
+		case *ast.ExprStmt:
+			call, ok := stmt.X.(*ast.CallExpr)
+			if !ok || !sourceSetupFuncs.Has(sourceFuncName(call.Fun)) {
+				return false
+			}
+			setups++
+		default:
+			return false
+		}
+	}
+	return setups > 0
+}
+
+// sourceFuncName returns the name of the called function, without the package it is qualified with.
+func sourceFuncName(fun ast.Expr) string {
+	switch fun := fun.(type) {
+	case *ast.Ident:
+		return fun.Name
+	case *ast.SelectorExpr:
+		return fun.Sel.Name
+	}
+	return ""
+}
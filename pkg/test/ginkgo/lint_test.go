@@ -0,0 +1,145 @@
+package ginkgo
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/onsi/ginkgo/v2/types"
+)
+
+func Test_lintTests(t *testing.T) {
+	at := func(line int) types.CodeLocation { return types.CodeLocation{FileName: "a.go", LineNumber: line} }
+	container := func(id uint, text string, level, line int) SpecNode {
+		return SpecNode{ID: id, Type: types.NodeTypeContainer, Text: text, NestingLevel: level, Location: at(line)}
+	}
+	it := func(id uint, text string, level, line int) SpecNode {
+		return SpecNode{ID: id, Type: types.NodeTypeIt, Text: text, NestingLevel: level, Location: at(line)}
+	}
+	outer, wrapper, inner := container(1, "[sig-cli] oc", 0, 1), container(2, "wraps", 1, 2), container(3, "inner ", 2, 3)
+	setup := container(4, "with setup", 1, 20)
+	beforeEach := SpecNode{ID: 5, Type: types.NodeTypeBeforeEach, NestingLevel: 2, Location: at(21)}
+	topLevel := SpecNode{ID: 6, Type: types.NodeTypeAfterEach, Location: at(30)}
+
+	// every level has a setup node, so no level only wraps the next one
+	deep := []SpecNode{}
+	for i := 0; i <= maxTestNesting; i++ {
+		deep = append(deep, container(uint(100+i), "level", i, 100+i))
+		deep = append(deep, SpecNode{ID: uint(300 + i), Type: types.NodeTypeJustBeforeEach, NestingLevel: i + 1, Location: at(300 + i)})
+	}
+	deep = append(deep, it(200, "deep", maxTestNesting+1, 200))
+
+	got := lintTests([]TestInfo{
+		{Name: "[sig-cli] oc wraps inner  first", Nodes: []SpecNode{outer, wrapper, inner, it(10, "first", 3, 10)}},
+		{Name: "[sig-cli] oc wraps inner  second", Nodes: []SpecNode{outer, wrapper, inner, it(11, "second", 3, 11)}},
+		{Name: "[sig-cli] oc with setup only []", Nodes: []SpecNode{outer, setup, beforeEach, it(12, "only []", 2, 22)}},
+		{Name: "[sig-cli] oc with setup [Serial", Nodes: []SpecNode{outer, setup, beforeEach, {ID: 13, Type: types.NodeTypeIt, Text: "[Serial", NestingLevel: 2, Focused: true}}},
+		{Name: "[sig-cli] oc ", Nodes: []SpecNode{outer, it(14, "", 1, 14)}},
+		{Name: "[sig-cli] oc setup everywhere", Nodes: []SpecNode{topLevel, outer, it(15, "setup everywhere", 1, 15)}},
+		{Name: "level level level level level level level level level level level level level deep", Nodes: deep},
+	})
+	want := []string{
+		`"[sig-cli] oc with setup [Serial": name contains unbalanced brackets`,
+		`"[sig-cli] oc with setup [Serial": the It "[Serial" node has no code location`,
+		`"[sig-cli] oc with setup only []": name contains an empty label`,
+		`"level level level level level level level level level level level level level deep": test is nested 13 containers deep`,
+		`:0: It "[Serial" is focused, which keeps every other test from running`,
+		`a.go:14: It has no text, which leaves extra spaces in the names of its tests`,
+		`a.go:2: container "wraps" only wraps the container at a.go:3 and could be merged with it`,
+		`a.go:30: AfterEach is declared at the top level, so it runs for every test in the binary`,
+		`a.go:3: container "inner" text "inner " has leading or trailing whitespace`,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("lintTests() =\n%q\nwant\n%q", got, want)
+	}
+}
+
+func Test_lintSource(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "a_test.go")
+	if err := ioutil.WriteFile(path, []byte(`package a
+
+var _ = g.Describe("[sig-cli] oc", func() {
+	oc := exutil.NewCLI("oc")
+
+	g.It("is empty", func() {
+		// TODO
+	})
+	g.It("runs", func() { oc.Run("get") })
+
+	g.Context("only setup", func() {
+		var name string
+		g.BeforeEach(func() { name = "a" })
+		g.AfterEach(func() {})
+	})
+	g.Context("calls a helper", func() {
+		g.BeforeEach(func() {})
+		describeHelper(oc)
+	})
+})
+`), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{
+		path + ":6: It has an empty body and always passes",
+		path + ":11: container only declares setup nodes, which never run without a test",
+	}
+	if got := lintSource(path); !reflect.DeepEqual(got, want) {
+		t.Errorf("lintSource() =\n%q\nwant\n%q", got, want)
+	}
+	// the findings are reported for the files declaring the tests
+	got := lintTests([]TestInfo{{Name: "[sig-cli] oc runs", Nodes: []SpecNode{
+		{ID: 1, Type: types.NodeTypeContainer, Text: "[sig-cli] oc", Location: types.CodeLocation{FileName: path, LineNumber: 3}},
+		{ID: 2, Type: types.NodeTypeIt, Text: "runs", NestingLevel: 1, Location: types.CodeLocation{FileName: path, LineNumber: 9}},
+	}}})
+	if want := []string{want[1], want[0]}; !reflect.DeepEqual(got, want) {
+		t.Errorf("lintTests() =\n%q\nwant\n%q", got, want)
+	}
+	if got := lintSource(filepath.Join(t.TempDir(), "missing.go")); got != nil {
+		t.Errorf("expected a missing file to be skipped, got %q", got)
+	}
+}
+
+// fakeTreeSpec has the shape of a ginkgo spec, whose nodes are read by specNodes.
+type fakeTreeSpec struct {
+	Nodes []fakeTreeNode
+}
+
+type fakeTreeNode struct {
+	ID           uint
+	NodeType     types.NodeType
+	Text         string
+	CodeLocation types.CodeLocation
+	NestingLevel int
+	MarkedFocus  bool
+	Labels       fakeLabels
+}
+
+type fakeLabels []string
+
+func (s fakeTreeSpec) CodeLocations() []types.CodeLocation { return nil }
+func (s fakeTreeSpec) Text() string                        { return "" }
+func (s fakeTreeSpec) AppendText(text string)              { s.Nodes[len(s.Nodes)-1].Text += text }
+
+func Test_testCaseNodes(t *testing.T) {
+	spec := fakeTreeSpec{Nodes: []fakeTreeNode{
+		{ID: 1, NodeType: types.NodeTypeContainer, Text: "[sig-cli] oc", CodeLocation: types.CodeLocation{FileName: "a.go", LineNumber: 1}, Labels: fakeLabels{"slow"}},
+		{ID: 2, NodeType: types.NodeTypeIt, Text: "runs", NestingLevel: 1, MarkedFocus: true},
+		{ID: 3, NodeType: types.NodeTypeAfterEach, NestingLevel: 1},
+	}}
+	spec.AppendText(" [Suite:openshift/conformance/parallel]")
+	test := &testCase{spec: spec, annotations: " [Suite:openshift/conformance/parallel]"}
+
+	want := []SpecNode{
+		{ID: 1, Type: types.NodeTypeContainer, Text: "[sig-cli] oc", Location: types.CodeLocation{FileName: "a.go", LineNumber: 1}, Labels: []string{"slow"}},
+		{ID: 2, Type: types.NodeTypeIt, Text: "runs", NestingLevel: 1, Focused: true},
+		{ID: 3, Type: types.NodeTypeAfterEach, NestingLevel: 1},
+	}
+	if got := test.nodes(); !reflect.DeepEqual(got, want) {
+		t.Errorf("nodes() =\n%#v\nwant\n%#v", got, want)
+	}
+	if got := (&testCase{spec: fakeSpec("a")}).nodes(); got != nil {
+		t.Errorf("expected a spec without nodes to have none, got %#v", got)
+	}
+}
@@ -0,0 +1,127 @@
+package ginkgo
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// maxRecentFailures is the number of failed tests the live status keeps on screen.
+const maxRecentFailures = 5
+
+// liveStatus redraws a summary of a running suite in a terminal: one line per running test with its
+// elapsed time, counters of finished tests, and the most recent failures. It is easier to follow than
+// the interleaved output of parallel tests, which can be sent elsewhere while it is shown.
+// All methods are safe to call on a nil liveStatus.
+type liveStatus struct {
+	lock sync.Mutex
+	out  io.Writer
+	now  func() time.Time
+
+	running        map[string]time.Time
+	counts         map[TestState]int
+	recentFailures []string
+	// drawn is the number of lines written by the last redraw, which are cleared by the next
+	drawn int
+}
+
+func newLiveStatus(out io.Writer) *liveStatus {
+	return &liveStatus{
+		out:     out,
+		now:     time.Now,
+		running: map[string]time.Time{},
+		counts:  map[TestState]int{},
+	}
+}
+
+func (s *liveStatus) TestStarted(name string) {
+	if s == nil {
+		return
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.running[name] = s.now()
+}
+
+func (s *liveStatus) TestEnded(testRunResult *testRunResultHandle) {
+	if s == nil || testRunResult.testRunResult == nil {
+		return
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	delete(s.running, testRunResult.name)
+	s.counts[testRunResult.testState]++
+	if isTestFailed(testRunResult.testState) {
+		s.recentFailures = append(s.recentFailures, testRunResult.name)
+		if len(s.recentFailures) > maxRecentFailures {
+			s.recentFailures = s.recentFailures[1:]
+		}
+	}
+}
+
+// Run redraws the status every interval until ctx is done, then draws it a final time.
+func (s *liveStatus) Run(ctx context.Context, interval time.Duration) {
+	if s == nil {
+		return
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		s.redraw()
+		select {
+		case <-ctx.Done():
+			s.redraw()
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *liveStatus) redraw() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	buf := &bytes.Buffer{}
+	if s.drawn > 0 {
+		// move to the start of the previous status and clear it
+		fmt.Fprintf(buf, "\x1b[%dA\x1b[J", s.drawn)
+	}
+	status := s.render()
+	buf.WriteString(status)
+	s.drawn = strings.Count(status, "\n")
+	s.out.Write(buf.Bytes())
+}
+
+// render returns the status as text. The caller must hold the lock.
+func (s *liveStatus) render() string {
+	buf := &bytes.Buffer{}
+	fmt.Fprintf(buf, "passed: %d  failed: %d  flaked: %d  skipped: %d  running: %d\n",
+		s.counts[TestSucceeded]+s.counts[TestFailedAsExpected], s.counts[TestFailed]+s.counts[TestFailedTimeout]+s.counts[TestUnexpectedPass]+s.counts[TestUnknown], s.counts[TestFlaked], s.counts[TestSkipped], len(s.running))
+
+	names := make([]string, 0, len(s.running))
+	for name := range s.running {
+		names = append(names, name)
+	}
+	// longest running first, since those are the tests most likely to be stuck
+	sort.Slice(names, func(i, j int) bool {
+		if !s.running[names[i]].Equal(s.running[names[j]]) {
+			return s.running[names[i]].Before(s.running[names[j]])
+		}
+		return names[i] < names[j]
+	})
+	now := s.now()
+	for _, name := range names {
+		fmt.Fprintf(buf, "  %8s %s\n", now.Sub(s.running[name]).Round(time.Second), name)
+	}
+	if len(s.recentFailures) > 0 {
+		fmt.Fprintf(buf, "recent failures:\n")
+		for _, name := range s.recentFailures {
+			fmt.Fprintf(buf, "  %s\n", name)
+		}
+	}
+	return buf.String()
+}
@@ -0,0 +1,42 @@
+package ginkgo
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+)
+
+func Test_liveStatus(t *testing.T) {
+	now := time.Date(2023, 5, 1, 10, 0, 0, 0, time.UTC)
+	out := &bytes.Buffer{}
+	s := newLiveStatus(out)
+	s.now = func() time.Time { return now }
+
+	s.TestStarted("a")
+	now = now.Add(10 * time.Second)
+	s.TestStarted("b")
+	s.TestStarted("c")
+	now = now.Add(5 * time.Second)
+	s.TestEnded(&testRunResultHandle{testRunResult: &testRunResult{name: "c", testState: TestFailed}})
+	s.TestEnded(&testRunResultHandle{testRunResult: &testRunResult{name: "d", testState: TestSucceeded}})
+
+	want := "passed: 1  failed: 1  flaked: 0  skipped: 0  running: 2\n" +
+		"       15s a\n" +
+		"        5s b\n" +
+		"recent failures:\n" +
+		"  c\n"
+	if got := s.render(); got != want {
+		t.Errorf("render() = %q, want %q", got, want)
+	}
+
+	s.redraw()
+	s.redraw()
+	if strings.Count(out.String(), want) != 2 || !strings.Contains(out.String(), "\x1b[5A\x1b[J") {
+		t.Errorf("expected the second redraw to replace the first, got %q", out.String())
+	}
+
+	var nilStatus *liveStatus
+	nilStatus.TestStarted("a")
+	nilStatus.TestEnded(&testRunResultHandle{})
+}
@@ -0,0 +1,301 @@
+package ginkgo
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/openshift/origin/pkg/monitor"
+	"github.com/openshift/origin/pkg/monitor/monitorapi"
+	monitorserialization "github.com/openshift/origin/pkg/monitor/serialization"
+	"github.com/openshift/origin/pkg/test/ginkgo/junitapi"
+)
+
+// MergeArtifactsOptions merges the artifact directories of several runs of a suite, such as the shards of a
+// job or its retries, into a single set of JUnit reports, intervals, and disruption summaries.
+type MergeArtifactsOptions struct {
+	// OutputDir receives the merged artifacts, and is created if it does not exist
+	OutputDir string
+
+	Out, ErrOut io.Writer
+}
+
+// junitReportSuffix matches the time suffix the runner adds to the name of its JUnit reports.
+var junitReportSuffix = regexp.MustCompile(`_\d{8}-\d{6}\.xml$`)
+
+// Run merges the artifacts of dirs into OutputDir.
+func (opt *MergeArtifactsOptions) Run(dirs []string) error {
+	if len(dirs) == 0 {
+		return fmt.Errorf("at least one artifact directory must be specified")
+	}
+	if len(opt.OutputDir) == 0 {
+		return fmt.Errorf("an output directory must be specified")
+	}
+	if err := os.MkdirAll(opt.OutputDir, 0755); err != nil {
+		return fmt.Errorf("could not create the output directory: %v", err)
+	}
+	timeSuffix := time.Now().UTC().Format("20060102-150405")
+
+	reports, err := readJUnitReports(dirs, opt.ErrOut)
+	if err != nil {
+		return err
+	}
+	prefixes := make([]string, 0, len(reports))
+	for prefix := range reports {
+		prefixes = append(prefixes, prefix)
+	}
+	sort.Strings(prefixes)
+	for _, prefix := range prefixes {
+		for _, warning := range missingShards(reports[prefix]) {
+			fmt.Fprintf(opt.ErrOut, "warning: %s: %s\n", prefix, warning)
+		}
+		merged := mergeJUnitSuites(reports[prefix])
+		if err := writeJUnitReport(merged, prefix, timeSuffix, opt.OutputDir, opt.ErrOut); err != nil {
+			return err
+		}
+		fmt.Fprintf(opt.Out, "%s: merged %d reports, %d tests, %d failed, %d skipped\n", prefix, len(reports[prefix]), merged.NumTests, merged.NumFailed, merged.NumSkipped)
+	}
+
+	intervals, err := mergeIntervals(dirs)
+	if err != nil {
+		return err
+	}
+	if len(intervals) > 0 {
+		if err := monitorserialization.EventsToFile(filepath.Join(opt.OutputDir, fmt.Sprintf("e2e-events_%s.json", timeSuffix)), intervals); err != nil {
+			return err
+		}
+		fmt.Fprintf(opt.Out, "e2e-events: merged %d intervals\n", len(intervals))
+	}
+
+	disruption, err := mergeBackendDisruption(dirs)
+	if err != nil {
+		return err
+	}
+	if disruption != nil {
+		data, err := json.MarshalIndent(disruption, "", "    ")
+		if err != nil {
+			return err
+		}
+		if err := ioutil.WriteFile(filepath.Join(opt.OutputDir, fmt.Sprintf("backend-disruption_%s.json", timeSuffix)), data, 0644); err != nil {
+			return err
+		}
+		fmt.Fprintf(opt.Out, "backend-disruption: merged %d backends\n", len(disruption.BackendDisruptions))
+	}
+	return nil
+}
+
+// readJUnitReports returns the JUnit reports of dirs by the name they are written under without their time
+// suffix, such as junit_e2e. Reports holding several suites, such as the aggregate of run-suites, are
+// skipped.
+func readJUnitReports(dirs []string, errOut io.Writer) (map[string][]*junitapi.JUnitTestSuite, error) {
+	reports := map[string][]*junitapi.JUnitTestSuite{}
+	for _, dir := range dirs {
+		paths, err := filepath.Glob(filepath.Join(dir, "junit_*.xml"))
+		if err != nil {
+			return nil, err
+		}
+		sort.Strings(paths)
+		for _, path := range paths {
+			data, err := ioutil.ReadFile(path)
+			if err != nil {
+				return nil, err
+			}
+			suite := &junitapi.JUnitTestSuite{}
+			if err := xml.Unmarshal(data, suite); err != nil {
+				fmt.Fprintf(errOut, "warning: Skipping %s: %v\n", path, err)
+				continue
+			}
+			prefix := strings.TrimSuffix(junitReportSuffix.ReplaceAllString(filepath.Base(path), ""), ".xml")
+			reports[prefix] = append(reports[prefix], suite)
+		}
+	}
+	return reports, nil
+}
+
+// mergeJUnitSuites merges reports of the same suite, keeping the best result of every test: a pass over a
+// failure over a skip. A test that both failed and passed is reported as a flake, with a failing and a
+// passing test case, the way the runner reports a test that passed on retry.
+func mergeJUnitSuites(suites []*junitapi.JUnitTestSuite) *junitapi.JUnitTestSuite {
+	merged := &junitapi.JUnitTestSuite{Name: suites[0].Name}
+	for _, property := range suites[0].Properties {
+		if property.Name == "shard.index" || property.Name == "shard.count" {
+			continue
+		}
+		merged.Properties = append(merged.Properties, property)
+	}
+
+	type results struct {
+		passed, failed, skipped *junitapi.JUnitTestCase
+	}
+	var names []string
+	byName := map[string]*results{}
+	for _, suite := range suites {
+		merged.Duration += suite.Duration
+		for _, testCase := range suite.TestCases {
+			r, ok := byName[testCase.Name]
+			if !ok {
+				r = &results{}
+				byName[testCase.Name] = r
+				names = append(names, testCase.Name)
+			}
+			switch {
+			case testCase.SkipMessage != nil:
+				if r.skipped == nil {
+					r.skipped = testCase
+				}
+			case testCase.FailureOutput != nil:
+				if r.failed == nil {
+					r.failed = testCase
+				}
+			default:
+				if r.passed == nil {
+					r.passed = testCase
+				}
+			}
+		}
+	}
+
+	for _, name := range names {
+		r := byName[name]
+		switch {
+		case r.passed != nil:
+			if r.failed != nil {
+				merged.NumTests++
+				merged.NumFailed++
+				merged.TestCases = append(merged.TestCases, r.failed)
+			}
+			merged.NumTests++
+			merged.TestCases = append(merged.TestCases, r.passed)
+		case r.failed != nil:
+			merged.NumTests++
+			merged.NumFailed++
+			merged.TestCases = append(merged.TestCases, r.failed)
+		default:
+			merged.NumTests++
+			merged.NumSkipped++
+			merged.TestCases = append(merged.TestCases, r.skipped)
+		}
+	}
+	return merged
+}
+
+// missingShards describes the shards absent from reports of a sharded suite, using the shard properties
+// the runner records in its reports.
+func missingShards(suites []*junitapi.JUnitTestSuite) []string {
+	count := 0
+	found := map[int]bool{}
+	for _, suite := range suites {
+		index, suiteCount := -1, 0
+		for _, property := range suite.Properties {
+			switch property.Name {
+			case "shard.index":
+				if i, err := strconv.Atoi(property.Value); err == nil {
+					index = i
+				}
+			case "shard.count":
+				if c, err := strconv.Atoi(property.Value); err == nil {
+					suiteCount = c
+				}
+			}
+		}
+		if suiteCount == 0 || index < 0 {
+			continue
+		}
+		if count != 0 && count != suiteCount {
+			return []string{fmt.Sprintf("the reports were split into different numbers of shards, %d and %d", count, suiteCount)}
+		}
+		count = suiteCount
+		found[index] = true
+	}
+	var missing []string
+	for i := 0; i < count; i++ {
+		if !found[i] {
+			missing = append(missing, fmt.Sprintf("shard %d of %d is missing", i, count))
+		}
+	}
+	return missing
+}
+
+// mergeIntervals returns the intervals of every e2e-events file in dirs in order, without the intervals
+// recorded in more than one file.
+func mergeIntervals(dirs []string) (monitorapi.Intervals, error) {
+	var merged monitorapi.Intervals
+	seen := map[string]bool{}
+	for _, dir := range dirs {
+		paths, err := filepath.Glob(filepath.Join(dir, "e2e-events_*.json"))
+		if err != nil {
+			return nil, err
+		}
+		sort.Strings(paths)
+		for _, path := range paths {
+			intervals, err := monitorserialization.EventsFromFile(path)
+			if err != nil {
+				return nil, fmt.Errorf("unable to read intervals from %s: %v", path, err)
+			}
+			for _, interval := range intervals {
+				key := fmt.Sprintf("%d/%d/%d/%s/%s", interval.From.UnixNano(), interval.To.UnixNano(), interval.Level, interval.Locator, interval.Message)
+				if seen[key] {
+					continue
+				}
+				seen[key] = true
+				merged = append(merged, interval)
+			}
+		}
+	}
+	sort.Stable(merged)
+	return merged, nil
+}
+
+// mergeBackendDisruption returns the longest disruption of every backend in the backend-disruption files of
+// dirs, or nil if there are none. Shards that run at the same time against one cluster observe the same
+// outages, so adding up their disruption would count an outage once for every shard.
+func mergeBackendDisruption(dirs []string) (*monitor.BackendDisruptionList, error) {
+	var merged *monitor.BackendDisruptionList
+	for _, dir := range dirs {
+		paths, err := filepath.Glob(filepath.Join(dir, "backend-disruption_*.json"))
+		if err != nil {
+			return nil, err
+		}
+		sort.Strings(paths)
+		for _, path := range paths {
+			data, err := ioutil.ReadFile(path)
+			if err != nil {
+				return nil, err
+			}
+			list := &monitor.BackendDisruptionList{}
+			if err := json.Unmarshal(data, list); err != nil {
+				return nil, fmt.Errorf("unable to parse %s: %v", path, err)
+			}
+			if merged == nil {
+				merged = &monitor.BackendDisruptionList{BackendDisruptions: map[string]*monitor.BackendDisruption{}}
+			}
+			for name, disruption := range list.BackendDisruptions {
+				existing, ok := merged.BackendDisruptions[name]
+				if !ok {
+					merged.BackendDisruptions[name] = disruption
+					continue
+				}
+				if disruption.DisruptedDuration.Duration > existing.DisruptedDuration.Duration {
+					existing.DisruptedDuration = disruption.DisruptedDuration
+				}
+				for _, message := range disruption.DisruptionMessages {
+					if !sets.NewString(existing.DisruptionMessages...).Has(message) {
+						existing.DisruptionMessages = append(existing.DisruptionMessages, message)
+					}
+				}
+			}
+		}
+	}
+	return merged, nil
+}
@@ -0,0 +1,157 @@
+package ginkgo
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/openshift/origin/pkg/monitor"
+	"github.com/openshift/origin/pkg/test/ginkgo/junitapi"
+)
+
+func TestMergeJUnitSuites(t *testing.T) {
+	passed := func(name string) *junitapi.JUnitTestCase { return &junitapi.JUnitTestCase{Name: name} }
+	failed := func(name string) *junitapi.JUnitTestCase {
+		return &junitapi.JUnitTestCase{Name: name, FailureOutput: &junitapi.FailureOutput{Output: "fail"}}
+	}
+	skipped := func(name string) *junitapi.JUnitTestCase {
+		return &junitapi.JUnitTestCase{Name: name, SkipMessage: &junitapi.SkipMessage{Message: "skip"}}
+	}
+	first := &junitapi.JUnitTestSuite{
+		Name:       "openshift-tests",
+		Duration:   10,
+		Properties: []*junitapi.TestSuiteProperty{{Name: "build.version", Value: "v1"}, {Name: "shard.index", Value: "0"}},
+		TestCases:  []*junitapi.JUnitTestCase{passed("a"), failed("b"), skipped("c"), failed("d")},
+	}
+	second := &junitapi.JUnitTestSuite{
+		Name:      "openshift-tests",
+		Duration:  5,
+		TestCases: []*junitapi.JUnitTestCase{failed("a"), passed("b"), passed("c"), failed("d"), skipped("e")},
+	}
+
+	merged := mergeJUnitSuites([]*junitapi.JUnitTestSuite{first, second})
+	var results []string
+	for _, testCase := range merged.TestCases {
+		result := "pass"
+		switch {
+		case testCase.SkipMessage != nil:
+			result = "skip"
+		case testCase.FailureOutput != nil:
+			result = "fail"
+		}
+		results = append(results, testCase.Name+"="+result)
+	}
+	expected := []string{"a=fail", "a=pass", "b=fail", "b=pass", "c=pass", "d=fail", "e=skip"}
+	if !reflect.DeepEqual(results, expected) {
+		t.Errorf("expected %v, got %v", expected, results)
+	}
+	if merged.NumTests != 7 || merged.NumFailed != 3 || merged.NumSkipped != 1 || merged.Duration != 15 {
+		t.Errorf("unexpected counts %d tests, %d failed, %d skipped, %v seconds", merged.NumTests, merged.NumFailed, merged.NumSkipped, merged.Duration)
+	}
+	if len(merged.Properties) != 1 || merged.Properties[0].Name != "build.version" {
+		t.Errorf("expected the shard properties to be dropped, got %v", merged.Properties)
+	}
+}
+
+func TestMissingShards(t *testing.T) {
+	shard := func(index, count string) *junitapi.JUnitTestSuite {
+		return &junitapi.JUnitTestSuite{Properties: []*junitapi.TestSuiteProperty{{Name: "shard.index", Value: index}, {Name: "shard.count", Value: count}}}
+	}
+	tests := []struct {
+		name     string
+		suites   []*junitapi.JUnitTestSuite
+		expected []string
+	}{
+		{
+			name:   "not sharded",
+			suites: []*junitapi.JUnitTestSuite{{}, {}},
+		},
+		{
+			name:   "complete",
+			suites: []*junitapi.JUnitTestSuite{shard("1", "2"), shard("0", "2"), shard("0", "2")},
+		},
+		{
+			name:     "missing",
+			suites:   []*junitapi.JUnitTestSuite{shard("1", "3")},
+			expected: []string{"shard 0 of 3 is missing", "shard 2 of 3 is missing"},
+		},
+		{
+			name:     "different counts",
+			suites:   []*junitapi.JUnitTestSuite{shard("0", "2"), shard("1", "3")},
+			expected: []string{"the reports were split into different numbers of shards, 2 and 3"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := missingShards(tt.suites); !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestMergeArtifactsOptions_Run(t *testing.T) {
+	write := func(dir, name, content string) {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	first, second := t.TempDir(), t.TempDir()
+	write(first, "junit_e2e_20230101-000000.xml", `<testsuite name="openshift-tests" tests="1" failures="1"><testcase name="a"><failure>boom</failure></testcase></testsuite>`)
+	write(second, "junit_e2e_20230101-010000.xml", `<testsuite name="openshift-tests" tests="1"><testcase name="a"></testcase></testsuite>`)
+	write(second, "junit_aggregate_20230101-010000.xml", `<testsuites><testsuite name="x"></testsuite></testsuites>`)
+	write(first, "backend-disruption_20230101-000000.json", `{"BackendDisruptions":{"kube-api-new-connections":{"Name":"kube-api-new-connections","DisruptedDuration":"2s","DisruptionMessages":["first"]}}}`)
+	write(second, "backend-disruption_20230101-010000.json", `{"BackendDisruptions":{"kube-api-new-connections":{"Name":"kube-api-new-connections","DisruptedDuration":"3s","DisruptionMessages":["first","second"]}}}`)
+
+	outputDir := filepath.Join(t.TempDir(), "merged")
+	out, errOut := &bytes.Buffer{}, &bytes.Buffer{}
+	opt := &MergeArtifactsOptions{OutputDir: outputDir, Out: out, ErrOut: errOut}
+	if err := opt.Run([]string{first, second}); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out.String(), "junit_e2e: merged 2 reports, 2 tests, 1 failed, 0 skipped") {
+		t.Errorf("unexpected output:\n%s", out.String())
+	}
+	if !strings.Contains(errOut.String(), "warning: Skipping "+filepath.Join(second, "junit_aggregate_20230101-010000.xml")) {
+		t.Errorf("expected the aggregate report to be skipped:\n%s", errOut.String())
+	}
+
+	reports, err := filepath.Glob(filepath.Join(outputDir, "junit_e2e_*.xml"))
+	if err != nil || len(reports) != 1 {
+		t.Fatalf("expected one merged report, got %v: %v", reports, err)
+	}
+	data, err := ioutil.ReadFile(reports[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	suite := &junitapi.JUnitTestSuite{}
+	if err := xml.Unmarshal(data, suite); err != nil {
+		t.Fatal(err)
+	}
+	if len(suite.TestCases) != 2 || suite.TestCases[0].FailureOutput == nil || suite.TestCases[1].FailureOutput != nil {
+		t.Errorf("expected a flake, got %s", data)
+	}
+
+	disruptions, err := filepath.Glob(filepath.Join(outputDir, "backend-disruption_*.json"))
+	if err != nil || len(disruptions) != 1 {
+		t.Fatalf("expected one merged disruption summary, got %v: %v", disruptions, err)
+	}
+	data, err = ioutil.ReadFile(disruptions[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	list := &monitor.BackendDisruptionList{}
+	if err := json.Unmarshal(data, list); err != nil {
+		t.Fatal(err)
+	}
+	disruption := list.BackendDisruptions["kube-api-new-connections"]
+	if disruption == nil || disruption.DisruptedDuration.Duration != 3*time.Second || !reflect.DeepEqual(disruption.DisruptionMessages, []string{"first", "second"}) {
+		t.Errorf("unexpected disruption %s", data)
+	}
+}
@@ -0,0 +1,241 @@
+package ginkgo
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"github.com/openshift/origin/pkg/test/ginkgo/junitapi"
+)
+
+// MultiSuiteOptions runs several suites from one invocation. Every suite is run by the run command in
+// its own process with its own pool of parallel tests and its own report directory, so suites stay
+// independent of each other, and a combined report and a single result are produced at the end.
+type MultiSuiteOptions struct {
+	// Binary runs each suite, and defaults to the current binary
+	Binary string
+	// Args are passed to the run command of every suite
+	Args []string
+	// JUnitDir, if set, receives a directory of reports for each suite and the combined report
+	JUnitDir string
+	// Concurrent runs the suites at the same time instead of one after the other
+	Concurrent bool
+
+	Out, ErrOut io.Writer
+}
+
+// suiteRun is the outcome of one suite run by MultiSuiteOptions.
+type suiteRun struct {
+	name     string
+	dir      string
+	duration time.Duration
+	err      error
+	results  *junitapi.JUnitTestSuite
+}
+
+// Run runs the suites and returns an error if any of them failed.
+func (opt *MultiSuiteOptions) Run(ctx context.Context, suites []string) error {
+	if len(suites) == 0 {
+		return fmt.Errorf("at least one suite must be specified")
+	}
+	junitDir := opt.JUnitDir
+	if len(junitDir) == 0 {
+		dir, err := ioutil.TempDir("", "openshift-tests-suites")
+		if err != nil {
+			return err
+		}
+		defer os.RemoveAll(dir)
+		junitDir = dir
+	}
+
+	runs := make([]*suiteRun, 0, len(suites))
+	for _, name := range suites {
+		runs = append(runs, &suiteRun{name: name, dir: filepath.Join(junitDir, suiteDirName(name))})
+	}
+
+	if opt.Concurrent {
+		lock := &sync.Mutex{}
+		var wg sync.WaitGroup
+		for _, run := range runs {
+			wg.Add(1)
+			go func(run *suiteRun) {
+				defer wg.Done()
+				out := newLinePrefixWriter(lock, opt.Out, fmt.Sprintf("[%s] ", run.name))
+				defer out.Flush()
+				opt.runSuite(ctx, run, out)
+			}(run)
+		}
+		wg.Wait()
+	} else {
+		for _, run := range runs {
+			if ctx.Err() != nil {
+				run.err = ctx.Err()
+				continue
+			}
+			fmt.Fprintf(opt.Out, "Running suite %s\n\n", run.name)
+			opt.runSuite(ctx, run, opt.Out)
+		}
+	}
+
+	var failed []string
+	aggregate := &junitapi.JUnitTestSuites{}
+	for _, run := range runs {
+		results, err := readSuiteResults(run.dir)
+		if err != nil {
+			fmt.Fprintf(opt.ErrOut, "error: Unable to read the results of suite %s: %v\n", run.name, err)
+		}
+		if results != nil {
+			results.Name = run.name
+			run.results = results
+			aggregate.Suites = append(aggregate.Suites, results)
+		}
+		if run.err != nil {
+			failed = append(failed, run.name)
+		}
+	}
+	writeMultiSuiteSummary(opt.Out, "Suites", runs)
+
+	if len(opt.JUnitDir) > 0 {
+		out, err := xml.Marshal(aggregate)
+		if err != nil {
+			return err
+		}
+		path := filepath.Join(opt.JUnitDir, fmt.Sprintf("junit_aggregate_%s.xml", time.Now().UTC().Format("20060102-150405")))
+		fmt.Fprintf(opt.ErrOut, "Writing JUnit report to %s\n\n", path)
+		if err := ioutil.WriteFile(path, out, 0640); err != nil {
+			return err
+		}
+	}
+
+	if len(failed) > 0 {
+		return fmt.Errorf("%d of %d suites failed: %s", len(failed), len(runs), strings.Join(failed, ", "))
+	}
+	return ctx.Err()
+}
+
+// runSuite runs one suite to completion. When ctx is cancelled the suite is interrupted so it still
+// reports the tests that completed.
+func (opt *MultiSuiteOptions) runSuite(ctx context.Context, run *suiteRun, out io.Writer) {
+	start := time.Now()
+	defer func() { run.duration = time.Since(start).Round(time.Second) }()
+
+	if err := os.MkdirAll(run.dir, 0755); err != nil {
+		run.err = err
+		return
+	}
+	runSuiteProcess(ctx, opt.Binary, append([]string{"run", run.name, "--junit-dir", run.dir}, opt.Args...), nil, run, out)
+}
+
+// runSuiteProcess runs binary, which defaults to the current binary, with args and with env added to
+// the environment of this process, and records its result in run.
+func runSuiteProcess(ctx context.Context, binary string, args, env []string, run *suiteRun, out io.Writer) {
+	if len(binary) == 0 {
+		binary = os.Args[0]
+	}
+	cmd := exec.Command(binary, args...)
+	if len(env) > 0 {
+		cmd.Env = append(os.Environ(), env...)
+	}
+	cmd.Stdout, cmd.Stderr = out, out
+	if err := cmd.Start(); err != nil {
+		run.err = err
+		return
+	}
+	done := make(chan error, 1)
+	go func() { done <- cmd.Wait() }()
+	select {
+	case run.err = <-done:
+	case <-ctx.Done():
+		cmd.Process.Signal(os.Interrupt)
+		run.err = <-done
+	}
+}
+
+// readSuiteResults returns the most recent e2e JUnit report written to dir, or nil if there is none.
+func readSuiteResults(dir string) (*junitapi.JUnitTestSuite, error) {
+	matches, err := filepath.Glob(filepath.Join(dir, "junit_e2e_*.xml"))
+	if err != nil || len(matches) == 0 {
+		return nil, err
+	}
+	sort.Strings(matches)
+	data, err := ioutil.ReadFile(matches[len(matches)-1])
+	if err != nil {
+		return nil, err
+	}
+	results := &junitapi.JUnitTestSuite{}
+	if err := xml.Unmarshal(data, results); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+func writeMultiSuiteSummary(out io.Writer, title string, runs []*suiteRun) {
+	fmt.Fprintf(out, "\n%s:\n\n", title)
+	w := tabwriter.NewWriter(out, 0, 4, 2, ' ', 0)
+	for _, run := range runs {
+		result := "passed"
+		if run.err != nil {
+			result = "failed"
+		}
+		counts := "no results"
+		if r := run.results; r != nil {
+			counts = fmt.Sprintf("%d pass, %d fail, %d skip", r.NumTests-r.NumFailed-r.NumSkipped, r.NumFailed, r.NumSkipped)
+		}
+		fmt.Fprintf(w, "  %s\t%s\t%s\t(%s)\n", run.name, result, counts, run.duration)
+	}
+	w.Flush()
+	fmt.Fprintln(out)
+}
+
+// suiteDirName converts a suite name such as openshift/conformance/parallel into a directory name.
+func suiteDirName(name string) string {
+	return strings.NewReplacer("/", "_", " ", "_").Replace(name)
+}
+
+// linePrefixWriter prefixes every line written to it, and writes only whole lines while holding a
+// lock shared with other writers so the output of concurrent suites does not interleave mid-line.
+type linePrefixWriter struct {
+	lock   *sync.Mutex
+	out    io.Writer
+	prefix string
+	buf    bytes.Buffer
+}
+
+func newLinePrefixWriter(lock *sync.Mutex, out io.Writer, prefix string) *linePrefixWriter {
+	return &linePrefixWriter{lock: lock, out: out, prefix: prefix}
+}
+
+func (w *linePrefixWriter) Write(p []byte) (int, error) {
+	w.buf.Write(p)
+	for {
+		i := bytes.IndexByte(w.buf.Bytes(), '\n')
+		if i < 0 {
+			return len(p), nil
+		}
+		w.writeLine(w.buf.Next(i + 1))
+	}
+}
+
+// Flush writes any partial line that remains.
+func (w *linePrefixWriter) Flush() {
+	if w.buf.Len() > 0 {
+		w.writeLine(append(w.buf.Next(w.buf.Len()), '\n'))
+	}
+}
+
+func (w *linePrefixWriter) writeLine(line []byte) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	fmt.Fprintf(w.out, "%s%s", w.prefix, line)
+}
@@ -0,0 +1,62 @@
+package ginkgo
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestMultiSuiteOptions_Run(t *testing.T) {
+	dir := t.TempDir()
+	binary := filepath.Join(dir, "openshift-tests")
+	// the fake run command reports one failure for suite b and fails
+	script := `#!/bin/sh
+suite=$2 dir=$4 failures=0
+[ "$suite" = "b" ] && failures=1
+echo "running $suite with $5"
+printf '<testsuite name="openshift-tests" tests="3" failures="%s" skipped="1"></testsuite>' $failures > $dir/junit_e2e_20230101-000000.xml
+[ $failures = 0 ]
+`
+	if err := ioutil.WriteFile(binary, []byte(script), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, concurrent := range []bool{false, true} {
+		junitDir := t.TempDir()
+		out := &bytes.Buffer{}
+		opt := &MultiSuiteOptions{Binary: binary, Args: []string{"--parallelism=5"}, JUnitDir: junitDir, Concurrent: concurrent, Out: out, ErrOut: ioutil.Discard}
+		err := opt.Run(context.TODO(), []string{"openshift/a", "b"})
+		if err == nil || err.Error() != "1 of 2 suites failed: b" {
+			t.Errorf("concurrent=%t: unexpected error %v", concurrent, err)
+		}
+		if concurrent && !strings.Contains(out.String(), "[openshift/a] running openshift/a with --parallelism=5\n") {
+			t.Errorf("concurrent=%t: expected prefixed suite output, got:\n%s", concurrent, out.String())
+		}
+		if !strings.Contains(out.String(), "openshift/a  passed  2 pass, 0 fail, 1 skip") || !strings.Contains(out.String(), "b            failed  1 pass, 1 fail, 1 skip") {
+			t.Errorf("concurrent=%t: unexpected summary:\n%s", concurrent, out.String())
+		}
+		matches, _ := filepath.Glob(filepath.Join(junitDir, "junit_aggregate_*.xml"))
+		if len(matches) != 1 {
+			t.Fatalf("concurrent=%t: expected an aggregate report, got %v", concurrent, matches)
+		}
+		data, _ := ioutil.ReadFile(matches[0])
+		if !strings.Contains(string(data), `<testsuite name="openshift/a" tests="3" skipped="1" failures="0"`) || !strings.Contains(string(data), `<testsuite name="b" tests="3" skipped="1" failures="1"`) {
+			t.Errorf("concurrent=%t: unexpected aggregate report %s", concurrent, data)
+		}
+	}
+}
+
+func Test_linePrefixWriter(t *testing.T) {
+	out := &bytes.Buffer{}
+	w := newLinePrefixWriter(&sync.Mutex{}, out, "[a] ")
+	w.Write([]byte("one\ntw"))
+	w.Write([]byte("o\nthree"))
+	w.Flush()
+	if got := out.String(); got != "[a] one\n[a] two\n[a] three\n" {
+		t.Errorf("unexpected output %q", got)
+	}
+}
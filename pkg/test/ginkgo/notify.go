@@ -0,0 +1,216 @@
+package ginkgo
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	neturl "net/url"
+	"strings"
+	"text/template"
+	"time"
+)
+
+// maxNotifiedFailures bounds the number of failing tests listed in a suite notification so
+// chat messages stay readable.
+const maxNotifiedFailures = 20
+
+// notifyTimeout bounds posting a suite notification, which is sent even if the suite was interrupted.
+const notifyTimeout = 30 * time.Second
+
+const (
+	// WebhookFormatSlack posts the suiteNotification, whose text field makes it a Slack incoming
+	// webhook message.
+	WebhookFormatSlack = "slack"
+	// WebhookFormatTeams posts a Microsoft Teams incoming webhook message card.
+	WebhookFormatTeams = "teams"
+)
+
+// suiteNotification is posted to the webhook at the end of a suite run. The text field makes the
+// payload directly usable as a Slack incoming webhook message; the remaining fields are for other
+// consumers. It is also the data of a notification template.
+type suiteNotification struct {
+	Text        string   `json:"text"`
+	Suite       string   `json:"suite"`
+	Pass        int      `json:"pass"`
+	Fail        int      `json:"fail"`
+	Skip        int      `json:"skip"`
+	Duration    string   `json:"duration"`
+	Failing     []string `json:"failing,omitempty"`
+	ArtifactURL string   `json:"artifactURL,omitempty"`
+}
+
+// suiteNotifier posts the summary of a suite to a webhook in the format the webhook expects.
+type suiteNotifier struct {
+	url         string
+	format      string
+	template    *template.Template
+	artifactURL string
+}
+
+// newSuiteNotifier returns a notifier for the webhook at url, or nil if url is empty. templateFile, if
+// set, is a Go text/template that renders the text of the message from a suiteNotification, such as
+// '{{.Suite}} failed {{.Fail}} tests, see {{.ArtifactURL}}'. artifactURL, if set, links the message to
+// the artifacts of the run.
+func newSuiteNotifier(url, format, templateFile, artifactURL string) (*suiteNotifier, error) {
+	if len(url) == 0 {
+		return nil, nil
+	}
+	switch format {
+	case "", WebhookFormatSlack:
+		format = WebhookFormatSlack
+	case WebhookFormatTeams:
+	default:
+		return nil, fmt.Errorf("unknown webhook format %q, must be %s or %s", format, WebhookFormatSlack, WebhookFormatTeams)
+	}
+	notifier := &suiteNotifier{url: url, format: format, artifactURL: artifactURL}
+	if len(templateFile) > 0 {
+		data, err := ioutil.ReadFile(templateFile)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read the webhook template: %v", err)
+		}
+		notifier.template, err = template.New("webhook").Option("missingkey=error").Parse(string(data))
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse the webhook template %s: %v", templateFile, err)
+		}
+	}
+	return notifier, nil
+}
+
+// notify posts the summary of the suite.
+func (n *suiteNotifier) notify(ctx context.Context, suite string, pass, fail, skip int, duration time.Duration, failing []string) error {
+	notification, err := newSuiteNotification(suite, pass, fail, skip, duration, failing, n.artifactURL, n.template)
+	if err != nil {
+		return err
+	}
+	return postSuiteNotification(ctx, n.url, n.format, notification)
+}
+
+// dryRun renders a notification for the suite, so a broken template fails before the run, and
+// describes the webhook it would be posted to.
+func (n *suiteNotifier) dryRun(suite string) (string, error) {
+	if _, err := newSuiteNotification(suite, 0, 0, 0, 0, nil, n.artifactURL, n.template); err != nil {
+		return "", err
+	}
+	u, err := neturl.Parse(n.url)
+	if err != nil {
+		return "", fmt.Errorf("unable to parse the webhook URL: %v", err)
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || len(u.Host) == 0 {
+		return "", fmt.Errorf("the webhook %s must be an http or https URL", u.Redacted())
+	}
+	// incoming webhook URLs hold their secret in the path
+	return fmt.Sprintf("post a %s message to the webhook at %s://%s", n.format, u.Scheme, u.Host), nil
+}
+
+// newSuiteNotification summarizes the suite. The text lists the failing tests and links the
+// artifacts, unless tmpl is set, in which case tmpl renders the text.
+func newSuiteNotification(suite string, pass, fail, skip int, duration time.Duration, failing []string, artifactURL string, tmpl *template.Template) (suiteNotification, error) {
+	notification := suiteNotification{
+		Suite:       suite,
+		Pass:        pass,
+		Fail:        fail,
+		Skip:        skip,
+		Duration:    duration.String(),
+		Failing:     failing,
+		ArtifactURL: artifactURL,
+	}
+	text := &strings.Builder{}
+	if tmpl != nil {
+		if err := tmpl.Execute(text, notification); err != nil {
+			return notification, fmt.Errorf("unable to render the webhook template: %v", err)
+		}
+		notification.Text = text.String()
+		return notification, nil
+	}
+	fmt.Fprintf(text, "%s: %d pass, %d fail, %d skip (%s)", suite, pass, fail, skip, duration)
+	for i, name := range failing {
+		if i == maxNotifiedFailures {
+			fmt.Fprintf(text, "\n... and %d more", len(failing)-maxNotifiedFailures)
+			break
+		}
+		fmt.Fprintf(text, "\n- %s", name)
+	}
+	if len(artifactURL) > 0 {
+		fmt.Fprintf(text, "\nArtifacts: %s", artifactURL)
+	}
+	notification.Text = text.String()
+	return notification, nil
+}
+
+// teamsMessageCard is a Microsoft Teams incoming webhook message.
+type teamsMessageCard struct {
+	Type            string               `json:"@type"`
+	Context         string               `json:"@context"`
+	Summary         string               `json:"summary"`
+	ThemeColor      string               `json:"themeColor"`
+	Title           string               `json:"title"`
+	Text            string               `json:"text"`
+	PotentialAction []teamsOpenURIAction `json:"potentialAction,omitempty"`
+}
+
+type teamsOpenURIAction struct {
+	Type    string           `json:"@type"`
+	Name    string           `json:"name"`
+	Targets []teamsURITarget `json:"targets"`
+}
+
+type teamsURITarget struct {
+	OS  string `json:"os"`
+	URI string `json:"uri"`
+}
+
+func newTeamsMessageCard(notification suiteNotification) teamsMessageCard {
+	title := fmt.Sprintf("%s: %d pass, %d fail, %d skip (%s)", notification.Suite, notification.Pass, notification.Fail, notification.Skip, notification.Duration)
+	color := "2EB886"
+	if notification.Fail > 0 {
+		color = "D00000"
+	}
+	card := teamsMessageCard{
+		Type:       "MessageCard",
+		Context:    "https://schema.org/extensions",
+		Summary:    title,
+		ThemeColor: color,
+		Title:      title,
+		// Teams renders the text as markdown, which only breaks lines between paragraphs
+		Text: strings.ReplaceAll(notification.Text, "\n", "\n\n"),
+	}
+	if len(notification.ArtifactURL) > 0 {
+		card.PotentialAction = []teamsOpenURIAction{{
+			Type:    "OpenUri",
+			Name:    "View artifacts",
+			Targets: []teamsURITarget{{OS: "default", URI: notification.ArtifactURL}},
+		}}
+	}
+	return card
+}
+
+// postSuiteNotification sends the notification to the webhook as JSON in format.
+func postSuiteNotification(ctx context.Context, url, format string, notification suiteNotification) error {
+	var payload interface{} = notification
+	if format == WebhookFormatTeams {
+		payload = newTeamsMessageCard(notification)
+	}
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook returned %s", resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,114 @@
+package ginkgo
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func Test_postSuiteNotification(t *testing.T) {
+	var got suiteNotification
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+			t.Errorf("unable to decode notification: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	notifier, err := newSuiteNotifier(server.URL, "", "", "https://artifacts.example.com/run/1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	failing := []string{"[sig-network] a", "[sig-network] b"}
+	if err := notifier.notify(context.TODO(), "openshift/conformance", 10, 2, 1, time.Minute, failing); err != nil {
+		t.Fatal(err)
+	}
+	if got.Suite != "openshift/conformance" || got.Fail != 2 || len(got.Failing) != 2 || got.ArtifactURL != "https://artifacts.example.com/run/1" {
+		t.Errorf("unexpected notification %#v", got)
+	}
+	if !strings.HasPrefix(got.Text, "openshift/conformance: 10 pass, 2 fail, 1 skip (1m0s)\n- [sig-network] a") {
+		t.Errorf("unexpected notification text %q", got.Text)
+	}
+	if !strings.HasSuffix(got.Text, "\nArtifacts: https://artifacts.example.com/run/1") {
+		t.Errorf("expected the notification text to link the artifacts: %q", got.Text)
+	}
+}
+
+func Test_postSuiteNotification_teams(t *testing.T) {
+	var got teamsMessageCard
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+			t.Errorf("unable to decode notification: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	notifier, err := newSuiteNotifier(server.URL, WebhookFormatTeams, "", "https://artifacts.example.com/run/1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := notifier.notify(context.TODO(), "openshift/conformance", 10, 1, 0, time.Minute, []string{"[sig-network] a"}); err != nil {
+		t.Fatal(err)
+	}
+	if got.Type != "MessageCard" || got.Title != "openshift/conformance: 10 pass, 1 fail, 0 skip (1m0s)" || got.ThemeColor != "D00000" {
+		t.Errorf("unexpected card %#v", got)
+	}
+	if !strings.Contains(got.Text, "\n\n- [sig-network] a") {
+		t.Errorf("unexpected card text %q", got.Text)
+	}
+	if len(got.PotentialAction) != 1 || got.PotentialAction[0].Targets[0].URI != "https://artifacts.example.com/run/1" {
+		t.Errorf("expected the card to link the artifacts: %#v", got.PotentialAction)
+	}
+}
+
+func Test_newSuiteNotification_template(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "webhook.tmpl")
+	if err := ioutil.WriteFile(path, []byte(`{{.Suite}} failed {{.Fail}}{{range .Failing}} {{.}}{{end}}, see {{.ArtifactURL}}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	notifier, err := newSuiteNotifier("http://webhook", "", path, "https://artifacts")
+	if err != nil {
+		t.Fatal(err)
+	}
+	notification, err := newSuiteNotification("e2e", 1, 1, 0, time.Second, []string{"a"}, notifier.artifactURL, notifier.template)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if notification.Text != "e2e failed 1 a, see https://artifacts" {
+		t.Errorf("unexpected notification text %q", notification.Text)
+	}
+
+	if err := ioutil.WriteFile(path, []byte(`{{.Suite`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := newSuiteNotifier("http://webhook", "", path, ""); err == nil {
+		t.Error("expected an invalid template to be rejected")
+	}
+	if _, err := newSuiteNotifier("http://webhook", "email", "", ""); err == nil {
+		t.Error("expected an unknown format to be rejected")
+	}
+	if notifier, err := newSuiteNotifier("", "email", "", ""); notifier != nil || err != nil {
+		t.Errorf("expected no notifier without a webhook, got %v: %v", notifier, err)
+	}
+}
+
+func Test_postSuiteNotification_error(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer server.Close()
+
+	notification, err := newSuiteNotification("suite", 0, 0, 0, 0, nil, "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := postSuiteNotification(context.TODO(), server.URL, WebhookFormatSlack, notification); err == nil {
+		t.Fatal("expected an error")
+	}
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/openshift/origin/pkg/monitor/monitorapi"
 	"github.com/openshift/origin/pkg/monitor/nodedetails"
 	monitorserialization "github.com/openshift/origin/pkg/monitor/serialization"
+	"github.com/openshift/origin/pkg/monitortestframework"
 	"github.com/openshift/origin/pkg/synthetictests/allowedalerts"
 	"github.com/openshift/origin/test/extended/util/disruption/controlplane"
 	"github.com/openshift/origin/test/extended/util/disruption/externalservice"
@@ -53,10 +54,19 @@ type MonitorEventsOptions struct {
 	RunDataWriters []RunDataWriter
 	Out            io.Writer
 	ErrOut         io.Writer
+
+	// IntervalStreamFile, if set, is the file the monitor appends intervals to as they are
+	// recorded, so they can be read back if the run dies before End.
+	IntervalStreamFile string
+	intervalStream     *monitorserialization.IntervalStreamWriter
+
+	// LookBack is how long before Start the intervals read back from the cluster during End, such as
+	// those of node logs, audit logs, and alerts, begin.
+	LookBack time.Duration
 }
 
 func NewMonitorEventsOptions(out io.Writer, errOut io.Writer) *MonitorEventsOptions {
-	return &MonitorEventsOptions{
+	o := &MonitorEventsOptions{
 		Recorders: []monitor.StartEventIntervalRecorderFunc{
 			controlplane.StartAllAPIMonitoring,
 			frontends.StartAllIngressMonitoring,
@@ -80,6 +90,11 @@ func NewMonitorEventsOptions(out io.Writer, errOut io.Writer) *MonitorEventsOpti
 		Out:    out,
 		ErrOut: errOut,
 	}
+	// curated charts for specific investigations, see pkg/monitor/intervalcreation/chartspecs
+	for _, spec := range intervalcreation.ChartSpecPresets() {
+		o.RunDataWriters = append(o.RunDataWriters, intervalcreation.NewChartSpecEventIntervalRenderer(spec))
+	}
+	return o
 }
 
 func (o *MonitorEventsOptions) Start(ctx context.Context, restConfig *rest.Config) (monitor.Recorder, error) {
@@ -90,17 +105,27 @@ func (o *MonitorEventsOptions) Start(ctx context.Context, restConfig *rest.Confi
 	o.startTime = &t
 
 	m, err := monitor.Start(ctx, restConfig,
-		[]monitor.StartEventIntervalRecorderFunc{
+		append([]monitor.StartEventIntervalRecorderFunc{
 			controlplane.StartAllAPIMonitoring,
 			frontends.StartAllIngressMonitoring,
 			externalservice.StartExternalServiceMonitoring,
-		},
+		}, monitortestframework.StartCollectionFuncs()...),
 	)
 	if err != nil {
 		return nil, err
 	}
 	o.monitor = m
 
+	if len(o.IntervalStreamFile) > 0 {
+		stream, err := monitorserialization.CreateIntervalStreamFile(o.IntervalStreamFile)
+		if err != nil {
+			fmt.Fprintf(o.ErrOut, "error: Unable to stream intervals to %s: %v\n", o.IntervalStreamFile, err)
+		} else {
+			o.intervalStream = stream
+			m.SetIntervalStream(stream)
+		}
+	}
+
 	return m, nil
 }
 
@@ -159,9 +184,15 @@ func (o *MonitorEventsOptions) End(ctx context.Context, restConfig *rest.Config,
 	t := time.Now()
 	o.endTime = &t
 	o.recordedResources = o.monitor.CurrentResourceState()
+	if o.intervalStream != nil {
+		o.monitor.SetIntervalStream(nil)
+		if err := o.intervalStream.Close(); err != nil {
+			fmt.Fprintf(o.ErrOut, "error: Unable to close the interval stream: %v\n", err)
+		}
+	}
 
 	var err error
-	fromTime, endTime := *o.startTime, *o.endTime
+	fromTime, endTime := o.startTime.Add(-o.LookBack), *o.endTime
 	events := o.monitor.Intervals(fromTime, endTime)
 
 	markMissedPathologicalEvents(events)
@@ -172,7 +203,7 @@ func (o *MonitorEventsOptions) End(ctx context.Context, restConfig *rest.Config,
 		fmt.Fprintf(o.ErrOut, "InsertIntervalsFromCluster error but continuing processing: %v", err)
 	}
 	// add events from alerts so we can create the intervals
-	alertEventIntervals, err := monitor.FetchEventIntervalsForAllAlerts(ctx, restConfig, *o.startTime)
+	alertEventIntervals, err := monitor.FetchEventIntervalsForAllAlerts(ctx, restConfig, fromTime)
 	if err != nil {
 		fmt.Fprintf(o.ErrOut, "FetchEventIntervalsForAllAlerts error but continuing processing: %v", err)
 	}
@@ -207,18 +238,26 @@ func (o *MonitorEventsOptions) End(ctx context.Context, restConfig *rest.Config,
 			return nil
 		})
 		if len(additionalEvents) > 0 {
-			events = append(events, additionalEvents.Cut(*o.startTime, *o.endTime)...)
+			events = append(events, additionalEvents.Cut(fromTime, endTime)...)
 		}
 	}
 
 	sort.Sort(events)
-	events.Clamp(*o.startTime, *o.endTime)
+	events.Clamp(fromTime, endTime)
 
 	o.recordedEvents = events
 
 	return nil
 }
 
+// Intervals returns the intervals the monitor has collected so far, or nil if it has not started.
+func (o *MonitorEventsOptions) Intervals(from, to time.Time) monitorapi.Intervals {
+	if o.monitor == nil {
+		return nil
+	}
+	return o.monitor.Intervals(from, to)
+}
+
 func (o *MonitorEventsOptions) GetEvents() monitorapi.Intervals {
 	return o.recordedEvents
 }
@@ -0,0 +1,160 @@
+package ginkgo
+
+import (
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/onsi/ginkgo/v2/types"
+)
+
+// TestInfo is a read-only description of a test registered with the suite, for tools that need to
+// inspect the suite without running it.
+type TestInfo struct {
+	// Name is the full name of the test, including annotations
+	Name string
+	// ID is stable across runs for as long as the name of the test does not change
+	ID string
+	// Owner is the sig that owns the test
+	Owner string
+	// Labels are the bracketed tags in the name, such as "Serial" or "sig-node"
+	Labels []string
+	// Annotations is the text appended to the name by the generated annotation rules
+	Annotations string
+	// Metadata is recorded with the test by the registered annotators
+	Metadata map[string]string
+	// APIGroups must be served by the cluster for the test to run
+	APIGroups []string
+	// Timeout is set when the test overrides the suite timeout
+	Timeout time.Duration
+	// Serial tests never run in parallel with other tests
+	Serial bool
+	// Locations are the code locations of the containers and the test itself, outermost first
+	Locations []types.CodeLocation
+	// Containers are the texts of the containers of the test, outermost first, as in the
+	// Describe and Context calls that declare it
+	Containers []string
+	// Nodes are the nodes of the spec tree the test is built from, in the order ginkgo runs them
+	Nodes []SpecNode
+}
+
+// SpecNode is a node of the ginkgo spec tree a test is built from: a container, a setup or cleanup
+// node such as BeforeEach, or the test itself.
+type SpecNode struct {
+	// ID identifies the node. A container shared by several tests has the same ID in each of them.
+	ID   uint
+	Type types.NodeType
+	// Text is the text the node was declared with, without the annotations added to the test name
+	Text     string
+	Location types.CodeLocation
+	// NestingLevel is 0 for the nodes declared at the top level and increases in each container
+	NestingLevel int
+	// Labels are the ginkgo labels of the node
+	Labels  []string
+	Focused bool
+	Pending bool
+}
+
+// specNodes returns the nodes of spec. types.TestSpec only exposes the text and code locations of
+// the spec, so the nodes are read from the exported fields of the ginkgo spec behind it. A spec
+// without them has no nodes.
+func specNodes(spec types.TestSpec) []SpecNode {
+	if spec == nil {
+		return nil
+	}
+	v := reflect.Indirect(reflect.ValueOf(spec))
+	if v.Kind() != reflect.Struct {
+		return nil
+	}
+	nodes := v.FieldByName("Nodes")
+	if nodes.Kind() != reflect.Slice {
+		return nil
+	}
+	out := make([]SpecNode, 0, nodes.Len())
+	for i := 0; i < nodes.Len(); i++ {
+		n := reflect.Indirect(nodes.Index(i))
+		if n.Kind() != reflect.Struct {
+			return nil
+		}
+		node := SpecNode{}
+		if f := n.FieldByName("ID"); f.Kind() == reflect.Uint {
+			node.ID = uint(f.Uint())
+		}
+		if f := n.FieldByName("NodeType"); f.IsValid() && f.CanInterface() {
+			node.Type, _ = f.Interface().(types.NodeType)
+		}
+		if f := n.FieldByName("Text"); f.Kind() == reflect.String {
+			node.Text = f.String()
+		}
+		if f := n.FieldByName("CodeLocation"); f.IsValid() && f.CanInterface() {
+			node.Location, _ = f.Interface().(types.CodeLocation)
+		}
+		if f := n.FieldByName("NestingLevel"); f.Kind() == reflect.Int {
+			node.NestingLevel = int(f.Int())
+		}
+		if f := n.FieldByName("Labels"); f.Kind() == reflect.Slice {
+			for j := 0; j < f.Len(); j++ {
+				if label := f.Index(j); label.Kind() == reflect.String {
+					node.Labels = append(node.Labels, label.String())
+				}
+			}
+		}
+		if f := n.FieldByName("MarkedFocus"); f.Kind() == reflect.Bool {
+			node.Focused = f.Bool()
+		}
+		if f := n.FieldByName("MarkedPending"); f.Kind() == reflect.Bool {
+			node.Pending = f.Bool()
+		}
+		out = append(out, node)
+	}
+	return out
+}
+
+// nodes returns the nodes of the spec tree the test is built from. The annotations appended to the
+// text of the last node are removed.
+func (t *testCase) nodes() []SpecNode {
+	nodes := specNodes(t.spec)
+	if len(nodes) > 0 && len(t.annotations) > 0 {
+		last := &nodes[len(nodes)-1]
+		last.Text = strings.TrimSuffix(last.Text, t.annotations)
+	}
+	return nodes
+}
+
+// ListTests builds the test tree if needed and describes every test in the suite, sorted by name.
+// It may be called any number of times, including before running the suite.
+func ListTests() ([]TestInfo, error) {
+	tests, err := testsForSuite()
+	if err != nil {
+		return nil, err
+	}
+	infos := make([]TestInfo, 0, len(tests))
+	for _, test := range sortedTests(tests) {
+		infos = append(infos, newTestInfo(test))
+	}
+	return infos, nil
+}
+
+func newTestInfo(test *testCase) TestInfo {
+	nodes := test.nodes()
+	var containers []string
+	for _, node := range nodes {
+		if node.Type.Is(types.NodeTypeContainer) {
+			containers = append(containers, node.Text)
+		}
+	}
+	return TestInfo{
+		Name:        test.name,
+		ID:          test.id(),
+		Owner:       test.owner(),
+		Labels:      test.labels(),
+		Annotations: test.annotations,
+		Metadata:    test.metadata,
+		APIGroups:   append([]string(nil), test.apigroups...),
+		Timeout:     test.testTimeout,
+		Serial:      isSerialTest(test),
+		Locations:   append([]types.CodeLocation(nil), test.locations...),
+		Containers:  containers,
+		Nodes:       nodes,
+	}
+}
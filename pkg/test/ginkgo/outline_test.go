@@ -0,0 +1,62 @@
+package ginkgo
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/onsi/ginkgo/v2/types"
+)
+
+func Test_newTestInfo(t *testing.T) {
+	describe := types.CodeLocation{FileName: "test/extended/cli/oc.go", LineNumber: 10}
+	it := types.CodeLocation{FileName: "test/extended/cli/oc.go", LineNumber: 20}
+	annotations := " [Suite:openshift/conformance/serial]"
+	spec := fakeTreeSpec{Nodes: []fakeTreeNode{
+		{ID: 1, NodeType: types.NodeTypeContainer, Text: "[sig-cli] oc", CodeLocation: describe},
+		{ID: 2, NodeType: types.NodeTypeContainer, Text: "[apigroup:config.openshift.io] adm", CodeLocation: describe, NestingLevel: 1},
+		{ID: 3, NodeType: types.NodeTypeBeforeEach, CodeLocation: describe, NestingLevel: 2},
+		{ID: 4, NodeType: types.NodeTypeIt, Text: "upgrades [Serial] [Timeout:20m]", CodeLocation: it, NestingLevel: 2},
+	}}
+	spec.AppendText(annotations)
+	test, err := newTestCaseFromGinkgoSpec(fakeNamedSpec{fakeTreeSpec: spec, name: "[sig-cli] oc [apigroup:config.openshift.io] adm upgrades [Serial] [Timeout:20m]" + annotations})
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.annotations = annotations
+
+	info := newTestInfo(test)
+	if info.Name != "[sig-cli] oc [apigroup:config.openshift.io] adm upgrades [Serial] [Timeout:20m] [Suite:openshift/conformance/serial]" || info.ID != test.id() || info.Owner != "sig-cli" {
+		t.Errorf("unexpected test info %#v", info)
+	}
+	if !info.Serial || info.Timeout != 20*time.Minute || !reflect.DeepEqual(info.APIGroups, []string{"config.openshift.io"}) || info.Annotations != annotations {
+		t.Errorf("unexpected test info %#v", info)
+	}
+	if want := []string{"sig-cli", "apigroup:config.openshift.io", "Serial", "Timeout:20m", "Suite:openshift/conformance/serial"}; !reflect.DeepEqual(info.Labels, want) {
+		t.Errorf("unexpected labels %q", info.Labels)
+	}
+	if want := []string{"[sig-cli] oc", "[apigroup:config.openshift.io] adm"}; !reflect.DeepEqual(info.Containers, want) {
+		t.Errorf("unexpected containers %q", info.Containers)
+	}
+	if len(info.Nodes) != 4 || info.Nodes[3].Text != "upgrades [Serial] [Timeout:20m]" || info.Nodes[3].Location != it || info.Nodes[2].Type != types.NodeTypeBeforeEach {
+		t.Errorf("unexpected nodes %#v", info.Nodes)
+	}
+	if want := []types.CodeLocation{describe, describe, describe, it}; !reflect.DeepEqual(info.Locations, want) {
+		t.Errorf("unexpected locations %v", info.Locations)
+	}
+}
+
+// fakeNamedSpec is a fakeTreeSpec with a name and code locations.
+type fakeNamedSpec struct {
+	fakeTreeSpec
+	name string
+}
+
+func (s fakeNamedSpec) Text() string { return s.name }
+func (s fakeNamedSpec) CodeLocations() []types.CodeLocation {
+	var locations []types.CodeLocation
+	for _, node := range s.Nodes {
+		locations = append(locations, node.CodeLocation)
+	}
+	return locations
+}
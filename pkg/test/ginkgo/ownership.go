@@ -0,0 +1,348 @@
+package ginkgo
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/onsi/ginkgo/v2/types"
+	"sigs.k8s.io/yaml"
+)
+
+// OwnershipRule assigns an owner, such as a team or a bug tracker component, to every test whose
+// name matches the regular expression Match and whose source file matches the regular expression
+// Path. Either may be omitted. The source file of a test is the file that defines it, relative to the
+// root of the repository, such as test/extended/networking/services.go or
+// vendor/k8s.io/kubernetes/test/e2e/network/service.go.
+type OwnershipRule struct {
+	Match string `json:"match"`
+	Path  string `json:"path"`
+	Owner string `json:"owner"`
+}
+
+// Ownership maps tests to owners. Rules are evaluated in order and the first match wins; tests that
+// match no rule are owned by the last CODEOWNERS entry matching their source file, if CODEOWNERS was
+// loaded, and otherwise by the sig in their name.
+type Ownership struct {
+	rules      []ownershipRule
+	codeOwners []codeOwnersRule
+	// root is the directory source files are made relative to, if known
+	root string
+}
+
+type ownershipRule struct {
+	match *regexp.Regexp
+	path  *regexp.Regexp
+	owner string
+}
+
+type codeOwnersRule struct {
+	pattern *regexp.Regexp
+	owner   string
+}
+
+// NewOwnership compiles the provided rules.
+func NewOwnership(rules []OwnershipRule) (*Ownership, error) {
+	ownership := &Ownership{}
+	for i, rule := range rules {
+		if len(rule.Owner) == 0 {
+			return nil, fmt.Errorf("ownership rule %d has no owner", i)
+		}
+		if len(rule.Match) == 0 && len(rule.Path) == 0 {
+			return nil, fmt.Errorf("ownership rule %d has neither a match nor a path", i)
+		}
+		compiled := ownershipRule{owner: rule.Owner}
+		var err error
+		if len(rule.Match) > 0 {
+			if compiled.match, err = regexp.Compile(rule.Match); err != nil {
+				return nil, fmt.Errorf("ownership rule %d is invalid: %v", i, err)
+			}
+		}
+		if len(rule.Path) > 0 {
+			if compiled.path, err = regexp.Compile(rule.Path); err != nil {
+				return nil, fmt.Errorf("ownership rule %d has an invalid path: %v", i, err)
+			}
+		}
+		ownership.rules = append(ownership.rules, compiled)
+	}
+	return ownership, nil
+}
+
+// LoadOwnership reads a YAML or JSON list of OwnershipRules from rulesPath and the owners of source
+// files from the GitHub CODEOWNERS file at codeOwnersPath. Either path may be empty.
+func LoadOwnership(rulesPath, codeOwnersPath string) (*Ownership, error) {
+	var rules []OwnershipRule
+	if len(rulesPath) > 0 {
+		data, err := ioutil.ReadFile(rulesPath)
+		if err != nil {
+			return nil, err
+		}
+		if err := yaml.Unmarshal(data, &rules); err != nil {
+			return nil, fmt.Errorf("unable to parse ownership file %s: %v", rulesPath, err)
+		}
+	}
+	ownership, err := NewOwnership(rules)
+	if err != nil {
+		return nil, err
+	}
+	if len(codeOwnersPath) > 0 {
+		f, err := os.Open(codeOwnersPath)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		if ownership.codeOwners, err = parseCodeOwners(f); err != nil {
+			return nil, fmt.Errorf("unable to parse %s: %v", codeOwnersPath, err)
+		}
+		// CODEOWNERS is at the root of the repository or in its .github or docs directory
+		root, err := filepath.Abs(filepath.Dir(codeOwnersPath))
+		if err != nil {
+			return nil, err
+		}
+		if base := filepath.Base(root); base == ".github" || base == "docs" {
+			root = filepath.Dir(root)
+		}
+		ownership.root = root
+	}
+	return ownership, nil
+}
+
+// parseCodeOwners reads the patterns and owners of a GitHub CODEOWNERS file. Entries without owners
+// are kept, as they remove the owners of the files they match.
+func parseCodeOwners(r io.Reader) ([]codeOwnersRule, error) {
+	var rules []codeOwnersRule
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := scanner.Text()
+		if i := strings.Index(text, "#"); i >= 0 {
+			text = text[:i]
+		}
+		fields := strings.Fields(text)
+		if len(fields) == 0 {
+			continue
+		}
+		pattern, err := codeOwnersPattern(fields[0])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %v", line, err)
+		}
+		rules = append(rules, codeOwnersRule{pattern: pattern, owner: strings.Join(fields[1:], " ")})
+	}
+	return rules, scanner.Err()
+}
+
+// codeOwnersPattern converts a CODEOWNERS pattern, which follows the rules of .gitignore, into an
+// expression matching slash separated paths relative to the root of the repository.
+func codeOwnersPattern(pattern string) (*regexp.Regexp, error) {
+	// a pattern with a slash other than at its end is relative to the root, otherwise it matches at
+	// any depth
+	anchored := strings.Contains(strings.TrimSuffix(pattern, "/"), "/")
+	directory := strings.HasSuffix(pattern, "/")
+	pattern = strings.Trim(pattern, "/")
+	if len(pattern) == 0 {
+		return nil, fmt.Errorf("empty pattern")
+	}
+
+	expr := &strings.Builder{}
+	if anchored {
+		expr.WriteString("^")
+	} else {
+		expr.WriteString("^(.*/)?")
+	}
+	for i := 0; i < len(pattern); i++ {
+		switch {
+		case strings.HasPrefix(pattern[i:], "**/"):
+			expr.WriteString("(.*/)?")
+			i += 2
+		case strings.HasPrefix(pattern[i:], "**"):
+			expr.WriteString(".*")
+			i++
+		case pattern[i] == '*':
+			expr.WriteString("[^/]*")
+		case pattern[i] == '?':
+			expr.WriteString("[^/]")
+		default:
+			expr.WriteString(regexp.QuoteMeta(pattern[i : i+1]))
+		}
+	}
+	switch {
+	case directory:
+		expr.WriteString("/.*$")
+	case strings.HasSuffix(pattern, "/*"):
+		// unlike .gitignore, CODEOWNERS does not apply dir/* to the contents of nested directories
+		expr.WriteString("$")
+	default:
+		// a pattern naming a directory owns everything in it
+		expr.WriteString("(/.*)?$")
+	}
+	return regexp.Compile(expr.String())
+}
+
+// ownerFor returns the owner of the first rule matching the test, or of the last CODEOWNERS entry
+// matching its source file, or an empty string.
+func (o *Ownership) ownerFor(test *testCase) string {
+	if o == nil {
+		return ""
+	}
+	file := o.sourceFile(test)
+	for _, rule := range o.rules {
+		if rule.match != nil && !rule.match.MatchString(test.name) {
+			continue
+		}
+		if rule.path != nil && (len(file) == 0 || !rule.path.MatchString(file)) {
+			continue
+		}
+		return rule.owner
+	}
+	if len(file) == 0 {
+		return ""
+	}
+	for i := len(o.codeOwners) - 1; i >= 0; i-- {
+		if o.codeOwners[i].pattern.MatchString(file) {
+			return o.codeOwners[i].owner
+		}
+	}
+	return ""
+}
+
+// originPackagePath is where the source of this repository is built from when it is not under the
+// CODEOWNERS root, such as in a GOPATH or with -trimpath.
+const originPackagePath = "github.com/openshift/origin/"
+
+// sourceFile returns the slash separated path of the file that defines the test relative to the root
+// of the repository, or an empty string if the test has no location. The file of the It node is used,
+// as the cleanup nodes of a test run, and are located, after it.
+func (o *Ownership) sourceFile(test *testCase) string {
+	var file string
+	for _, node := range test.nodes() {
+		if node.Type.Is(types.NodeTypeIt) {
+			file = node.Location.FileName
+		}
+	}
+	if len(file) == 0 && len(test.locations) > 0 {
+		file = test.locations[len(test.locations)-1].FileName
+	}
+	if len(file) == 0 {
+		return ""
+	}
+	file = filepath.ToSlash(file)
+	if len(o.root) > 0 {
+		if root := filepath.ToSlash(o.root) + "/"; strings.HasPrefix(file, root) {
+			return strings.TrimPrefix(file, root)
+		}
+	}
+	if i := strings.LastIndex(file, originPackagePath); i >= 0 {
+		return file[i+len(originPackagePath):]
+	}
+	return file
+}
+
+// assign records the mapped owner on every test so it is carried into the reports.
+func (o *Ownership) assign(tests []*testCase) {
+	for _, test := range tests {
+		if owner := o.ownerFor(test); len(owner) > 0 {
+			test.assignedOwner = owner
+		}
+	}
+}
+
+// ownerRollup is the outcome of the tests of one owner.
+type ownerRollup struct {
+	Owner    string   `json:"owner"`
+	Tests    int      `json:"tests"`
+	Passed   int      `json:"passed"`
+	Failed   int      `json:"failed"`
+	Flaked   int      `json:"flaked"`
+	Skipped  int      `json:"skipped"`
+	Duration string   `json:"duration"`
+	Failing  []string `json:"failing,omitempty"`
+	duration time.Duration
+}
+
+// unownedTests is the owner of tests with no owner in the rollup.
+const unownedTests = "unowned"
+
+// ownerRollups returns the outcome of the tests of each owner by the final attempt of every test,
+// owners with the most failures first.
+func ownerRollups(tests []*testCase) []*ownerRollup {
+	final := map[string]*testCase{}
+	for _, test := range tests {
+		if previous, ok := final[test.name]; !ok || test.attempt() >= previous.attempt() {
+			final[test.name] = test
+		}
+	}
+	byOwner := map[string]*ownerRollup{}
+	for _, test := range final {
+		owner := test.owner()
+		if len(owner) == 0 {
+			owner = unownedTests
+		}
+		rollup, ok := byOwner[owner]
+		if !ok {
+			rollup = &ownerRollup{Owner: owner}
+			byOwner[owner] = rollup
+		}
+		rollup.Tests++
+		for attempt := test; attempt != nil; attempt = attempt.previous {
+			rollup.duration += attempt.duration
+		}
+		switch state := test.state(); {
+		case state == TestFlaked:
+			rollup.Flaked++
+		case state == TestSkipped:
+			rollup.Skipped++
+		case isTestFailed(state):
+			rollup.Failed++
+			rollup.Failing = append(rollup.Failing, test.name)
+		default:
+			rollup.Passed++
+		}
+	}
+	rollups := make([]*ownerRollup, 0, len(byOwner))
+	for _, rollup := range byOwner {
+		sort.Strings(rollup.Failing)
+		rollup.Duration = rollup.duration.Round(time.Second).String()
+		rollups = append(rollups, rollup)
+	}
+	sort.Slice(rollups, func(i, j int) bool {
+		if rollups[i].Failed != rollups[j].Failed {
+			return rollups[i].Failed > rollups[j].Failed
+		}
+		return rollups[i].Owner < rollups[j].Owner
+	})
+	return rollups
+}
+
+// writeOwnerSummary prints the outcome of the tests of every owner with a failed or flaked test.
+func writeOwnerSummary(out io.Writer, rollups []*ownerRollup) {
+	var affected []*ownerRollup
+	for _, rollup := range rollups {
+		if rollup.Failed > 0 || rollup.Flaked > 0 {
+			affected = append(affected, rollup)
+		}
+	}
+	if len(affected) == 0 {
+		return
+	}
+	fmt.Fprintf(out, "Failures by owner:\n\n")
+	for _, rollup := range affected {
+		fmt.Fprintf(out, "%4d fail %4d flake %4d pass %s\n", rollup.Failed, rollup.Flaked, rollup.Passed, rollup.Owner)
+	}
+	fmt.Fprintln(out)
+}
+
+// writeOwnerRollupToDir writes the outcome of the tests of every owner to the junit dir.
+func writeOwnerRollupToDir(dir, fileSuffix string, rollups []*ownerRollup) error {
+	data, err := json.MarshalIndent(rollups, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filepath.Join(dir, fmt.Sprintf("test-owners%s.json", fileSuffix)), append(data, '\n'), 0644)
+}
@@ -0,0 +1,178 @@
+package ginkgo
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/onsi/ginkgo/v2/types"
+)
+
+func TestLoadOwnership(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "owners.yaml")
+	if err := ioutil.WriteFile(path, []byte(`
+- match: '\[sig-network\] .*ovn'
+  owner: ovn-kubernetes
+- match: '\[sig-network\]'
+  owner: networking
+`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	ownership, err := LoadOwnership(path, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []*testCase{
+		{name: "[sig-network] ovn should route"},
+		{name: "[sig-network] services should serve"},
+		{name: "[sig-storage] volumes should mount"},
+		{name: "no sig"},
+	}
+	ownership.assign(tests)
+
+	for i, want := range []string{"ovn-kubernetes", "networking", "sig-storage", ""} {
+		if got := tests[i].owner(); got != want {
+			t.Errorf("owner of %q = %q, want %q", tests[i].name, got, want)
+		}
+	}
+}
+
+func TestNewOwnership_invalid(t *testing.T) {
+	if _, err := NewOwnership([]OwnershipRule{{Match: "[", Owner: "a"}}); err == nil {
+		t.Error("expected an error for an invalid expression")
+	}
+	if _, err := NewOwnership([]OwnershipRule{{Match: "a"}}); err == nil {
+		t.Error("expected an error for a missing owner")
+	}
+	if _, err := NewOwnership([]OwnershipRule{{Owner: "a"}}); err == nil {
+		t.Error("expected an error for a rule that matches every test")
+	}
+	if _, err := NewOwnership([]OwnershipRule{{Path: "(", Owner: "a"}}); err == nil {
+		t.Error("expected an error for an invalid path")
+	}
+}
+
+func TestLoadOwnership_codeLocation(t *testing.T) {
+	repo := t.TempDir()
+	if err := os.Mkdir(filepath.Join(repo, ".github"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	codeOwners := filepath.Join(repo, ".github", "CODEOWNERS")
+	if err := ioutil.WriteFile(codeOwners, []byte(`
+# default owners
+*                              @openshift/origin-approvers
+/test/extended/networking/     @openshift/sdn   @openshift/ovn
+/test/extended/networking/ovn  # no owners
+storage/                       @openshift/storage
+/test/extended/cli/**/*.go     @openshift/cli
+`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	rules := filepath.Join(repo, "owners.yaml")
+	if err := ioutil.WriteFile(rules, []byte(`
+- path: '^test/extended/networking/services\.go$'
+  match: 'ingress'
+  owner: network-edge
+`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	ownership, err := LoadOwnership(rules, codeOwners)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	at := func(file string) []types.CodeLocation {
+		return []types.CodeLocation{{FileName: "/go/src/github.com/openshift/origin/test/extended/util/framework.go"}, {FileName: file}}
+	}
+	tests := []*testCase{
+		{name: "[sig-network] ingress should route", locations: at(filepath.Join(repo, "test/extended/networking/services.go"))},
+		{name: "[sig-network] services should serve", locations: at(filepath.Join(repo, "test/extended/networking/services.go"))},
+		{name: "[sig-network] ovn should route", locations: at(filepath.Join(repo, "test/extended/networking/ovn/ovn.go"))},
+		{name: "[sig-storage] volumes should mount", locations: at("/go/src/github.com/openshift/origin/vendor/k8s.io/kubernetes/test/e2e/storage/volumes.go")},
+		{name: "[sig-cli] oc should run", locations: at("github.com/openshift/origin/test/extended/cli/nested/run.go")},
+		{name: "[sig-apps] builds should run", locations: at(filepath.Join(repo, "test/extended/builds/run.go"))},
+		{name: "[sig-node] no location"},
+		{name: "[sig-storage] cleans up", spec: fakeTreeSpec{Nodes: []fakeTreeNode{
+			{NodeType: types.NodeTypeContainer, CodeLocation: types.CodeLocation{FileName: filepath.Join(repo, "test/extended/cli/describe.go")}},
+			{NodeType: types.NodeTypeIt, CodeLocation: types.CodeLocation{FileName: filepath.Join(repo, "test/extended/storage/volumes.go")}},
+			{NodeType: types.NodeTypeAfterEach, CodeLocation: types.CodeLocation{FileName: filepath.Join(repo, "test/extended/util/client.go")}},
+		}}},
+	}
+	ownership.assign(tests)
+
+	for i, want := range []string{"network-edge", "@openshift/sdn @openshift/ovn", "sig-network", "@openshift/storage", "@openshift/cli", "@openshift/origin-approvers", "sig-node", "@openshift/storage"} {
+		if got := tests[i].owner(); got != want {
+			t.Errorf("owner of %q = %q, want %q", tests[i].name, got, want)
+		}
+	}
+}
+
+func Test_codeOwnersPattern(t *testing.T) {
+	tests := []struct {
+		pattern string
+		matches []string
+		misses  []string
+	}{
+		{pattern: "*.go", matches: []string{"a.go", "test/extended/a.go"}, misses: []string{"a.yaml"}},
+		{pattern: "/docs/", matches: []string{"docs/a.md", "docs/sub/a.md"}, misses: []string{"docs", "sub/docs/a.md"}},
+		{pattern: "docs/*", matches: []string{"docs/a.md"}, misses: []string{"docs/sub/a.md", "sub/docs/a.md"}},
+		{pattern: "apps", matches: []string{"apps", "apps/a.go", "test/apps/a.go"}, misses: []string{"myapps/a.go"}},
+		{pattern: "**/logs", matches: []string{"logs/a", "deep/logs/a"}, misses: []string{"catalogs/a"}},
+	}
+	for _, tt := range tests {
+		re, err := codeOwnersPattern(tt.pattern)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, path := range tt.matches {
+			if !re.MatchString(path) {
+				t.Errorf("expected %s to match %s", tt.pattern, path)
+			}
+		}
+		for _, path := range tt.misses {
+			if re.MatchString(path) {
+				t.Errorf("expected %s not to match %s", tt.pattern, path)
+			}
+		}
+	}
+}
+
+func Test_ownerRollups(t *testing.T) {
+	failed := &testCase{name: "[sig-network] a", failed: true, duration: time.Minute}
+	retried := failed.Retry()
+	retried.failed, retried.duration = true, time.Minute
+	flaked := &testCase{name: "[sig-network] b", failed: true, duration: time.Second}
+	passed := flaked.Retry()
+	passed.success, passed.flake, passed.duration = true, true, time.Second
+	tests := []*testCase{
+		failed, flaked, retried, passed,
+		{name: "[sig-network] c", success: true, duration: time.Second},
+		{name: "[sig-storage] d", skipped: true},
+		{name: "no sig", success: true},
+	}
+
+	rollups := ownerRollups(tests)
+	if len(rollups) != 3 {
+		t.Fatalf("unexpected rollups %v", rollups)
+	}
+	network := rollups[0]
+	if network.Owner != "sig-network" || network.Tests != 3 || network.Failed != 1 || network.Flaked != 1 || network.Passed != 1 || network.Duration != "2m3s" {
+		t.Errorf("unexpected rollup %#v", network)
+	}
+	if len(network.Failing) != 1 || network.Failing[0] != "[sig-network] a" {
+		t.Errorf("unexpected failing tests %v", network.Failing)
+	}
+	if rollups[1].Owner != "sig-storage" || rollups[1].Skipped != 1 || rollups[2].Owner != unownedTests {
+		t.Errorf("unexpected rollups %#v %#v", rollups[1], rollups[2])
+	}
+
+	out := &bytes.Buffer{}
+	writeOwnerSummary(out, rollups)
+	if want := "Failures by owner:\n\n   1 fail    1 flake    1 pass sig-network\n\n"; out.String() != want {
+		t.Errorf("unexpected summary %q", out.String())
+	}
+}
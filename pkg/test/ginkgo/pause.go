@@ -0,0 +1,52 @@
+package ginkgo
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/openshift/origin/pkg/test/ginkgo/runnerapi"
+)
+
+// pauseOnFailureHook acts on the choice the operator made while a failed test was paused before its
+// cleanup, see runnerapi.PauseOnFailureEnv. The test itself prints the failure, its namespaces and
+// artifacts and waits for the choice, since its cleanup has already run once its process exits.
+type pauseOnFailureHook struct {
+	abort func()
+}
+
+func newPauseOnFailureHook(abort func()) *pauseOnFailureHook {
+	return &pauseOnFailureHook{abort: abort}
+}
+
+// TestFailed stops the suite if the operator chose to abort it, and reports the namespaces that were
+// kept if the operator chose to skip the cleanup of the test.
+func (h *pauseOnFailureHook) TestFailed(_ context.Context, testName string, testOutput []byte, artifactDir string, out io.Writer) error {
+	switch pauseChoice(testOutput) {
+	case runnerapi.PauseAbort:
+		fmt.Fprintf(out, "Aborting the suite, as chosen when %q paused\n", testName)
+		h.abort()
+	case runnerapi.PauseSkipCleanup:
+		fmt.Fprintf(out, "Kept the namespaces and resources of %q, as chosen when it paused\n", testName)
+		if len(artifactDir) > 0 {
+			fmt.Fprintf(out, "Artifacts: %s\n", artifactDir)
+		}
+	}
+	return nil
+}
+
+// pauseChoice returns the choice run-test reported for a paused test, or an empty string if the test
+// did not pause.
+func pauseChoice(testOutput []byte) string {
+	var choice string
+	scanner := bufio.NewScanner(bytes.NewReader(testOutput))
+	for scanner.Scan() {
+		if value, ok := strings.CutPrefix(scanner.Text(), runnerapi.PauseChoicePrefix); ok {
+			choice = strings.TrimSpace(value)
+		}
+	}
+	return choice
+}
@@ -0,0 +1,46 @@
+package ginkgo
+
+import (
+	"bytes"
+	"context"
+	"testing"
+)
+
+func Test_pauseOnFailureHook(t *testing.T) {
+	tests := []struct {
+		name        string
+		output      string
+		wantAborted bool
+		wantOutput  string
+	}{
+		{name: "did not pause", output: "fail [a.go:1]: boom\n"},
+		{name: "continue", output: "pause-on-failure: continue\nfail [a.go:1]: boom\n"},
+		{
+			name:       "skip cleanup",
+			output:     "pause-on-failure: skip-cleanup\nfail [a.go:1]: boom\n",
+			wantOutput: "Kept the namespaces and resources of \"[sig-cli] a\", as chosen when it paused\nArtifacts: /artifacts/a\n",
+		},
+		{
+			name:        "abort",
+			output:      "STEP: a\npause-on-failure: abort\nfail [a.go:1]: boom\n",
+			wantAborted: true,
+			wantOutput:  "Aborting the suite, as chosen when \"[sig-cli] a\" paused\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out := &bytes.Buffer{}
+			aborted := false
+			hook := newPauseOnFailureHook(func() { aborted = true })
+			if err := hook.TestFailed(context.Background(), "[sig-cli] a", []byte(tt.output), "/artifacts/a", out); err != nil {
+				t.Fatal(err)
+			}
+			if aborted != tt.wantAborted {
+				t.Errorf("aborted = %v, want %v", aborted, tt.wantAborted)
+			}
+			if out.String() != tt.wantOutput {
+				t.Errorf("unexpected output %q", out.String())
+			}
+		})
+	}
+}
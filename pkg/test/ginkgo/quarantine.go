@@ -0,0 +1,83 @@
+package ginkgo
+
+import (
+	"fmt"
+	"io/ioutil"
+	"regexp"
+	"strings"
+)
+
+// Quarantine lists tests that are run but never fail the suite, because they are known to be
+// unstable. The failure of a quarantined test is reported as a flake so the signal is kept.
+type Quarantine struct {
+	patterns []*regexp.Regexp
+}
+
+// NewQuarantine compiles the regular expressions matching the names of quarantined tests.
+func NewQuarantine(patterns []string) (*Quarantine, error) {
+	quarantine := &Quarantine{}
+	for i, pattern := range patterns {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("quarantine pattern %d is invalid: %v", i, err)
+		}
+		quarantine.patterns = append(quarantine.patterns, re)
+	}
+	return quarantine, nil
+}
+
+// LoadQuarantine reads a file with one regular expression per line. Empty lines and lines starting
+// with # are ignored.
+func LoadQuarantine(path string) (*Quarantine, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var patterns []string
+	for _, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if len(line) == 0 || strings.HasPrefix(line, "#") {
+			continue
+		}
+		patterns = append(patterns, line)
+	}
+	quarantine, err := NewQuarantine(patterns)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse quarantine file %s: %v", path, err)
+	}
+	return quarantine, nil
+}
+
+// MatchFor returns the first pattern matching the test name, or an empty string if the test is not
+// quarantined.
+func (q *Quarantine) MatchFor(testName string) string {
+	if q == nil {
+		return ""
+	}
+	for _, re := range q.patterns {
+		if re.MatchString(testName) {
+			return re.String()
+		}
+	}
+	return ""
+}
+
+// assign records the matching pattern on every quarantined test.
+func (q *Quarantine) assign(tests []*testCase) {
+	for _, test := range tests {
+		test.quarantine = q.MatchFor(test.name)
+	}
+}
+
+// applyQuarantine reports the failure of a quarantined test as a flake, which does not fail the
+// suite. Tests that pass or are skipped are not changed.
+func applyQuarantine(test *testCase, result *testRunResult) {
+	if len(test.quarantine) == 0 || result == nil {
+		return
+	}
+	switch result.testState {
+	case TestFailed, TestFailedTimeout, TestUnexpectedPass, TestUnknown:
+		result.testState = TestFlaked
+		result.testOutputBytes = append(result.testOutputBytes, fmt.Sprintf("\nflake: the test is quarantined by %q and does not fail the suite\n", test.quarantine)...)
+	}
+}
@@ -0,0 +1,71 @@
+package ginkgo
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestLoadQuarantine(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "quarantine.txt")
+	contents := "# known to be unstable\n\n\\[sig-network\\] unstable\n  \\[sig-storage\\].*\\[Slow\\]  \n"
+	if err := ioutil.WriteFile(path, []byte(contents), 0644); err != nil {
+		t.Fatal(err)
+	}
+	quarantine, err := LoadQuarantine(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name string
+		want string
+	}{
+		{name: "[sig-network] unstable test", want: `\[sig-network\] unstable`},
+		{name: "[sig-storage] volume [Slow]", want: `\[sig-storage\].*\[Slow\]`},
+		{name: "[sig-storage] volume"},
+	}
+	for _, tt := range tests {
+		if got := quarantine.MatchFor(tt.name); got != tt.want {
+			t.Errorf("MatchFor(%q) = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+
+	if err := ioutil.WriteFile(path, []byte("[unclosed\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadQuarantine(path); err == nil {
+		t.Error("expected an error for an invalid pattern")
+	}
+	if got := (*Quarantine)(nil).MatchFor("[sig-network] unstable test"); got != "" {
+		t.Errorf("expected no match without a quarantine, got %q", got)
+	}
+}
+
+func Test_applyQuarantine(t *testing.T) {
+	tests := []struct {
+		name       string
+		quarantine string
+		state      TestState
+		want       TestState
+	}{
+		{name: "quarantined failure is a flake", quarantine: "unstable", state: TestFailed, want: TestFlaked},
+		{name: "quarantined timeout is a flake", quarantine: "unstable", state: TestFailedTimeout, want: TestFlaked},
+		{name: "quarantined pass is unchanged", quarantine: "unstable", state: TestSucceeded, want: TestSucceeded},
+		{name: "quarantined skip is unchanged", quarantine: "unstable", state: TestSkipped, want: TestSkipped},
+		{name: "failure without quarantine is unchanged", state: TestFailed, want: TestFailed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := &testCase{name: "[sig-network] unstable test", quarantine: tt.quarantine}
+			result := &testRunResult{name: test.name, testState: tt.state}
+			applyQuarantine(test, result)
+			if result.testState != tt.want {
+				t.Errorf("unexpected state %s", result.testState)
+			}
+			if tt.want == TestFlaked && !strings.Contains(string(result.testOutputBytes), "flake: the test is quarantined") {
+				t.Errorf("expected the output to explain the flake, got %q", result.testOutputBytes)
+			}
+		})
+	}
+}
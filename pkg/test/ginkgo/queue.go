@@ -14,6 +14,8 @@ import (
 // defered until all other tests are completed.
 type parallelByFileTestQueue struct {
 	commandContext *commandContext
+	// stepThrough, if set, is asked before each test is run
+	stepThrough *stepThroughPrompt
 }
 
 type TestFunc func(ctx context.Context, test *testCase)
@@ -95,6 +97,10 @@ func (q *parallelByFileTestQueue) Execute(ctx context.Context, tests []*testCase
 		testSuiteProgress:     testSuiteProgress,
 		maybeAbortOnFailureFn: maybeAbortOnFailureFn,
 	}
+	if q.stepThrough != nil {
+		execute(ctx, &stepThroughRunner{prompt: q.stepThrough, delegate: testSuiteRunner}, tests, parallelism)
+		return
+	}
 
 	execute(ctx, testSuiteRunner, tests, parallelism)
 }
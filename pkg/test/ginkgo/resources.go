@@ -0,0 +1,72 @@
+package ginkgo
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"runtime"
+	"sort"
+	"syscall"
+	"time"
+)
+
+// testResources is the resource usage of the process that ran a test.
+type testResources struct {
+	// MaxRSSBytes is the peak resident memory of the process
+	MaxRSSBytes int64
+	UserCPU     time.Duration
+	SystemCPU   time.Duration
+}
+
+// processResources returns the resource usage of an exited process, or nil if it is unavailable.
+func processResources(state *os.ProcessState) *testResources {
+	if state == nil {
+		return nil
+	}
+	usage, ok := state.SysUsage().(*syscall.Rusage)
+	if !ok || usage == nil {
+		return nil
+	}
+	maxRSS := int64(usage.Maxrss)
+	// Linux reports the peak in kilobytes, darwin in bytes
+	if runtime.GOOS != "darwin" {
+		maxRSS *= 1024
+	}
+	return &testResources{
+		MaxRSSBytes: maxRSS,
+		UserCPU:     state.UserTime(),
+		SystemCPU:   state.SystemTime(),
+	}
+}
+
+// writeResourceSummary prints the tests whose processes used the most memory and CPU, so growth in
+// the cost of individual tests is attributed to them.
+func writeResourceSummary(out io.Writer, tests []*testCase, limit int) {
+	var measured []*testCase
+	for _, test := range tests {
+		if test.resources != nil {
+			measured = append(measured, test)
+		}
+	}
+	if len(measured) == 0 {
+		return
+	}
+
+	top := func(title string, less func(a, b *testResources) bool, format func(r *testResources) string) {
+		sort.SliceStable(measured, func(i, j int) bool { return less(measured[j].resources, measured[i].resources) })
+		fmt.Fprintf(out, "%s:\n\n", title)
+		for i, test := range measured {
+			if i == limit {
+				break
+			}
+			fmt.Fprintf(out, "%10s %s\n", format(test.resources), test.name)
+		}
+		fmt.Fprintln(out)
+	}
+	top("Tests with the highest peak memory",
+		func(a, b *testResources) bool { return a.MaxRSSBytes < b.MaxRSSBytes },
+		func(r *testResources) string { return fmt.Sprintf("%dMi", r.MaxRSSBytes/(1024*1024)) })
+	top("Tests with the highest CPU time",
+		func(a, b *testResources) bool { return a.UserCPU+a.SystemCPU < b.UserCPU+b.SystemCPU },
+		func(r *testResources) string { return (r.UserCPU + r.SystemCPU).Round(time.Second / 10).String() })
+}
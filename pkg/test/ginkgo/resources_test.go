@@ -0,0 +1,43 @@
+package ginkgo
+
+import (
+	"bytes"
+	"os/exec"
+	"strings"
+	"testing"
+	"time"
+)
+
+func Test_processResources(t *testing.T) {
+	if processResources(nil) != nil {
+		t.Error("expected no resources without a process state")
+	}
+	cmd := exec.Command("/bin/sh", "-c", "true")
+	if err := cmd.Run(); err != nil {
+		t.Fatal(err)
+	}
+	if r := processResources(cmd.ProcessState); r == nil || r.MaxRSSBytes <= 0 {
+		t.Errorf("unexpected resources %#v", r)
+	}
+}
+
+func Test_writeResourceSummary(t *testing.T) {
+	tests := []*testCase{
+		{name: "small", resources: &testResources{MaxRSSBytes: 50 * 1024 * 1024, UserCPU: 5 * time.Second}},
+		{name: "large", resources: &testResources{MaxRSSBytes: 500 * 1024 * 1024, UserCPU: time.Second}},
+		{name: "unmeasured"},
+	}
+	out := &bytes.Buffer{}
+	writeResourceSummary(out, tests, 1)
+	want := "Tests with the highest peak memory:\n\n     500Mi large\n\n" +
+		"Tests with the highest CPU time:\n\n        5s small\n\n"
+	if out.String() != want {
+		t.Errorf("unexpected summary %q", out.String())
+	}
+
+	out.Reset()
+	writeResourceSummary(out, tests[2:], 1)
+	if strings.TrimSpace(out.String()) != "" {
+		t.Errorf("expected no summary without measurements, got %q", out.String())
+	}
+}
@@ -0,0 +1,136 @@
+package ginkgo
+
+import (
+	"bufio"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/openshift/origin/pkg/test/ginkgo/junitapi"
+)
+
+// resumeCheckpointFile records every test as it completes, so a run that is killed before it writes
+// its reports can still be resumed with --resume-from.
+const resumeCheckpointFile = "resume-checkpoint.jsonl"
+
+// resumeCheckpoint is a line of the checkpoint file.
+type resumeCheckpoint struct {
+	Time  time.Time `json:"time"`
+	Test  string    `json:"test"`
+	State TestState `json:"state"`
+}
+
+// newResumeCheckpointSubscriber returns an event bus subscriber that appends the outcome of every
+// test to the checkpoint file in dir, and a function that closes the file.
+func newResumeCheckpointSubscriber(dir string) (func(SuiteEvent), func() error, error) {
+	f, err := os.OpenFile(filepath.Join(dir, resumeCheckpointFile), os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, nil, err
+	}
+	var lock sync.Mutex
+	return func(event SuiteEvent) {
+		e, ok := event.(TestDidRun)
+		if !ok {
+			return
+		}
+		data, err := json.Marshal(resumeCheckpoint{Time: time.Now().UTC(), Test: e.Test, State: e.State})
+		if err != nil {
+			return
+		}
+		lock.Lock()
+		defer lock.Unlock()
+		// a single write per line, so a line is never split by a kill
+		f.Write(append(data, '\n'))
+	}, f.Close, nil
+}
+
+// loadResumeState reads the outcome of the tests of a previous run from the checkpoint and JUnit
+// reports in dir. A test that passed in any attempt is recorded as passed.
+func loadResumeState(dir string) (map[string]TestState, error) {
+	state := map[string]TestState{}
+	record := func(name string, s TestState) {
+		if previous, ok := state[name]; ok && !isTestFailed(previous) {
+			return
+		}
+		state[name] = s
+	}
+
+	f, err := os.Open(filepath.Join(dir, resumeCheckpointFile))
+	switch {
+	case os.IsNotExist(err):
+	case err != nil:
+		return nil, err
+	default:
+		defer f.Close()
+		scanner := bufio.NewScanner(f)
+		scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+		for scanner.Scan() {
+			var checkpoint resumeCheckpoint
+			if err := json.Unmarshal(scanner.Bytes(), &checkpoint); err != nil {
+				// the last line is incomplete if the run was killed while writing it
+				continue
+			}
+			record(checkpoint.Test, checkpoint.State)
+		}
+		if err := scanner.Err(); err != nil {
+			return nil, err
+		}
+	}
+
+	reports, err := filepath.Glob(filepath.Join(dir, "junit_e2e_*.xml"))
+	if err != nil {
+		return nil, err
+	}
+	for _, report := range reports {
+		data, err := ioutil.ReadFile(report)
+		if err != nil {
+			return nil, err
+		}
+		suite := &junitapi.JUnitTestSuite{}
+		if err := xml.Unmarshal(data, suite); err != nil {
+			return nil, fmt.Errorf("unable to parse %s: %v", report, err)
+		}
+		for _, testCase := range suite.TestCases {
+			switch {
+			case testCase.SkipMessage != nil:
+				record(testCase.Name, TestSkipped)
+			case testCase.FailureOutput != nil:
+				record(testCase.Name, TestFailed)
+			default:
+				record(testCase.Name, TestSucceeded)
+			}
+		}
+	}
+	return state, nil
+}
+
+// resumeTests splits the tests into those that still have to run, because they failed or did not
+// complete in the previous run, and those that completed. Completed tests are given the outcome of
+// the previous run so they are reported with the tests that run now.
+func resumeTests(tests []*testCase, state map[string]TestState, dir string) (pending, completed []*testCase) {
+	for _, test := range tests {
+		s, ok := state[test.name]
+		if !ok || isTestFailed(s) {
+			pending = append(pending, test)
+			continue
+		}
+		switch s {
+		case TestSkipped:
+			test.skipped = true
+		case TestFailedAsExpected:
+			test.success = true
+			test.failedAsExpected = true
+		default:
+			// a flake is reported as a pass, its failure was reported by the previous run
+			test.success = true
+		}
+		test.testOutputBytes = []byte(fmt.Sprintf("resumed: the test completed with state %s in the run in %s\n", s, dir))
+		completed = append(completed, test)
+	}
+	return pending, completed
+}
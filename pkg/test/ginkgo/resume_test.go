@@ -0,0 +1,77 @@
+package ginkgo
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestResumeCheckpoint(t *testing.T) {
+	dir := t.TempDir()
+	checkpoint, closeCheckpoint, err := newResumeCheckpointSubscriber(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkpoint(TestWillRun{Test: "ignored"})
+	checkpoint(TestDidRun{Test: "passes", State: TestSucceeded})
+	checkpoint(TestDidRun{Test: "fails", State: TestFailed})
+	checkpoint(TestDidRun{Test: "passes on retry", State: TestFailed})
+	checkpoint(TestDidRun{Test: "passes on retry", State: TestSucceeded})
+	checkpoint(TestDidRun{Test: "skipped", State: TestSkipped})
+	if err := closeCheckpoint(); err != nil {
+		t.Fatal(err)
+	}
+	// a run killed while writing leaves an incomplete line
+	f, err := os.OpenFile(filepath.Join(dir, resumeCheckpointFile), os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.WriteString(`{"test":"interrupted","st`)
+	f.Close()
+
+	junit := `<testsuite name="openshift-tests">
+  <testcase name="from junit"></testcase>
+  <testcase name="fails"><failure message="">failed</failure></testcase>
+</testsuite>`
+	if err := os.WriteFile(filepath.Join(dir, "junit_e2e_20230101-000000.xml"), []byte(junit), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	state, err := loadResumeState(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]TestState{
+		"passes":          TestSucceeded,
+		"fails":           TestFailed,
+		"passes on retry": TestSucceeded,
+		"skipped":         TestSkipped,
+		"from junit":      TestSucceeded,
+	}
+	if !reflect.DeepEqual(state, want) {
+		t.Errorf("expected %v, got %v", want, state)
+	}
+
+	tests := []*testCase{{name: "passes"}, {name: "fails"}, {name: "skipped"}, {name: "new"}}
+	pending, completed := resumeTests(tests, state, dir)
+	if names := testNames(pending); !reflect.DeepEqual(names, []string{"fails", "new"}) {
+		t.Errorf("unexpected pending tests: %v", names)
+	}
+	if names := testNames(completed); !reflect.DeepEqual(names, []string{"passes", "skipped"}) {
+		t.Errorf("unexpected completed tests: %v", names)
+	}
+	if completed[0].state() != TestSucceeded || completed[1].state() != TestSkipped {
+		t.Errorf("unexpected states of completed tests: %s, %s", completed[0].state(), completed[1].state())
+	}
+}
+
+func TestLoadResumeStateEmptyDir(t *testing.T) {
+	state, err := loadResumeState(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(state) != 0 {
+		t.Errorf("expected no state, got %v", state)
+	}
+}
@@ -0,0 +1,33 @@
+package ginkgo
+
+import "context"
+
+// retryFailedTests runs each of the failing tests again, up to attempts times, until an attempt passes
+// or is skipped. It returns every retry that ran, the names of the tests that passed on a retry or
+// were skipped on a retry, and the last retry of the tests that failed every attempt.
+func retryFailedTests(ctx context.Context, failing []*testCase, attempts int, execute func(ctx context.Context, tests []*testCase)) (retries []*testCase, flaky, skipped []string, repeatFailures []*testCase) {
+	next := failing
+	for attempt := 1; attempt <= attempts && len(next) > 0; attempt++ {
+		current := make([]*testCase, 0, len(next))
+		for _, test := range next {
+			current = append(current, test.Retry())
+		}
+		execute(ctx, current)
+		retries = append(retries, current...)
+
+		next = nil
+		for _, test := range current {
+			switch {
+			case test.success:
+				flaky = append(flaky, test.name)
+			case test.skipped:
+				skipped = append(skipped, test.name)
+			case attempt < attempts && ctx.Err() == nil:
+				next = append(next, test)
+			default:
+				repeatFailures = append(repeatFailures, test)
+			}
+		}
+	}
+	return retries, flaky, skipped, repeatFailures
+}
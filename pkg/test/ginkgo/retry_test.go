@@ -0,0 +1,54 @@
+package ginkgo
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func Test_retryFailedTests(t *testing.T) {
+	failing := []*testCase{{name: "passes on the second retry", failed: true}, {name: "always fails", failed: true}, {name: "skipped", failed: true}}
+	var executed [][]string
+	execute := func(ctx context.Context, tests []*testCase) {
+		var names []string
+		for _, test := range tests {
+			names = append(names, test.name)
+			switch {
+			case test.name == "skipped":
+				test.skipped = true
+			case test.name == "passes on the second retry" && test.attempt() == 3:
+				test.success = true
+			default:
+				test.failed = true
+			}
+		}
+		executed = append(executed, names)
+	}
+
+	retries, flaky, skipped, repeatFailures := retryFailedTests(context.TODO(), failing, 3, execute)
+	wantExecuted := [][]string{
+		{"passes on the second retry", "always fails", "skipped"},
+		{"passes on the second retry", "always fails"},
+		{"always fails"},
+	}
+	if !reflect.DeepEqual(executed, wantExecuted) {
+		t.Errorf("unexpected attempts %v", executed)
+	}
+	if len(retries) != 6 {
+		t.Errorf("expected 6 retries, got %d", len(retries))
+	}
+	if !reflect.DeepEqual(flaky, []string{"passes on the second retry"}) || !reflect.DeepEqual(skipped, []string{"skipped"}) {
+		t.Errorf("unexpected flaky %v or skipped %v", flaky, skipped)
+	}
+	if len(repeatFailures) != 1 || repeatFailures[0].name != "always fails" || repeatFailures[0].attempt() != 4 {
+		t.Errorf("unexpected repeat failures %#v", repeatFailures)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	executed = nil
+	_, _, _, repeatFailures = retryFailedTests(ctx, failing[1:2], 3, execute)
+	if len(executed) != 1 || len(repeatFailures) != 1 {
+		t.Errorf("expected retries to stop after cancellation, got %v", executed)
+	}
+}
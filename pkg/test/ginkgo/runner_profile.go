@@ -0,0 +1,210 @@
+package ginkgo
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"runtime/pprof"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/openshift/origin/pkg/monitor"
+	"github.com/openshift/origin/pkg/monitor/monitorapi"
+)
+
+const (
+	// runnerLocator identifies the intervals that describe the openshift-tests process itself.
+	runnerLocator = "runner/openshift-tests"
+
+	// runnerProfileInterval is how often the CPU profile of the runner starts a new file and its heap
+	// profile is rewritten, so the profiles of a runner killed for running out of memory are still
+	// readable.
+	runnerProfileInterval = 10 * time.Minute
+	// runnerSampleInterval is how often the memory and goroutines of the runner are recorded.
+	runnerSampleInterval = 30 * time.Second
+)
+
+// runnerProfiler writes CPU and heap profiles of the runner to a directory. The CPU profile is split
+// in numbered files of runnerProfileInterval and the heap profile is replaced at the same interval.
+type runnerProfiler struct {
+	dir    string
+	errOut io.Writer
+
+	lock    sync.Mutex
+	segment int
+	cpu     *os.File
+
+	stopCh chan struct{}
+	doneCh chan struct{}
+}
+
+// startRunnerProfiler starts profiling the runner into dir until Stop is called.
+func startRunnerProfiler(dir string, interval time.Duration, errOut io.Writer) (*runnerProfiler, error) {
+	p := &runnerProfiler{
+		dir:    dir,
+		errOut: errOut,
+		stopCh: make(chan struct{}),
+		doneCh: make(chan struct{}),
+	}
+	if err := p.startCPUSegment(); err != nil {
+		return nil, err
+	}
+	go func() {
+		defer close(p.doneCh)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-p.stopCh:
+				return
+			case <-ticker.C:
+				if err := p.rotate(); err != nil {
+					fmt.Fprintf(p.errOut, "error: Unable to profile the runner: %v\n", err)
+				}
+			}
+		}
+	}()
+	return p, nil
+}
+
+func (p *runnerProfiler) startCPUSegment() error {
+	p.segment++
+	f, err := os.Create(filepath.Join(p.dir, fmt.Sprintf("runner-cpu-%03d.pprof", p.segment)))
+	if err != nil {
+		return err
+	}
+	if err := pprof.StartCPUProfile(f); err != nil {
+		f.Close()
+		return err
+	}
+	p.cpu = f
+	return nil
+}
+
+func (p *runnerProfiler) stopCPUSegment() error {
+	if p.cpu == nil {
+		return nil
+	}
+	pprof.StopCPUProfile()
+	err := p.cpu.Close()
+	p.cpu = nil
+	return err
+}
+
+// rotate completes the current CPU profile, rewrites the heap profile, and starts the next CPU profile.
+func (p *runnerProfiler) rotate() error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if err := p.stopCPUSegment(); err != nil {
+		return err
+	}
+	if err := writeHeapProfile(filepath.Join(p.dir, "runner-heap.pprof")); err != nil {
+		return err
+	}
+	return p.startCPUSegment()
+}
+
+// Stop completes the CPU profile and writes the final heap profile.
+func (p *runnerProfiler) Stop() error {
+	close(p.stopCh)
+	<-p.doneCh
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if err := p.stopCPUSegment(); err != nil {
+		return err
+	}
+	return writeHeapProfile(filepath.Join(p.dir, "runner-heap.pprof"))
+}
+
+// writeHeapProfile replaces the heap profile at path, so a reader never sees a partial profile.
+func writeHeapProfile(path string) error {
+	f, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	runtime.GC()
+	if err := pprof.WriteHeapProfile(f); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), path)
+}
+
+// runnerResources is a sample of the memory and goroutines of the runner.
+type runnerResources struct {
+	// RSS is the resident memory of the process in bytes, or the memory obtained from the system by
+	// the Go runtime where the resident memory is not available.
+	RSS        uint64
+	HeapAlloc  uint64
+	Goroutines int
+}
+
+func sampleRunnerResources() runnerResources {
+	var stats runtime.MemStats
+	runtime.ReadMemStats(&stats)
+	resources := runnerResources{
+		RSS:        stats.Sys,
+		HeapAlloc:  stats.HeapAlloc,
+		Goroutines: runtime.NumGoroutine(),
+	}
+	if f, err := os.Open("/proc/self/status"); err == nil {
+		defer f.Close()
+		if rss, err := readRSS(f); err == nil {
+			resources.RSS = rss
+		}
+	}
+	return resources
+}
+
+// readRSS returns the VmRSS of a /proc/<pid>/status file in bytes.
+func readRSS(status io.Reader) (uint64, error) {
+	scanner := bufio.NewScanner(status)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 3 || fields[0] != "VmRSS:" || fields[2] != "kB" {
+			continue
+		}
+		kb, err := strconv.ParseUint(fields[1], 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid VmRSS %q: %v", fields[1], err)
+		}
+		return kb * 1024, nil
+	}
+	if err := scanner.Err(); err != nil {
+		return 0, err
+	}
+	return 0, fmt.Errorf("no VmRSS found")
+}
+
+func (r runnerResources) condition() monitorapi.Condition {
+	return monitorapi.Condition{
+		Level:   monitorapi.Info,
+		Locator: runnerLocator,
+		Message: fmt.Sprintf("reason/RunnerResources rss/%dMi heap/%dMi goroutines/%d", r.RSS>>20, r.HeapAlloc>>20, r.Goroutines),
+	}
+}
+
+// recordRunnerResources records the memory and goroutines of the runner every interval until ctx is done.
+func recordRunnerResources(ctx context.Context, recorder monitor.Recorder, interval time.Duration) {
+	recorder.Record(sampleRunnerResources().condition())
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			recorder.Record(sampleRunnerResources().condition())
+		}
+	}
+}
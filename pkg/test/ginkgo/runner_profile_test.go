@@ -0,0 +1,85 @@
+package ginkgo
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestReadRSS(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  string
+		want    uint64
+		wantErr bool
+	}{
+		{
+			name:   "resident memory",
+			status: "Name:\topenshift-tests\nVmPeak:\t 2048 kB\nVmRSS:\t    1536 kB\nThreads:\t12\n",
+			want:   1536 * 1024,
+		},
+		{
+			name:    "missing",
+			status:  "Name:\topenshift-tests\nThreads:\t12\n",
+			wantErr: true,
+		},
+		{
+			name:    "invalid",
+			status:  "VmRSS:\t    lots kB\n",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := readRSS(strings.NewReader(tt.status))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("expected %d, got %d", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestRunnerResourcesCondition(t *testing.T) {
+	condition := runnerResources{RSS: 3 << 30, HeapAlloc: 512 << 20, Goroutines: 42}.condition()
+	if condition.Locator != runnerLocator {
+		t.Errorf("unexpected locator %q", condition.Locator)
+	}
+	if want := "reason/RunnerResources rss/3072Mi heap/512Mi goroutines/42"; condition.Message != want {
+		t.Errorf("expected message %q, got %q", want, condition.Message)
+	}
+}
+
+func TestRunnerProfiler(t *testing.T) {
+	dir := t.TempDir()
+	profiler, err := startRunnerProfiler(dir, time.Hour, os.Stderr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := profiler.rotate(); err != nil {
+		t.Fatal(err)
+	}
+	if err := profiler.Stop(); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"runner-cpu-001.pprof", "runner-cpu-002.pprof", "runner-heap.pprof"} {
+		info, err := os.Stat(filepath.Join(dir, name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if info.Size() == 0 {
+			t.Errorf("%s is empty", name)
+		}
+	}
+	files, err := filepath.Glob(filepath.Join(dir, "*"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 3 {
+		t.Errorf("unexpected files %v", files)
+	}
+}
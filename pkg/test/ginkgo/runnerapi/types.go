@@ -0,0 +1,66 @@
+// Package runnerapi holds the names shared by openshift-tests and the specs it runs in separate
+// processes: the spec report entries the runner reads back from a spec, and the environment the
+// runner passes to it. It has no dependencies so both sides can import it.
+package runnerapi
+
+import (
+	"crypto/sha256"
+	"fmt"
+)
+
+const (
+	// NamespaceReportEntry names the spec report entries that record the namespaces created for a
+	// spec, so the test runner can tell which namespaces belong to a failed spec.
+	NamespaceReportEntry = "e2e-namespace"
+	// SpecMetricsReportEntry names the spec report entries that hold the results of the spec metric
+	// queries at the start and the end of a spec.
+	SpecMetricsReportEntry = "spec-metrics"
+	// ArtifactReportEntry names the spec report entries that record the artifacts attached to a spec,
+	// relative to the artifact directory of the spec, so the test runner can link them from the JUnit
+	// report.
+	ArtifactReportEntry = "artifact"
+	// PauseChoiceReportEntry names the spec report entry that records what the operator chose when a
+	// failed spec paused, one of the Pause* choices.
+	PauseChoiceReportEntry = "pause-choice"
+)
+
+const (
+	// TestArtifactsDir is the directory under the artifact directory that holds one directory per
+	// spec, named by the ID of the spec.
+	TestArtifactsDir = "test-artifacts"
+	// TestArtifactsIndex is the file in TestArtifactsDir that maps the IDs of the specs to their
+	// artifacts.
+	TestArtifactsIndex = "index.json"
+)
+
+const (
+	// PauseOnFailureEnv, if set to a duration, makes a failed spec print its failure, namespaces and
+	// artifact directory and wait, for at most that long, for the operator to choose one of the Pause*
+	// choices on stdin or in a file before its AfterEach and DeferCleanup nodes delete the evidence.
+	// It is set by openshift-tests --pause-on-failure.
+	PauseOnFailureEnv = "TEST_PAUSE_ON_FAILURE"
+	// PauseOutputFDEnv, if set, is the file descriptor the pause is announced on instead of stderr.
+	// openshift-tests run passes its own stderr, as it prints the output of a test once it exits.
+	PauseOutputFDEnv = "TEST_PAUSE_OUTPUT_FD"
+	// PauseInputFDEnv, if set, is the file descriptor the choice of the operator is read from instead
+	// of stdin. openshift-tests run passes its own stdin.
+	PauseInputFDEnv = "TEST_PAUSE_INPUT_FD"
+)
+
+const (
+	// PauseContinue runs the cleanup of the failed spec and continues the suite.
+	PauseContinue = "continue"
+	// PauseSkipCleanup keeps the namespaces and resources of the failed spec and continues the suite.
+	PauseSkipCleanup = "skip-cleanup"
+	// PauseAbort runs the cleanup of the failed spec and stops the suite, as an interrupt would.
+	PauseAbort = "abort"
+	// PauseChoicePrefix starts the line run-test writes with the choice of the operator, so the runner
+	// can stop the suite.
+	PauseChoicePrefix = "pause-on-failure: "
+)
+
+// SpecID returns an identifier for the spec with the given name, without its suite annotations, that is
+// stable across runs and releases for as long as the name does not change.
+func SpecID(name string) string {
+	return fmt.Sprintf("%x", sha256.Sum256([]byte(name)))[:16]
+}
@@ -0,0 +1,56 @@
+package ginkgo
+
+import (
+	"fmt"
+	"hash/fnv"
+
+	"github.com/openshift/origin/pkg/test/ginkgo/junitapi"
+)
+
+// validateShard returns an error unless the shard is unset or index is in [0, count).
+func validateShard(index, count int) error {
+	if count == 0 && index == 0 {
+		return nil
+	}
+	if count < 1 {
+		return fmt.Errorf("--shard-count must be at least 1 when --shard-index is set")
+	}
+	if index < 0 || index >= count {
+		return fmt.Errorf("--shard-index must be between 0 and %d", count-1)
+	}
+	return nil
+}
+
+// shardTests returns the tests that belong to shard index of count. Tests are assigned by a hash of
+// their stable id, so every job that runs the same suite with the same count selects a disjoint
+// set of tests, and together the shards cover the suite.
+func shardTests(tests []*testCase, index, count int) []*testCase {
+	if count <= 1 {
+		return tests
+	}
+	selected := make([]*testCase, 0, len(tests)/count+1)
+	for _, test := range tests {
+		if testShard(test, count) == index {
+			selected = append(selected, test)
+		}
+	}
+	return selected
+}
+
+func testShard(test *testCase, count int) int {
+	h := fnv.New32a()
+	h.Write([]byte(test.id()))
+	return int(h.Sum32() % uint32(count))
+}
+
+// shardProperties records the shard of the suite in the JUnit report, so merged reports can be
+// checked for missing shards.
+func shardProperties(index, count int) []*junitapi.TestSuiteProperty {
+	if count == 0 {
+		return nil
+	}
+	return []*junitapi.TestSuiteProperty{
+		{Name: "shard.index", Value: fmt.Sprintf("%d", index)},
+		{Name: "shard.count", Value: fmt.Sprintf("%d", count)},
+	}
+}
@@ -0,0 +1,64 @@
+package ginkgo
+
+import (
+	"fmt"
+	"testing"
+)
+
+func Test_shardTests(t *testing.T) {
+	var tests []*testCase
+	for i := 0; i < 200; i++ {
+		tests = append(tests, &testCase{name: fmt.Sprintf("[sig-test] test %d", i)})
+	}
+
+	seen := map[string]int{}
+	for index := 0; index < 4; index++ {
+		shard := shardTests(tests, index, 4)
+		if len(shard) == 0 || len(shard) == len(tests) {
+			t.Errorf("expected shard %d to hold part of the suite, got %d tests", index, len(shard))
+		}
+		for _, test := range shard {
+			seen[test.name]++
+		}
+		again := shardTests(tests, index, 4)
+		if len(again) != len(shard) {
+			t.Errorf("expected shard %d to be stable, got %d and %d tests", index, len(shard), len(again))
+		}
+	}
+	if len(seen) != len(tests) {
+		t.Errorf("expected the shards to cover all %d tests, got %d", len(tests), len(seen))
+	}
+	for name, count := range seen {
+		if count != 1 {
+			t.Errorf("expected %q to be in one shard, got %d", name, count)
+		}
+	}
+
+	annotated := &testCase{name: "[sig-test] test 7 [Suite:openshift/conformance/parallel]", annotations: " [Suite:openshift/conformance/parallel]"}
+	if testShard(annotated, 4) != testShard(tests[7], 4) {
+		t.Error("expected the shard of a test to ignore its annotations")
+	}
+
+	if got := shardTests(tests, 0, 1); len(got) != len(tests) {
+		t.Errorf("expected a single shard to hold every test, got %d", len(got))
+	}
+}
+
+func Test_validateShard(t *testing.T) {
+	tests := []struct {
+		index, count int
+		wantErr      bool
+	}{
+		{index: 0, count: 0},
+		{index: 0, count: 1},
+		{index: 2, count: 3},
+		{index: 3, count: 3, wantErr: true},
+		{index: -1, count: 3, wantErr: true},
+		{index: 1, count: 0, wantErr: true},
+	}
+	for _, tt := range tests {
+		if err := validateShard(tt.index, tt.count); (err != nil) != tt.wantErr {
+			t.Errorf("validateShard(%d, %d) error = %v, wantErr %v", tt.index, tt.count, err, tt.wantErr)
+		}
+	}
+}
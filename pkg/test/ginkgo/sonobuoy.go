@@ -0,0 +1,120 @@
+package ginkgo
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/openshift/origin/pkg/test"
+	"github.com/openshift/origin/pkg/test/ginkgo/junitapi"
+)
+
+// OutputFormatSonobuoy writes the human readable log of the suite to standard out like
+// OutputFormatText, and the results as a Sonobuoy results tarball to the junit dir, so that tools
+// built for the CNCF conformance results can read them.
+const OutputFormatSonobuoy = "sonobuoy"
+
+// sonobuoyPlugin is the Sonobuoy plugin that runs the upstream conformance tests, whose results the
+// tarball mimics.
+const sonobuoyPlugin = "e2e"
+
+// sonobuoyItem is an entry of sonobuoy_results.yaml, the results of a plugin processed by Sonobuoy.
+type sonobuoyItem struct {
+	Name    string                 `json:"name"`
+	Status  string                 `json:"status"`
+	Meta    map[string]string      `json:"meta,omitempty"`
+	Details map[string]interface{} `json:"details,omitempty"`
+	Items   []sonobuoyItem         `json:"items,omitempty"`
+}
+
+const (
+	sonobuoyStatusPassed  = "passed"
+	sonobuoyStatusFailed  = "failed"
+	sonobuoyStatusSkipped = "skipped"
+)
+
+// sonobuoyResults converts the results of a suite to the processed results of the e2e plugin.
+func sonobuoyResults(suite *junitapi.JUnitTestSuite, junitFile string) sonobuoyItem {
+	file := sonobuoyItem{
+		Name:   filepath.Base(junitFile),
+		Status: sonobuoyStatusPassed,
+		Meta:   map[string]string{"file": junitFile},
+	}
+	for _, testCase := range suite.TestCases {
+		item := sonobuoyItem{Name: testCase.Name, Status: sonobuoyStatusPassed}
+		switch {
+		case testCase.FailureOutput != nil:
+			item.Status = sonobuoyStatusFailed
+			item.Details = map[string]interface{}{"failure": testCase.FailureOutput.Output}
+			if len(testCase.SystemOut) > 0 {
+				item.Details["system-out"] = testCase.SystemOut
+			}
+			file.Status = sonobuoyStatusFailed
+		case testCase.SkipMessage != nil:
+			item.Status = sonobuoyStatusSkipped
+		}
+		file.Items = append(file.Items, item)
+	}
+	return sonobuoyItem{
+		Name:   sonobuoyPlugin,
+		Status: file.Status,
+		Meta:   map[string]string{"type": "summary"},
+		Items:  []sonobuoyItem{file},
+	}
+}
+
+// writeSonobuoyResults writes the results of a suite and the log of the run at logPath to a tarball
+// laid out like the results retrieved from Sonobuoy for the e2e plugin.
+func writeSonobuoyResults(dir, fileSuffix string, suite *junitapi.JUnitTestSuite, logPath string) error {
+	junit, err := xml.Marshal(suite)
+	if err != nil {
+		return err
+	}
+	log, err := ioutil.ReadFile(logPath)
+	if err != nil {
+		return err
+	}
+	results, err := yaml.Marshal(sonobuoyResults(suite, "results/global/junit_01.xml"))
+	if err != nil {
+		return err
+	}
+
+	path := filepath.Join(dir, fmt.Sprintf("sonobuoy%s.tar.gz", fileSuffix))
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	gz := gzip.NewWriter(f)
+	tw := tar.NewWriter(gz)
+	now := time.Now()
+	for _, file := range []struct {
+		name string
+		data []byte
+	}{
+		{name: "plugins/e2e/results/global/junit_01.xml", data: test.StripANSI(junit)},
+		{name: "plugins/e2e/results/global/e2e.log", data: test.StripANSI(log)},
+		{name: "plugins/e2e/sonobuoy_results.yaml", data: results},
+	} {
+		if err := tw.WriteHeader(&tar.Header{Name: file.name, Mode: 0644, Size: int64(len(file.data)), ModTime: now}); err != nil {
+			return err
+		}
+		if _, err := tw.Write(file.data); err != nil {
+			return err
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	if err := gz.Close(); err != nil {
+		return err
+	}
+	return f.Close()
+}
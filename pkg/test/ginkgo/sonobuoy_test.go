@@ -0,0 +1,86 @@
+package ginkgo
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/openshift/origin/pkg/test/ginkgo/junitapi"
+)
+
+func TestWriteSonobuoyResults(t *testing.T) {
+	dir := t.TempDir()
+	logPath := filepath.Join(dir, "run.log")
+	if err := ioutil.WriteFile(logPath, []byte("\x1b[1mstarted: 0/1/2\x1b[0m\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	suite := &junitapi.JUnitTestSuite{
+		Name: "openshift-tests",
+		TestCases: []*junitapi.JUnitTestCase{
+			{Name: "[sig-a] passes"},
+			{Name: "[sig-a] fails", FailureOutput: &junitapi.FailureOutput{Output: "fail [a.go:1]: boom"}, SystemOut: "output"},
+			{Name: "[sig-a] skipped", SkipMessage: &junitapi.SkipMessage{Message: "not supported"}},
+		},
+	}
+	if err := writeSonobuoyResults(dir, "_20230401-100000", suite, logPath); err != nil {
+		t.Fatal(err)
+	}
+
+	f, err := os.Open(filepath.Join(dir, "sonobuoy_20230401-100000.tar.gz"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	files := map[string]string{}
+	tr := tar.NewReader(gz)
+	for {
+		header, err := tr.Next()
+		if err != nil {
+			break
+		}
+		data, err := ioutil.ReadAll(tr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		files[header.Name] = string(data)
+	}
+
+	if got := files["plugins/e2e/results/global/e2e.log"]; got != "started: 0/1/2\n" {
+		t.Errorf("unexpected e2e.log %q", got)
+	}
+	if _, ok := files["plugins/e2e/results/global/junit_01.xml"]; !ok {
+		t.Errorf("expected junit_01.xml in %v", files)
+	}
+	var results sonobuoyItem
+	if err := yaml.Unmarshal([]byte(files["plugins/e2e/sonobuoy_results.yaml"]), &results); err != nil {
+		t.Fatal(err)
+	}
+	want := sonobuoyItem{
+		Name:   "e2e",
+		Status: "failed",
+		Meta:   map[string]string{"type": "summary"},
+		Items: []sonobuoyItem{{
+			Name:   "junit_01.xml",
+			Status: "failed",
+			Meta:   map[string]string{"file": "results/global/junit_01.xml"},
+			Items: []sonobuoyItem{
+				{Name: "[sig-a] passes", Status: "passed"},
+				{Name: "[sig-a] fails", Status: "failed", Details: map[string]interface{}{"failure": "fail [a.go:1]: boom", "system-out": "output"}},
+				{Name: "[sig-a] skipped", Status: "skipped"},
+			},
+		}},
+	}
+	if !reflect.DeepEqual(want, results) {
+		t.Errorf("expected %#v, got %#v", want, results)
+	}
+}
@@ -0,0 +1,68 @@
+package ginkgo
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/onsi/ginkgo/v2/types"
+)
+
+// trimStack removes the frames of functions in any of the given packages from a stack in the format
+// of runtime/debug.Stack, where each frame is a function line followed by a file line.
+func trimStack(stack string, packages []string) string {
+	if len(packages) == 0 || len(stack) == 0 {
+		return stack
+	}
+	lines := strings.Split(strings.TrimRight(stack, "\n"), "\n")
+	var kept []string
+	for i := 0; i+1 < len(lines); i += 2 {
+		if inPackages(lines[i], packages) {
+			continue
+		}
+		kept = append(kept, lines[i], lines[i+1])
+	}
+	if len(kept) == 0 {
+		return stack
+	}
+	return strings.Join(kept, "\n") + "\n"
+}
+
+// inPackages returns true if function, as printed in a stack, belongs to one of packages or to a
+// package nested under one of them.
+func inPackages(function string, packages []string) bool {
+	for _, pkg := range packages {
+		if strings.HasPrefix(function, pkg+".") || strings.HasPrefix(function, pkg+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// trimLocation moves a failure location that points into one of the given packages to the first
+// frame of its stack that does not, so failures raised by helpers are reported at the calling test.
+func trimLocation(location types.CodeLocation, packages []string) types.CodeLocation {
+	stack := trimStack(location.FullStackTrace, packages)
+	if stack == location.FullStackTrace {
+		return location
+	}
+	location.FullStackTrace = stack
+	lines := strings.SplitN(stack, "\n", 3)
+	if len(lines) < 2 {
+		return location
+	}
+	// the file line has the form "\t/path/to/file.go:123 +0x1d"
+	file := strings.Fields(strings.TrimSpace(lines[1]))
+	if len(file) == 0 {
+		return location
+	}
+	i := strings.LastIndex(file[0], ":")
+	if i < 0 {
+		return location
+	}
+	line, err := strconv.Atoi(file[0][i+1:])
+	if err != nil {
+		return location
+	}
+	location.FileName, location.LineNumber = file[0][:i], line
+	return location
+}
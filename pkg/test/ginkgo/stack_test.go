@@ -0,0 +1,38 @@
+package ginkgo
+
+import (
+	"testing"
+
+	"github.com/onsi/ginkgo/v2/types"
+)
+
+const testStack = `github.com/onsi/gomega/internal.(*Assertion).To(0xc000123, {0x1, 0x2})
+	/go/src/github.com/openshift/origin/vendor/github.com/onsi/gomega/internal/assertion.go:62 +0x1d
+github.com/openshift/origin/test/extended/util.AssertReady(0xc000456)
+	/go/src/github.com/openshift/origin/test/extended/util/ready.go:20 +0x2e
+github.com/openshift/origin/test/extended/cli.glob..func1.2()
+	/go/src/github.com/openshift/origin/test/extended/cli/cli.go:42 +0x3f
+`
+
+func Test_trimLocation(t *testing.T) {
+	location := types.CodeLocation{
+		FileName:       "/go/src/github.com/openshift/origin/vendor/github.com/onsi/gomega/internal/assertion.go",
+		LineNumber:     62,
+		FullStackTrace: testStack,
+	}
+
+	if got := trimLocation(location, nil); got != location {
+		t.Errorf("expected the location to be unchanged without packages, got %#v", got)
+	}
+	if got := trimLocation(location, []string{"github.com/openshift/origin/test/extended/cli"}); got.LineNumber != 62 || len(got.FullStackTrace) >= len(testStack) {
+		t.Errorf("expected only the last frame to be removed, got %#v", got)
+	}
+
+	got := trimLocation(location, []string{"github.com/onsi/gomega", "github.com/openshift/origin/test/extended/util"})
+	if got.FileName != "/go/src/github.com/openshift/origin/test/extended/cli/cli.go" || got.LineNumber != 42 {
+		t.Errorf("unexpected location %s:%d", got.FileName, got.LineNumber)
+	}
+	if want := "github.com/openshift/origin/test/extended/cli.glob..func1.2()\n\t/go/src/github.com/openshift/origin/test/extended/cli/cli.go:42 +0x3f\n"; got.FullStackTrace != want {
+		t.Errorf("unexpected stack %q", got.FullStackTrace)
+	}
+}
@@ -11,6 +11,7 @@ type testSuiteProgress struct {
 	lock     sync.Mutex
 	failures int
 	index    int
+	finished int
 	total    int
 }
 
@@ -38,11 +39,19 @@ func (s *testSuiteProgress) TestEnded(testName string, testRunResult *testRunRes
 	s.lock.Lock()
 	defer s.lock.Unlock()
 
+	s.finished++
 	if isTestFailed(testRunResult.testState) {
 		s.failures++
 	}
 }
 
+func (s *testSuiteProgress) report() ProgressReport {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return ProgressReport{Started: s.index, Finished: s.finished, Failures: s.failures, Total: s.total}
+}
+
 func summarizeTests(tests []*testCase) (int, int, int, []*testCase) {
 	var pass, fail, skip int
 	var failingTests []*testCase
@@ -0,0 +1,87 @@
+package ginkgo
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+)
+
+type stepAction int
+
+const (
+	stepRun stepAction = iota
+	stepSkip
+	stepQuit
+)
+
+// stepThroughPrompt asks the operator before each test whether to run it, skip it, or stop the suite.
+// It is intended for bisecting order dependent failures locally with one test running at a time.
+type stepThroughPrompt struct {
+	lock     sync.Mutex
+	in       *bufio.Reader
+	out      io.Writer
+	estimate *suiteEstimate
+	quit     func()
+	// exhausted is set when input ends, after which every remaining test runs
+	exhausted bool
+}
+
+func newStepThroughPrompt(in io.Reader, out io.Writer, estimate *suiteEstimate, quit func()) *stepThroughPrompt {
+	return &stepThroughPrompt{in: bufio.NewReader(in), out: out, estimate: estimate, quit: quit}
+}
+
+func (p *stepThroughPrompt) ask(test *testCase) stepAction {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if p.exhausted {
+		return stepRun
+	}
+
+	fmt.Fprintf(p.out, "\nNext test: %q\n", test.name)
+	if labels := test.labels(); len(labels) > 0 {
+		fmt.Fprintf(p.out, "  labels: %s\n", strings.Join(labels, ", "))
+	}
+	if estimate := p.estimate.durationFor(test); len(estimate) > 0 {
+		fmt.Fprintf(p.out, "  estimated duration: %s\n", estimate)
+	}
+	for {
+		fmt.Fprintf(p.out, "[r]un, [s]kip, [q]uit? ")
+		line, err := p.in.ReadString('\n')
+		switch strings.ToLower(strings.TrimSpace(line)) {
+		case "r", "run", "":
+			if err == nil {
+				return stepRun
+			}
+		case "s", "skip":
+			return stepSkip
+		case "q", "quit":
+			return stepQuit
+		}
+		if err != nil {
+			fmt.Fprintf(p.out, "\nNo input available, running the remaining tests\n")
+			p.exhausted = true
+			return stepRun
+		}
+	}
+}
+
+// stepThroughRunner prompts before delegating each test to the wrapped runner.
+type stepThroughRunner struct {
+	prompt   *stepThroughPrompt
+	delegate testSuiteRunner
+}
+
+func (r *stepThroughRunner) RunOneTest(ctx context.Context, test *testCase) {
+	switch r.prompt.ask(test) {
+	case stepSkip:
+		test.skipped = true
+		test.testOutputBytes = []byte("skipped by the operator in step-through mode")
+	case stepQuit:
+		r.prompt.quit()
+	default:
+		r.delegate.RunOneTest(ctx, test)
+	}
+}
@@ -0,0 +1,39 @@
+package ginkgo
+
+import (
+	"context"
+	"io/ioutil"
+	"strings"
+	"testing"
+)
+
+type recordingRunner struct {
+	ran []string
+}
+
+func (r *recordingRunner) RunOneTest(_ context.Context, test *testCase) {
+	r.ran = append(r.ran, test.name)
+}
+
+func Test_stepThroughRunner(t *testing.T) {
+	quit := false
+	delegate := &recordingRunner{}
+	runner := &stepThroughRunner{
+		prompt:   newStepThroughPrompt(strings.NewReader("r\nwhat\ns\nq\n"), ioutil.Discard, nil, func() { quit = true }),
+		delegate: delegate,
+	}
+	tests := []*testCase{{name: "a"}, {name: "b"}, {name: "c"}, {name: "d"}}
+	for _, test := range tests {
+		runner.RunOneTest(context.Background(), test)
+	}
+
+	if len(delegate.ran) != 2 || delegate.ran[0] != "a" || delegate.ran[1] != "d" {
+		t.Errorf("unexpected tests run %v", delegate.ran)
+	}
+	if !tests[1].skipped {
+		t.Error("expected b to be skipped")
+	}
+	if !quit {
+		t.Error("expected c to quit the suite")
+	}
+}
@@ -0,0 +1,67 @@
+package ginkgo
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/openshift/origin/pkg/test"
+)
+
+const (
+	// ColorAuto colors the console output when it is a terminal.
+	ColorAuto = "auto"
+	// ColorAlways colors the console output.
+	ColorAlways = "always"
+	// ColorNever never colors the console output.
+	ColorNever = "never"
+)
+
+// statusStyler renders the status word of a test result for the console. Test output is stored
+// without styling, so reports never contain control sequences and styling is only applied when the
+// result is printed.
+type statusStyler func(state TestState, status string) string
+
+func plainStatus(_ TestState, status string) string {
+	return status
+}
+
+func ansiStatus(state TestState, status string) string {
+	var code string
+	switch state {
+	case TestSucceeded, TestFailedAsExpected:
+		code = "32"
+	case TestFailed, TestFailedTimeout, TestUnexpectedPass:
+		code = "1;31"
+	case TestFlaked, TestSkipped:
+		code = "33"
+	default:
+		return status
+	}
+	return fmt.Sprintf("\x1b[%sm%s\x1b[0m", code, status)
+}
+
+// newStatusStyler returns the styler for the color mode, deciding for ColorAuto by whether out is a
+// terminal.
+func newStatusStyler(mode string, out io.Writer) (statusStyler, error) {
+	switch mode {
+	case ColorNever, "":
+		return plainStatus, nil
+	case ColorAlways:
+		return ansiStatus, nil
+	case ColorAuto:
+		if f, ok := out.(*os.File); ok {
+			if info, err := f.Stat(); err == nil && info.Mode()&os.ModeCharDevice != 0 {
+				return ansiStatus, nil
+			}
+		}
+		return plainStatus, nil
+	default:
+		return nil, fmt.Errorf("unrecognized color mode %q, must be one of %s, %s, or %s", mode, ColorAuto, ColorAlways, ColorNever)
+	}
+}
+
+// stripStyle removes the control sequences a test process may have written to its output.
+func stripStyle(output []byte) []byte {
+	return test.StripANSI(output)
+}
@@ -0,0 +1,37 @@
+package ginkgo
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+)
+
+func Test_recordTestResultInLog_style(t *testing.T) {
+	result := &testRunResultHandle{testRunResult: &testRunResult{
+		name:            "[sig-cli] a",
+		testState:       TestFailed,
+		start:           time.Unix(0, 0),
+		end:             time.Unix(1, 0),
+		testOutputBytes: stripStyle([]byte("\x1b[31mfail [a.go:1]: boom\x1b[0m")),
+	}}
+
+	plain := &bytes.Buffer{}
+	recordTestResultInLog(result, plain, false, plainStatus)
+	if strings.Contains(plain.String(), "\x1b") || !strings.HasPrefix(plain.String(), "fail [a.go:1]: boom\nfailed: ") {
+		t.Errorf("unexpected plain output %q", plain.String())
+	}
+
+	colored := &bytes.Buffer{}
+	recordTestResultInLog(result, colored, false, ansiStatus)
+	if !strings.HasPrefix(colored.String(), "fail [a.go:1]: boom\n\x1b[1;31mfailed:\x1b[0m ") {
+		t.Errorf("unexpected colored output %q", colored.String())
+	}
+
+	if _, err := newStatusStyler("rainbow", &bytes.Buffer{}); err == nil {
+		t.Error("expected an error for an unknown color mode")
+	}
+	if style, err := newStatusStyler(ColorAuto, &bytes.Buffer{}); err != nil || style(TestFailed, "failed:") != "failed:" {
+		t.Error("expected no color when the output is not a terminal")
+	}
+}
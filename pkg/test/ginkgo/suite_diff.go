@@ -0,0 +1,212 @@
+package ginkgo
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// SuiteDiffOptions compares the JSON dry-run listings of a suite from two builds, so reviewers can see
+// how a change affects test coverage.
+type SuiteDiffOptions struct {
+	Out io.Writer
+}
+
+// testRename is a test whose name changed between listings while its code locations stayed the same.
+type testRename struct {
+	From          string
+	To            string
+	AddedLabels   []string
+	RemovedLabels []string
+}
+
+// testChange is a test present in both listings whose computed properties differ.
+type testChange struct {
+	Name    string
+	Changes []string
+}
+
+type suiteDiff struct {
+	Added   []string
+	Removed []string
+	Renamed []testRename
+	Changed []testChange
+}
+
+func (d *suiteDiff) empty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Renamed) == 0 && len(d.Changed) == 0
+}
+
+// Run prints the differences between the listings at oldPath and newPath, as written by
+// run --dry-run --dry-run-format=json.
+func (opt *SuiteDiffOptions) Run(oldPath, newPath string) error {
+	before, err := loadDryRunListing(oldPath)
+	if err != nil {
+		return err
+	}
+	after, err := loadDryRunListing(newPath)
+	if err != nil {
+		return err
+	}
+	writeSuiteDiff(opt.Out, diffDryRunListings(before, after))
+	return nil
+}
+
+func loadDryRunListing(path string) (*dryRunListing, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	listing := &dryRunListing{}
+	if err := json.Unmarshal(data, listing); err != nil {
+		return nil, fmt.Errorf("unable to parse %s as a dry-run listing: %v", path, err)
+	}
+	return listing, nil
+}
+
+// diffDryRunListings compares two listings by test name. A removed and an added test are reported as
+// a rename when they are the only tests at the same code locations, which is how label or text
+// changes to a single test appear.
+func diffDryRunListings(before, after *dryRunListing) *suiteDiff {
+	oldTests := make(map[string]dryRunTest, len(before.Tests))
+	for _, test := range before.Tests {
+		oldTests[test.Name] = test
+	}
+	newTests := make(map[string]dryRunTest, len(after.Tests))
+	for _, test := range after.Tests {
+		newTests[test.Name] = test
+	}
+
+	diff := &suiteDiff{}
+	removedAt := map[string][]dryRunTest{}
+	for _, test := range before.Tests {
+		if _, ok := newTests[test.Name]; !ok {
+			key := strings.Join(test.Locations, ",")
+			removedAt[key] = append(removedAt[key], test)
+		}
+	}
+	addedAt := map[string][]dryRunTest{}
+	for _, test := range after.Tests {
+		old, ok := oldTests[test.Name]
+		if !ok {
+			key := strings.Join(test.Locations, ",")
+			addedAt[key] = append(addedAt[key], test)
+			continue
+		}
+		if changes := compareDryRunTests(old, test); len(changes) > 0 {
+			diff.Changed = append(diff.Changed, testChange{Name: test.Name, Changes: changes})
+		}
+	}
+
+	for key, added := range addedAt {
+		removed := removedAt[key]
+		if len(key) > 0 && len(added) == 1 && len(removed) == 1 {
+			diff.Renamed = append(diff.Renamed, testRename{
+				From:          removed[0].Name,
+				To:            added[0].Name,
+				AddedLabels:   missingStrings(added[0].Labels, removed[0].Labels),
+				RemovedLabels: missingStrings(removed[0].Labels, added[0].Labels),
+			})
+			delete(removedAt, key)
+			continue
+		}
+		for _, test := range added {
+			diff.Added = append(diff.Added, test.Name)
+		}
+	}
+	for _, removed := range removedAt {
+		for _, test := range removed {
+			diff.Removed = append(diff.Removed, test.Name)
+		}
+	}
+
+	sort.Strings(diff.Added)
+	sort.Strings(diff.Removed)
+	sort.Slice(diff.Renamed, func(i, j int) bool { return diff.Renamed[i].To < diff.Renamed[j].To })
+	sort.Slice(diff.Changed, func(i, j int) bool { return diff.Changed[i].Name < diff.Changed[j].Name })
+	return diff
+}
+
+// compareDryRunTests describes the differences in the properties the runner computes for a test.
+func compareDryRunTests(before, after dryRunTest) []string {
+	var changes []string
+	compare := func(field, from, to string) {
+		if from != to {
+			changes = append(changes, fmt.Sprintf("%s changed from %q to %q", field, from, to))
+		}
+	}
+	compare("owner", before.Owner, after.Owner)
+	compare("timeout", before.Timeout, after.Timeout)
+	compare("serial", fmt.Sprint(before.Serial), fmt.Sprint(after.Serial))
+	compare("skipped", fmt.Sprint(before.Skipped), fmt.Sprint(after.Skipped))
+	compare("annotations", before.Annotations, after.Annotations)
+	for _, key := range sets.StringKeySet(before.Metadata).Union(sets.StringKeySet(after.Metadata)).List() {
+		compare("metadata "+key, before.Metadata[key], after.Metadata[key])
+	}
+	compare("external ids", strings.Join(before.ExternalIDs, ","), strings.Join(after.ExternalIDs, ","))
+	return changes
+}
+
+// missingStrings returns the values of a that are not in b.
+func missingStrings(a, b []string) []string {
+	present := make(map[string]struct{}, len(b))
+	for _, s := range b {
+		present[s] = struct{}{}
+	}
+	var missing []string
+	for _, s := range uniqueStrings(a) {
+		if _, ok := present[s]; !ok {
+			missing = append(missing, s)
+		}
+	}
+	return missing
+}
+
+func writeSuiteDiff(out io.Writer, diff *suiteDiff) {
+	if diff.empty() {
+		fmt.Fprintln(out, "No differences")
+		return
+	}
+	if len(diff.Added) > 0 {
+		fmt.Fprintf(out, "Added %d tests:\n", len(diff.Added))
+		for _, name := range diff.Added {
+			fmt.Fprintf(out, "+ %q\n", name)
+		}
+		fmt.Fprintln(out)
+	}
+	if len(diff.Removed) > 0 {
+		fmt.Fprintf(out, "Removed %d tests:\n", len(diff.Removed))
+		for _, name := range diff.Removed {
+			fmt.Fprintf(out, "- %q\n", name)
+		}
+		fmt.Fprintln(out)
+	}
+	if len(diff.Renamed) > 0 {
+		fmt.Fprintf(out, "Renamed %d tests:\n", len(diff.Renamed))
+		for _, rename := range diff.Renamed {
+			fmt.Fprintf(out, "  %q\n  -> %q\n", rename.From, rename.To)
+			if len(rename.AddedLabels) > 0 {
+				fmt.Fprintf(out, "     added labels: %s\n", strings.Join(rename.AddedLabels, ", "))
+			}
+			if len(rename.RemovedLabels) > 0 {
+				fmt.Fprintf(out, "     removed labels: %s\n", strings.Join(rename.RemovedLabels, ", "))
+			}
+		}
+		fmt.Fprintln(out)
+	}
+	if len(diff.Changed) > 0 {
+		fmt.Fprintf(out, "Changed %d tests:\n", len(diff.Changed))
+		for _, change := range diff.Changed {
+			fmt.Fprintf(out, "  %q\n", change.Name)
+			for _, c := range change.Changes {
+				fmt.Fprintf(out, "     %s\n", c)
+			}
+		}
+		fmt.Fprintln(out)
+	}
+}
@@ -0,0 +1,43 @@
+package ginkgo
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func Test_diffDryRunListings(t *testing.T) {
+	before := &dryRunListing{Tests: []dryRunTest{
+		{Name: "[sig-apps] kept", Timeout: "15m0s", Locations: []string{"a.go:1"}},
+		{Name: "[sig-apps] removed", Locations: []string{"a.go:2"}},
+		{Name: "[sig-apps] renamed", Labels: []string{"sig-apps"}, Locations: []string{"a.go:3"}},
+		{Name: "[sig-apps] same", Locations: []string{"a.go:4"}},
+	}}
+	after := &dryRunListing{Tests: []dryRunTest{
+		{Name: "[sig-apps] kept", Timeout: "30m0s", Serial: true, Locations: []string{"a.go:1"}},
+		{Name: "[sig-apps] added", Locations: []string{"a.go:5"}},
+		{Name: "[sig-apps] renamed [Serial]", Labels: []string{"sig-apps", "Serial"}, Locations: []string{"a.go:3"}},
+		{Name: "[sig-apps] same", Locations: []string{"a.go:4"}},
+	}}
+
+	diff := diffDryRunListings(before, after)
+	want := &suiteDiff{
+		Added:   []string{"[sig-apps] added"},
+		Removed: []string{"[sig-apps] removed"},
+		Renamed: []testRename{{From: "[sig-apps] renamed", To: "[sig-apps] renamed [Serial]", AddedLabels: []string{"Serial"}}},
+		Changed: []testChange{{Name: "[sig-apps] kept", Changes: []string{
+			`timeout changed from "15m0s" to "30m0s"`,
+			`serial changed from "false" to "true"`,
+		}}},
+	}
+	if !reflect.DeepEqual(diff, want) {
+		t.Errorf("unexpected diff %#v", diff)
+	}
+
+	out := &bytes.Buffer{}
+	writeSuiteDiff(out, diffDryRunListings(before, before))
+	if strings.TrimSpace(out.String()) != "No differences" {
+		t.Errorf("unexpected output for identical listings %q", out.String())
+	}
+}
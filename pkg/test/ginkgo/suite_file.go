@@ -0,0 +1,154 @@
+package ginkgo
+
+import (
+	"fmt"
+	"io/ioutil"
+	"regexp"
+	"strings"
+	"time"
+
+	"sigs.k8s.io/yaml"
+)
+
+// SuiteFile defines a suite outside of the binary, so custom suites can be run without rebuilding
+// openshift-tests. A test is in the suite if it matches any of the include qualifiers, or there are
+// none, and matches none of the exclude qualifiers. Disabled tests are never in the suite.
+type SuiteFile struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+
+	Include []SuiteQualifier `json:"include,omitempty"`
+	Exclude []SuiteQualifier `json:"exclude,omitempty"`
+
+	// Parallelism is the maximum number of tests that run at once
+	Parallelism int `json:"parallelism,omitempty"`
+	// TestTimeout is the timeout of tests without a [Timeout] label, such as "15m"
+	TestTimeout string `json:"testTimeout,omitempty"`
+	// MaximumAllowedFlakes is the number of flakes that may occur before they fail the suite
+	MaximumAllowedFlakes int `json:"maximumAllowedFlakes,omitempty"`
+
+	// MaxSerialTests, RequireSigLabel, and RequireTimeout are checked against the tests of the suite
+	// before any of them run, see SuiteBudget
+	MaxSerialTests  int  `json:"maxSerialTests,omitempty"`
+	RequireSigLabel bool `json:"requireSigLabel,omitempty"`
+	RequireTimeout  bool `json:"requireTimeout,omitempty"`
+}
+
+// SuiteQualifier matches tests that have every label, such as "sig-network" or "Serial", and
+// whose names match the regular expression. Either may be omitted.
+type SuiteQualifier struct {
+	Labels []string `json:"labels,omitempty"`
+	Regex  string   `json:"regex,omitempty"`
+}
+
+type suiteQualifier struct {
+	labels []string
+	re     *regexp.Regexp
+}
+
+func (q suiteQualifier) matches(name string) bool {
+	for _, label := range q.labels {
+		if !strings.Contains(name, "["+label+"]") {
+			return false
+		}
+	}
+	return q.re == nil || q.re.MatchString(name)
+}
+
+// NewSuiteFromFile returns the suite described by file.
+func NewSuiteFromFile(file SuiteFile) (*TestSuite, error) {
+	if len(file.Name) == 0 {
+		return nil, fmt.Errorf("the suite has no name")
+	}
+	if file.Parallelism < 0 {
+		return nil, fmt.Errorf("parallelism of suite %s must not be negative", file.Name)
+	}
+	if file.MaxSerialTests < 0 {
+		return nil, fmt.Errorf("maxSerialTests of suite %s must not be negative", file.Name)
+	}
+	var timeout time.Duration
+	if len(file.TestTimeout) > 0 {
+		var err error
+		if timeout, err = time.ParseDuration(file.TestTimeout); err != nil {
+			return nil, fmt.Errorf("testTimeout of suite %s is invalid: %v", file.Name, err)
+		}
+	}
+	include, err := compileSuiteQualifiers(file.Include)
+	if err != nil {
+		return nil, fmt.Errorf("include of suite %s is invalid: %v", file.Name, err)
+	}
+	exclude, err := compileSuiteQualifiers(file.Exclude)
+	if err != nil {
+		return nil, fmt.Errorf("exclude of suite %s is invalid: %v", file.Name, err)
+	}
+
+	var budget *SuiteBudget
+	if file.MaxSerialTests > 0 || file.RequireSigLabel || file.RequireTimeout {
+		budget = &SuiteBudget{
+			MaxSerialTests:  file.MaxSerialTests,
+			RequireSigLabel: file.RequireSigLabel,
+			RequireTimeout:  file.RequireTimeout,
+		}
+	}
+
+	return &TestSuite{
+		Name:        file.Name,
+		Description: file.Description,
+		Matches: func(name string) bool {
+			// disabled tests are left out of every suite, like the built-in suites
+			if strings.Contains(name, "[Disabled:") {
+				return false
+			}
+			for _, q := range exclude {
+				if q.matches(name) {
+					return false
+				}
+			}
+			if len(include) == 0 {
+				return true
+			}
+			for _, q := range include {
+				if q.matches(name) {
+					return true
+				}
+			}
+			return false
+		},
+		Parallelism:          file.Parallelism,
+		MaximumAllowedFlakes: file.MaximumAllowedFlakes,
+		TestTimeout:          timeout,
+		Budget:               budget,
+	}, nil
+}
+
+func compileSuiteQualifiers(qualifiers []SuiteQualifier) ([]suiteQualifier, error) {
+	var compiled []suiteQualifier
+	for i, q := range qualifiers {
+		if len(q.Labels) == 0 && len(q.Regex) == 0 {
+			return nil, fmt.Errorf("qualifier %d has neither labels nor a regex", i)
+		}
+		c := suiteQualifier{labels: q.Labels}
+		if len(q.Regex) > 0 {
+			re, err := regexp.Compile(q.Regex)
+			if err != nil {
+				return nil, fmt.Errorf("qualifier %d is invalid: %v", i, err)
+			}
+			c.re = re
+		}
+		compiled = append(compiled, c)
+	}
+	return compiled, nil
+}
+
+// LoadSuiteFile reads a suite described in YAML or JSON from a file.
+func LoadSuiteFile(path string) (*TestSuite, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var file SuiteFile
+	if err := yaml.UnmarshalStrict(data, &file); err != nil {
+		return nil, fmt.Errorf("unable to parse suite file %s: %v", path, err)
+	}
+	return NewSuiteFromFile(file)
+}
@@ -0,0 +1,128 @@
+package ginkgo
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestLoadSuiteFile(t *testing.T) {
+	tests := []struct {
+		name    string
+		file    string
+		wantErr string
+		matches map[string]bool
+	}{
+		{
+			name: "labels and regex",
+			file: `
+name: network-smoke
+description: A quick check of networking.
+include:
+- labels: [sig-network, Conformance]
+- regex: 'Services should serve'
+exclude:
+- labels: [Serial]
+parallelism: 5
+testTimeout: 20m
+`,
+			matches: map[string]bool{
+				"[sig-network] DNS works [Conformance] [Suite:openshift/conformance/parallel]": true,
+				"[sig-network] Services should serve a basic endpoint [Suite:k8s]":             true,
+				"[sig-network] Services should serve on a node port [Serial] [Suite:k8s]":      false,
+				"[sig-network] DNS works [Suite:k8s]":                                          false,
+				"[sig-cli] oc works [Conformance] [Suite:openshift/conformance/parallel]":      false,
+			},
+		},
+		{
+			name: "no include matches every test",
+			file: `
+name: everything-parallel
+exclude:
+- regex: '\[Serial\]'
+`,
+			matches: map[string]bool{
+				"[sig-cli] oc works [Suite:openshift/conformance/parallel]":                    true,
+				"[sig-cli] oc works [Serial] [Suite:openshift/conformance]":                    false,
+				"[sig-cli] oc breaks [Disabled:Broken] [Suite:openshift/conformance/parallel]": false,
+			},
+		},
+		{name: "no name", file: `include: [{labels: [sig-network]}]`, wantErr: "has no name"},
+		{name: "empty qualifier", file: "name: a\ninclude: [{}]", wantErr: "neither labels nor a regex"},
+		{name: "invalid regex", file: "name: a\ninclude: [{regex: '('}]", wantErr: "qualifier 0 is invalid"},
+		{name: "invalid timeout", file: "name: a\ntestTimeout: soon", wantErr: "testTimeout of suite a is invalid"},
+		{name: "negative serial budget", file: "name: a\nmaxSerialTests: -1", wantErr: "maxSerialTests of suite a must not be negative"},
+		{name: "unknown field", file: "name: a\nqualifiers: []", wantErr: "unable to parse suite file"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "suite.yaml")
+			if err := os.WriteFile(path, []byte(test.file), 0644); err != nil {
+				t.Fatal(err)
+			}
+			suite, err := LoadSuiteFile(path)
+			if len(test.wantErr) > 0 {
+				if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", test.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			for name, want := range test.matches {
+				if got := suite.Matches(name); got != want {
+					t.Errorf("%s: expected match %t, got %t", name, want, got)
+				}
+			}
+		})
+	}
+}
+
+func TestSelectSuiteFromFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "suite.yaml")
+	if err := os.WriteFile(path, []byte("name: custom\nparallelism: 3\ntestTimeout: 5m\ninclude: [{labels: [sig-cli]}]\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	builtIn := []*TestSuite{{Name: "openshift/conformance", Matches: func(string) bool { return true }}}
+
+	for _, args := range [][]string{nil, {"custom"}} {
+		opt := &Options{SuiteFile: path, ErrOut: &bytes.Buffer{}}
+		suite, err := opt.SelectSuite(builtIn, args)
+		if err != nil {
+			t.Fatalf("%v: %v", args, err)
+		}
+		if suite.Name != "custom" || suite.Parallelism != 3 || suite.TestTimeout != 5*time.Minute {
+			t.Errorf("%v: unexpected suite %#v", args, suite)
+		}
+	}
+
+	opt := &Options{SuiteFile: path, ErrOut: &bytes.Buffer{}}
+	if _, err := opt.SelectSuite(builtIn, []string{"openshift/conformance"}); err == nil {
+		t.Errorf("expected an error when the suite does not match the suite file")
+	}
+}
+
+func TestLoadSuiteFileBudget(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "suite.yaml")
+	if err := os.WriteFile(path, []byte("name: custom\nmaxSerialTests: 2\nrequireSigLabel: true\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	suite, err := LoadSuiteFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if suite.Budget == nil || suite.Budget.MaxSerialTests != 2 || !suite.Budget.RequireSigLabel || suite.Budget.RequireTimeout {
+		t.Errorf("unexpected budget %#v", suite.Budget)
+	}
+
+	if err := os.WriteFile(path, []byte("name: custom\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if suite, err := LoadSuiteFile(path); err != nil || suite.Budget != nil {
+		t.Errorf("expected no budget, got %#v, %v", suite, err)
+	}
+}
@@ -9,6 +9,8 @@ import (
 	"time"
 
 	"github.com/onsi/ginkgo/v2/types"
+
+	"github.com/openshift/origin/pkg/test/ginkgo/runnerapi"
 )
 
 type testCase struct {
@@ -16,28 +18,55 @@ type testCase struct {
 	spec      types.TestSpec
 	locations []types.CodeLocation
 	apigroups []string
+	// annotations is the text appended to the test name by the generated annotation rules
+	annotations string
+	// metadata is recorded with the test by the registered annotators
+	metadata map[string]string
+	// assignedOwner is the owner of the test from the ownership mapping, if any
+	assignedOwner string
 
 	// identifies which tests can be run in parallel (ginkgo runs suites linearly)
 	testExclusion string
 	// specific timeout for the current test. When set, it overrides the current
 	// suite timeout
 	testTimeout time.Duration
+	// timeoutSource describes where testTimeout was set, when it was not set by a label on the test
+	timeoutSource string
+	// expectedFailure is the reason the test is expected to fail, set by the [ExpectedFailure] label
+	expectedFailure string
+	// quarantine is the pattern of the quarantine list that matches the test, if any
+	quarantine string
 
 	start           time.Time
 	end             time.Time
 	duration        time.Duration
 	testOutputBytes []byte
+	// resources is the resource usage of the process that ran the test
+	resources *testResources
+	// rerunCommand runs only this test again and is set when the test failed
+	rerunCommand string
+	// failureBundle is the directory holding the diagnostics of the failed test, if one was written
+	failureBundle string
+	// artifacts are the artifacts the test attached, relative to the junit dir
+	artifacts []string
 
 	flake    bool
 	failed   bool
 	skipped  bool
 	success  bool
 	timedOut bool
+	// failedAsExpected is set with success when a test expected to fail failed
+	failedAsExpected bool
+	// unexpectedPass is set with failed when a test expected to fail passed
+	unexpectedPass bool
 
 	previous *testCase
 }
 
-var re = regexp.MustCompile(`.*\[Timeout:(.[^\]]*)\]`)
+var (
+	re      = regexp.MustCompile(`.*\[Timeout:(.[^\]]*)\]`)
+	labelRe = regexp.MustCompile(`\[([^\[\]]+)\]`)
+)
 
 func newTestCaseFromGinkgoSpec(spec types.TestSpec) (*testCase, error) {
 	name := spec.Text()
@@ -64,16 +93,88 @@ func newTestCaseFromGinkgoSpec(spec types.TestSpec) (*testCase, error) {
 		tc.testTimeout = testTimeOut
 	}
 
+	if match := expectedFailureRe.FindStringSubmatch(name); match != nil {
+		tc.expectedFailure = match[1]
+	}
+
 	return tc, nil
 }
 
+// labels returns the bracketed tags in the test name, such as "sig-network" or "Serial", in the order
+// they appear.
+func (t *testCase) labels() []string {
+	var labels []string
+	for _, match := range labelRe.FindAllStringSubmatch(t.name, -1) {
+		labels = append(labels, match[1])
+	}
+	return labels
+}
+
+// attempt returns 1 for the first run of a test and increases with each retry.
+func (t *testCase) attempt() int {
+	attempt := 1
+	for previous := t.previous; previous != nil; previous = previous.previous {
+		attempt++
+	}
+	return attempt
+}
+
+// owner returns the owner assigned by the ownership mapping, or the sig that owns the test, or an
+// empty string if the test has neither.
+func (t *testCase) owner() string {
+	if len(t.assignedOwner) > 0 {
+		return t.assignedOwner
+	}
+	for _, label := range t.labels() {
+		if strings.HasPrefix(label, "sig-") {
+			return label
+		}
+	}
+	return ""
+}
+
+// id returns an identifier for the test that is stable across runs and releases for as long
+// as the name of the test does not change.
+func (t *testCase) id() string {
+	return runnerapi.SpecID(strings.TrimSuffix(t.name, t.annotations))
+}
+
+// state returns the outcome of the test, or TestUnknown if it has not completed.
+func (t *testCase) state() TestState {
+	switch {
+	case t.flake:
+		return TestFlaked
+	case t.timedOut:
+		return TestFailedTimeout
+	case t.unexpectedPass:
+		return TestUnexpectedPass
+	case t.failedAsExpected:
+		return TestFailedAsExpected
+	case t.failed:
+		return TestFailed
+	case t.skipped:
+		return TestSkipped
+	case t.success:
+		return TestSucceeded
+	}
+	return TestUnknown
+}
+
 func (t *testCase) Retry() *testCase {
 	copied := &testCase{
 		name:          t.name,
 		spec:          t.spec,
 		locations:     t.locations,
+		annotations:   t.annotations,
+		metadata:      t.metadata,
+		assignedOwner: t.assignedOwner,
 		testExclusion: t.testExclusion,
 
+		testTimeout:     t.testTimeout,
+		timeoutSource:   t.timeoutSource,
+		expectedFailure: t.expectedFailure,
+		quarantine:      t.quarantine,
+
 		previous: t,
 	}
 	return copied
@@ -96,6 +197,12 @@ type TestSuite struct {
 	SyntheticEventTests JUnitsForEvents
 
 	TestTimeout time.Duration
+	// TimeoutOverrides set the timeout of matching tests, for tests that routinely need longer than
+	// TestTimeout without raising it for the whole suite.
+	TimeoutOverrides []TestTimeoutOverride
+
+	// Budget, if set, is checked against the tests selected for the suite before any of them run.
+	Budget *SuiteBudget
 }
 
 func (s *TestSuite) Filter(tests []*testCase) []*testCase {
@@ -109,6 +216,29 @@ func (s *TestSuite) Filter(tests []*testCase) []*testCase {
 	return matches
 }
 
+// TestTimeoutOverride sets the timeout of the tests in a suite whose names match.
+type TestTimeoutOverride struct {
+	Matches func(name string) bool
+	Timeout time.Duration
+}
+
+// applyTimeoutOverrides sets the timeout of every test without a [Timeout] label from the first
+// override that matches it.
+func (s *TestSuite) applyTimeoutOverrides(tests []*testCase) {
+	for _, test := range tests {
+		if test.testTimeout != 0 {
+			continue
+		}
+		for i, override := range s.TimeoutOverrides {
+			if override.Matches(test.name) {
+				test.testTimeout = override.Timeout
+				test.timeoutSource = fmt.Sprintf("timeout override %d of suite %s", i+1, s.Name)
+				break
+			}
+		}
+	}
+}
+
 func matchTestsFromFile(suite *TestSuite, contents []byte) error {
 	tests := make(map[string]int)
 	for _, line := range strings.Split(string(contents), "\n") {
@@ -0,0 +1,105 @@
+package ginkgo
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+)
+
+// test2jsonEvent matches the events written by `go test -json` (see `go doc test2json`) so that
+// existing tooling for Go test output can consume suite runs.
+type test2jsonEvent struct {
+	Time    time.Time `json:",omitempty"`
+	Action  string
+	Package string   `json:",omitempty"`
+	Test    string   `json:",omitempty"`
+	Elapsed *float64 `json:",omitempty"`
+	Output  *string  `json:",omitempty"`
+}
+
+// test2jsonWriter writes a test2json event stream for the tests run by a suite. It is safe for
+// concurrent use, and a nil writer writes nothing.
+type test2jsonWriter struct {
+	lock    sync.Mutex
+	out     io.Writer
+	encoder *json.Encoder
+	pkg     string
+}
+
+func newTest2JSONWriter(out io.Writer, pkg string) *test2jsonWriter {
+	return &test2jsonWriter{
+		out:     out,
+		encoder: json.NewEncoder(out),
+		pkg:     pkg,
+	}
+}
+
+func (w *test2jsonWriter) TestStarted(testName string) {
+	if w == nil {
+		return
+	}
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	w.encoder.Encode(test2jsonEvent{Time: time.Now(), Action: "run", Package: w.pkg, Test: testName})
+}
+
+// TestEnded writes the output of the test, one event per line as go test does, followed by the result.
+func (w *test2jsonWriter) TestEnded(testRunResult *testRunResultHandle) {
+	if w == nil {
+		return
+	}
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	end := testRunResult.end
+	if end.IsZero() {
+		end = time.Now()
+	}
+	for _, line := range strings.SplitAfter(string(testRunResult.testOutputBytes), "\n") {
+		if len(line) == 0 {
+			continue
+		}
+		output := line
+		w.encoder.Encode(test2jsonEvent{Time: end, Action: "output", Package: w.pkg, Test: testRunResult.name, Output: &output})
+	}
+
+	action := "fail"
+	switch testRunResult.testState {
+	case TestSucceeded, TestFailedAsExpected:
+		action = "pass"
+	case TestSkipped:
+		action = "skip"
+	}
+	elapsed := testRunResult.end.Sub(testRunResult.start).Seconds()
+	w.encoder.Encode(test2jsonEvent{Time: end, Action: action, Package: w.pkg, Test: testRunResult.name, Elapsed: &elapsed})
+
+	// a flake failed and then passed within the same run, which tools that detect flakes by reruns of
+	// go test see as a failed run followed by a passing one
+	if testRunResult.testState == TestFlaked {
+		var none float64
+		w.encoder.Encode(test2jsonEvent{Time: end, Action: "run", Package: w.pkg, Test: testRunResult.name})
+		w.encoder.Encode(test2jsonEvent{Time: end, Action: "pass", Package: w.pkg, Test: testRunResult.name, Elapsed: &none})
+	}
+}
+
+// SuiteEnded writes the summary line and the result of the package, which go test writes after the
+// tests of the package, so tools waiting for the package to complete see the suite end.
+func (w *test2jsonWriter) SuiteEnded(passed bool, elapsed time.Duration) {
+	if w == nil {
+		return
+	}
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	now, seconds := time.Now(), elapsed.Seconds()
+	action, result, summary := "pass", "PASS\n", fmt.Sprintf("ok  \t%s\t%.3fs\n", w.pkg, seconds)
+	if !passed {
+		action, result, summary = "fail", "FAIL\n", fmt.Sprintf("FAIL\t%s\t%.3fs\n", w.pkg, seconds)
+	}
+	w.encoder.Encode(test2jsonEvent{Time: now, Action: "output", Package: w.pkg, Output: &result})
+	w.encoder.Encode(test2jsonEvent{Time: now, Action: "output", Package: w.pkg, Output: &summary})
+	w.encoder.Encode(test2jsonEvent{Time: now, Action: action, Package: w.pkg, Elapsed: &seconds})
+}
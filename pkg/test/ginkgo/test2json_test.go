@@ -0,0 +1,114 @@
+package ginkgo
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func Test_test2jsonWriter(t *testing.T) {
+	out := &bytes.Buffer{}
+	w := newTest2JSONWriter(out, "openshift-tests")
+
+	start := time.Now()
+	w.TestStarted("[sig-network] works")
+	w.TestEnded(&testRunResultHandle{&testRunResult{
+		name:            "[sig-network] works",
+		start:           start,
+		end:             start.Add(2 * time.Second),
+		testState:       TestSkipped,
+		testOutputBytes: []byte("first\nsecond\n"),
+	}})
+
+	var actions, outputs []string
+	scanner := bufio.NewScanner(out)
+	for scanner.Scan() {
+		event := test2jsonEvent{}
+		if err := json.Unmarshal(scanner.Bytes(), &event); err != nil {
+			t.Fatal(err)
+		}
+		if event.Package != "openshift-tests" || event.Test != "[sig-network] works" {
+			t.Errorf("unexpected event %#v", event)
+		}
+		actions = append(actions, event.Action)
+		if event.Output != nil {
+			outputs = append(outputs, *event.Output)
+		}
+		if event.Action == "skip" && (event.Elapsed == nil || *event.Elapsed != 2) {
+			t.Errorf("unexpected elapsed time %v", event.Elapsed)
+		}
+	}
+	if got, want := len(actions), 4; got != want {
+		t.Fatalf("got %d events (%v), want %d", got, actions, want)
+	}
+	if actions[0] != "run" || actions[3] != "skip" {
+		t.Errorf("unexpected actions %v", actions)
+	}
+	if outputs[0] != "first\n" || outputs[1] != "second\n" {
+		t.Errorf("unexpected output %q", outputs)
+	}
+}
+
+func readTest2JSONEvents(t *testing.T, out *bytes.Buffer) []test2jsonEvent {
+	var events []test2jsonEvent
+	scanner := bufio.NewScanner(out)
+	for scanner.Scan() {
+		event := test2jsonEvent{}
+		if err := json.Unmarshal(scanner.Bytes(), &event); err != nil {
+			t.Fatal(err)
+		}
+		events = append(events, event)
+	}
+	return events
+}
+
+func Test_test2jsonWriter_flake(t *testing.T) {
+	out := &bytes.Buffer{}
+	w := newTest2JSONWriter(out, "openshift-tests")
+
+	start := time.Now()
+	w.TestStarted("[sig-network] flaky")
+	w.TestEnded(&testRunResultHandle{&testRunResult{
+		name:            "[sig-network] flaky",
+		start:           start,
+		end:             start.Add(time.Second),
+		testState:       TestFlaked,
+		testOutputBytes: []byte("flake: timed out\n"),
+	}})
+
+	var actions []string
+	for _, event := range readTest2JSONEvents(t, out) {
+		actions = append(actions, event.Action)
+	}
+	if want := []string{"run", "output", "fail", "run", "pass"}; !reflect.DeepEqual(actions, want) {
+		t.Errorf("got actions %v, want %v", actions, want)
+	}
+}
+
+func Test_test2jsonWriter_SuiteEnded(t *testing.T) {
+	for _, passed := range []bool{true, false} {
+		out := &bytes.Buffer{}
+		newTest2JSONWriter(out, "openshift/conformance").SuiteEnded(passed, 1500*time.Millisecond)
+
+		events := readTest2JSONEvents(t, out)
+		if len(events) != 3 {
+			t.Fatalf("expected 3 events, got %#v", events)
+		}
+		final := events[2]
+		want := "pass"
+		if !passed {
+			want = "fail"
+		}
+		if final.Action != want || final.Package != "openshift/conformance" || len(final.Test) > 0 || final.Elapsed == nil || *final.Elapsed != 1.5 {
+			t.Errorf("unexpected package event %#v", final)
+		}
+		if summary := *events[1].Output; (passed && summary != "ok  \topenshift/conformance\t1.500s\n") || (!passed && summary != "FAIL\topenshift/conformance\t1.500s\n") {
+			t.Errorf("unexpected summary %q", summary)
+		}
+	}
+	var w *test2jsonWriter
+	w.SuiteEnded(true, time.Second)
+}
@@ -0,0 +1,112 @@
+package ginkgo
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// testEventRecorder records the outcome of every test as an Event in the cluster so that results are
+// visible alongside the cluster state the tests acted on. A nil recorder records nothing.
+type testEventRecorder struct {
+	client    kubernetes.Interface
+	namespace string
+	errOut    io.Writer
+}
+
+func newTestEventRecorder(client kubernetes.Interface, namespace string, errOut io.Writer) *testEventRecorder {
+	return &testEventRecorder{
+		client:    client,
+		namespace: namespace,
+		errOut:    errOut,
+	}
+}
+
+const (
+	// testEventTimeout bounds the time spent recording an event, so an unreachable cluster does not hold
+	// up the suite.
+	testEventTimeout = 15 * time.Second
+	// testEventMaxSummary is the longest failure summary included in the message of an event.
+	testEventMaxSummary = 1024
+	// testEventArtifactsAnnotation is set on the event of a test to the directory of its failure bundle
+	// or artifacts.
+	testEventArtifactsAnnotation = "testing.openshift.io/artifacts"
+)
+
+// recordTestResultInCluster records the outcome of test. The event of a test that failed includes the
+// summary of the failure, and the event of a test with a failure bundle or artifacts includes the
+// directory they were written to.
+func recordTestResultInCluster(ctx context.Context, testRunResult *testRunResultHandle, test *testCase, artifactDir string, recorder *testEventRecorder) {
+	if recorder == nil || testRunResult.testRunResult == nil {
+		return
+	}
+
+	reason, eventType := "TestUnknown", corev1.EventTypeWarning
+	switch testRunResult.testState {
+	case TestSucceeded:
+		reason, eventType = "TestPassed", corev1.EventTypeNormal
+	case TestSkipped:
+		reason, eventType = "TestSkipped", corev1.EventTypeNormal
+	case TestFlaked:
+		reason = "TestFlaked"
+	case TestFailed:
+		reason = "TestFailed"
+	case TestFailedTimeout:
+		reason = "TestTimedOut"
+	case TestFailedAsExpected:
+		reason, eventType = "TestFailedAsExpected", corev1.EventTypeNormal
+	case TestUnexpectedPass:
+		reason = "TestUnexpectedPass"
+	}
+
+	message := fmt.Sprintf("%s (%s)", testRunResult.name, testRunResult.duration())
+	if eventType == corev1.EventTypeWarning {
+		if summary := failureMessage(test); len(summary) > 0 {
+			if len(summary) > testEventMaxSummary {
+				summary = "..." + summary[len(summary)-testEventMaxSummary:]
+			}
+			message = fmt.Sprintf("%s: %s", message, summary)
+		}
+	}
+	artifacts := test.failureBundle
+	if len(artifacts) == 0 && len(test.artifacts) > 0 {
+		artifacts = testArtifactDir(artifactDir, test)
+	}
+	var annotations map[string]string
+	if len(artifacts) > 0 {
+		message = fmt.Sprintf("%s\nArtifacts: %s", message, artifacts)
+		annotations = map[string]string{testEventArtifactsAnnotation: artifacts}
+	}
+
+	now := metav1.Now()
+	event := &corev1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: "openshift-tests.",
+			Namespace:    recorder.namespace,
+			Annotations:  annotations,
+		},
+		InvolvedObject: corev1.ObjectReference{
+			APIVersion: "v1",
+			Kind:       "Namespace",
+			Name:       recorder.namespace,
+		},
+		Reason:         reason,
+		Message:        strings.ToValidUTF8(message, ""),
+		Type:           eventType,
+		Source:         corev1.EventSource{Component: "openshift-tests"},
+		FirstTimestamp: now,
+		LastTimestamp:  now,
+		Count:          1,
+	}
+	ctx, cancel := context.WithTimeout(ctx, testEventTimeout)
+	defer cancel()
+	if _, err := recorder.client.CoreV1().Events(recorder.namespace).Create(ctx, event, metav1.CreateOptions{}); err != nil {
+		fmt.Fprintf(recorder.errOut, "error: Unable to record test result event for %q: %v\n", testRunResult.name, err)
+	}
+}
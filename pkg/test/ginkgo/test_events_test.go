@@ -0,0 +1,58 @@
+package ginkgo
+
+import (
+	"context"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func Test_recordTestResultInCluster(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	recorder := newTestEventRecorder(client, "e2e-results", ioutil.Discard)
+
+	test := &testCase{
+		name:            "[sig-network] works",
+		testOutputBytes: []byte("STEP: dialing\nfail [github.com/openshift/origin/test/extended/networking/services.go:42]: connection refused\n"),
+		failureBundle:   "/artifacts/tests/abc/failure",
+	}
+	recordTestResultInCluster(context.TODO(), &testRunResultHandle{&testRunResult{name: test.name, testState: TestFailedTimeout}}, test, "/artifacts", recorder)
+	recordTestResultInCluster(context.TODO(), &testRunResultHandle{&testRunResult{name: test.name}}, test, "/artifacts", nil)
+
+	events, err := client.CoreV1().Events("e2e-results").List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events.Items) != 1 {
+		t.Fatalf("expected 1 event, got %d", len(events.Items))
+	}
+	if event := events.Items[0]; event.Reason != "TestTimedOut" || event.Type != "Warning" {
+		t.Errorf("unexpected event reason %q and type %q", event.Reason, event.Type)
+	}
+	event := events.Items[0]
+	if !strings.Contains(event.Message, "services.go:42]: connection refused") || !strings.HasSuffix(event.Message, "\nArtifacts: /artifacts/tests/abc/failure") {
+		t.Errorf("unexpected event message %q", event.Message)
+	}
+	if event.Annotations[testEventArtifactsAnnotation] != "/artifacts/tests/abc/failure" {
+		t.Errorf("unexpected event annotations %v", event.Annotations)
+	}
+}
+
+func Test_recordTestResultInClusterSuccess(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	recorder := newTestEventRecorder(client, "e2e-results", ioutil.Discard)
+
+	test := &testCase{name: "[sig-network] works", testOutputBytes: []byte("fail [a.go:1]: not the final result\n")}
+	recordTestResultInCluster(context.TODO(), &testRunResultHandle{&testRunResult{name: test.name, testState: TestSucceeded}}, test, "/artifacts", recorder)
+
+	events, err := client.CoreV1().Events("e2e-results").List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events.Items) != 1 || strings.Contains(events.Items[0].Message, "fail [") || len(events.Items[0].Annotations) > 0 {
+		t.Errorf("unexpected events %v", events.Items)
+	}
+}
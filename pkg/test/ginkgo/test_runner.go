@@ -14,6 +14,7 @@ import (
 
 	"github.com/openshift/origin/pkg/monitor"
 	"github.com/openshift/origin/pkg/monitor/monitorapi"
+	"github.com/openshift/origin/pkg/test/ginkgo/runnerapi"
 )
 
 type testSuiteRunner interface {
@@ -48,14 +49,33 @@ func (r *testSuiteRunnerImpl) RunOneTest(ctx context.Context, test *testCase) {
 		Message: "started",
 	})
 	defer recordTestResultInMonitor(testRunResult, r.testOutput.monitorRecorder)
+	defer recordTestResultInCluster(ctx, testRunResult, test, r.commandContext.artifactDir, r.testOutput.eventRecorder)
 
 	// log the results to systemout
 	r.testSuiteProgress.LogTestStart(r.testOutput.out, test.name)
+	r.testOutput.test2json.TestStarted(test.name)
+	defer r.testOutput.test2json.TestEnded(testRunResult)
+	r.testOutput.liveStatus.TestStarted(test.name)
+	defer r.testOutput.liveStatus.TestEnded(testRunResult)
+	r.testOutput.events.Publish(TestWillRun{Test: test.name})
+	defer r.testOutput.events.testDidRun(test, testRunResult, r.testSuiteProgress)
 	defer r.testSuiteProgress.TestEnded(test.name, testRunResult)
-	defer recordTestResultInLogWithoutOverlap(testRunResult, r.testOutput.testOutputLock, r.testOutput.out, r.testOutput.includeSuccessfulOutput)
+	defer recordTestResultInLogWithoutOverlap(testRunResult, r.testOutput.testOutputLock, r.testOutput.out, r.testOutput.includeSuccessfulOutput, r.testOutput.style)
 
-	testRunResult.testRunResult = r.commandContext.RunTestInNewProcess(ctx, test)
+	testRunResult.testRunResult = interceptTest(ctx, r.commandContext.interceptors, test, r.commandContext.RunTestInNewProcess)
+	applyExpectedFailure(test, testRunResult.testRunResult)
+	applyQuarantine(test, testRunResult.testRunResult)
 	mutateTestCaseWithResults(test, testRunResult)
+	test.artifacts = attachedArtifacts(r.commandContext.artifactDir, test)
+
+	if isTestFailed(test.state()) {
+		bundle, err := writeFailureBundle(testArtifactDir(r.commandContext.artifactDir, test), test, r.commandContext.env)
+		if err != nil {
+			fmt.Fprintf(r.testOutput.out, "error: Unable to write failure bundle for %q: %v\n", test.name, err)
+		}
+		test.failureBundle = bundle
+	}
+	runFailureHooks(ctx, r.commandContext.failureHooks, test, testArtifactDir(r.commandContext.artifactDir, test), r.testOutput.testOutputLock, r.testOutput.out)
 }
 
 func mutateTestCaseWithResults(test *testCase, testRunResult *testRunResultHandle) {
@@ -70,6 +90,7 @@ func mutateTestCaseWithResults(test *testCase, testRunResult *testRunResultHandl
 	test.duration = duration
 
 	test.testOutputBytes = testRunResult.testOutputBytes
+	test.resources = testRunResult.resources
 
 	switch testRunResult.testState {
 	case TestFlaked:
@@ -78,36 +99,64 @@ func mutateTestCaseWithResults(test *testCase, testRunResult *testRunResultHandl
 		test.skipped = false
 		test.success = false
 		test.timedOut = false
+		test.failedAsExpected = false
+		test.unexpectedPass = false
 	case TestSucceeded:
 		test.flake = false
 		test.failed = false
 		test.skipped = false
 		test.success = true
 		test.timedOut = false
+		test.failedAsExpected = false
+		test.unexpectedPass = false
 	case TestSkipped:
 		test.flake = false
 		test.failed = false
 		test.skipped = true
 		test.success = false
 		test.timedOut = false
+		test.failedAsExpected = false
+		test.unexpectedPass = false
 	case TestFailed:
 		test.flake = false
 		test.failed = true
 		test.skipped = false
 		test.success = false
 		test.timedOut = false
+		test.failedAsExpected = false
+		test.unexpectedPass = false
 	case TestFailedTimeout:
 		test.flake = false
 		test.failed = true
 		test.skipped = false
 		test.success = false
 		test.timedOut = true
+		test.failedAsExpected = false
+		test.unexpectedPass = false
+	case TestFailedAsExpected:
+		test.flake = false
+		test.failed = false
+		test.skipped = false
+		test.success = true
+		test.timedOut = false
+		test.failedAsExpected = true
+		test.unexpectedPass = false
+	case TestUnexpectedPass:
+		test.flake = false
+		test.failed = true
+		test.skipped = false
+		test.success = false
+		test.timedOut = false
+		test.failedAsExpected = false
+		test.unexpectedPass = true
 	case TestUnknown:
 		test.flake = false
 		test.failed = true
 		test.skipped = false
 		test.success = false
 		test.timedOut = false
+		test.failedAsExpected = false
+		test.unexpectedPass = false
 	default:
 		panic("unhandled test case state")
 	}
@@ -116,6 +165,17 @@ func mutateTestCaseWithResults(test *testCase, testRunResult *testRunResultHandl
 type commandContext struct {
 	env     []string
 	timeout time.Duration
+	// deadlineWarning, if set, is how long before its timeout a test is warned that the deadline is near
+	deadlineWarning time.Duration
+	// artifactDir, if set, is the directory under which each test is given its own artifact directory
+	artifactDir string
+	// failureHooks are invoked after a test fails
+	failureHooks []FailureHook
+	// interceptors wrap the execution of every test, the first is outermost
+	interceptors []TestInterceptor
+	// pauseOnFailure, if set, is the longest a failed test waits before its cleanup, see
+	// runnerapi.PauseOnFailureEnv
+	pauseOnFailure time.Duration
 
 	testOutputConfig testOutputConfig
 }
@@ -124,6 +184,11 @@ type testOutputConfig struct {
 	testOutputLock  *sync.Mutex
 	out             io.Writer
 	monitorRecorder monitor.Recorder
+	eventRecorder   *testEventRecorder
+	test2json       *test2jsonWriter
+	style           statusStyler
+	liveStatus      *liveStatus
+	events          *EventBus
 
 	includeSuccessfulOutput bool
 }
@@ -138,6 +203,7 @@ type testRunResult struct {
 	end             time.Time
 	testState       TestState
 	testOutputBytes []byte
+	resources       *testResources
 }
 
 func (r testRunResult) duration() time.Duration {
@@ -157,6 +223,10 @@ const (
 	TestFlaked        TestState = "Flaked"
 	TestSkipped       TestState = "Skipped"
 	TestUnknown       TestState = "Unknown"
+	// TestFailedAsExpected is a test labeled [ExpectedFailure] that failed, which counts as a pass
+	TestFailedAsExpected TestState = "FailedAsExpected"
+	// TestUnexpectedPass is a test labeled [ExpectedFailure] that passed, which counts as a failure
+	TestUnexpectedPass TestState = "UnexpectedPass"
 )
 
 func isTestFailed(testState TestState) bool {
@@ -167,25 +237,36 @@ func isTestFailed(testState TestState) bool {
 		return false
 	case TestSkipped:
 		return false
+	case TestFailedAsExpected:
+		return false
 	}
 	return true
 }
 
 // testOutputLock prevents parallel tests from interleaving their output.
-func newTestOutputConfig(testOutputLock *sync.Mutex, out io.Writer, monitorRecorder monitor.Recorder, includeSuccessfulOutput bool) testOutputConfig {
+func newTestOutputConfig(testOutputLock *sync.Mutex, out io.Writer, monitorRecorder monitor.Recorder, eventRecorder *testEventRecorder, test2json *test2jsonWriter, style statusStyler, liveStatus *liveStatus, events *EventBus, includeSuccessfulOutput bool) testOutputConfig {
 	return testOutputConfig{
 		testOutputLock:          testOutputLock,
 		out:                     out,
 		monitorRecorder:         monitorRecorder,
+		eventRecorder:           eventRecorder,
+		test2json:               test2json,
+		style:                   style,
+		liveStatus:              liveStatus,
+		events:                  events,
 		includeSuccessfulOutput: includeSuccessfulOutput,
 	}
 }
 
 // construction provided so that if we add anything, we get a compile failure for all callers instead of weird behavior
-func newCommandContext(env []string, timeout time.Duration) *commandContext {
+func newCommandContext(env []string, timeout, deadlineWarning time.Duration, artifactDir string, failureHooks []FailureHook, interceptors []TestInterceptor) *commandContext {
 	return &commandContext{
-		env:     env,
-		timeout: timeout,
+		env:             env,
+		timeout:         timeout,
+		deadlineWarning: deadlineWarning,
+		artifactDir:     artifactDir,
+		failureHooks:    failureHooks,
+		interceptors:    interceptors,
 	}
 }
 
@@ -199,26 +280,40 @@ func (c *commandContext) commandString(test *testCase) string {
 	return buf.String()
 }
 
-func recordTestResultInLogWithoutOverlap(testRunResult *testRunResultHandle, testOutputLock *sync.Mutex, out io.Writer, includeSuccessfulOutput bool) {
+// rerunCommands records on every failed test the command that runs only that test again, and returns
+// one command for each distinct failed test.
+func rerunCommands(c *commandContext, tests []*testCase) []string {
+	var commands []string
+	for _, test := range tests {
+		if !test.failed {
+			continue
+		}
+		test.rerunCommand = c.commandString(test)
+		commands = append(commands, test.rerunCommand)
+	}
+	return uniqueStrings(commands)
+}
+
+func recordTestResultInLogWithoutOverlap(testRunResult *testRunResultHandle, testOutputLock *sync.Mutex, out io.Writer, includeSuccessfulOutput bool, style statusStyler) {
 	testOutputLock.Lock()
 	defer testOutputLock.Unlock()
 
-	recordTestResultInLog(testRunResult, out, includeSuccessfulOutput)
+	recordTestResultInLog(testRunResult, out, includeSuccessfulOutput, style)
 }
 
-func recordTestResultInLog(testRunResult *testRunResultHandle, out io.Writer, includeSuccessfulOutput bool) {
+func recordTestResultInLog(testRunResult *testRunResultHandle, out io.Writer, includeSuccessfulOutput bool, style statusStyler) {
 	// output the status of the test
 	switch testRunResult.testState {
 	case TestFlaked:
 		out.Write(testRunResult.testOutputBytes)
 		fmt.Fprintln(out)
-		fmt.Fprintf(out, "flaked: (%s) %s %q\n\n", testRunResult.duration(), testRunResult.end.UTC().Format("2006-01-02T15:04:05"), testRunResult.name)
+		fmt.Fprintf(out, "%s (%s) %s %q\n\n", style(TestFlaked, "flaked:"), testRunResult.duration(), testRunResult.end.UTC().Format("2006-01-02T15:04:05"), testRunResult.name)
 	case TestSucceeded:
 		if includeSuccessfulOutput {
 			out.Write(testRunResult.testOutputBytes)
 			fmt.Fprintln(out)
 		}
-		fmt.Fprintf(out, "passed: (%s) %s %q\n\n", testRunResult.duration(), testRunResult.end.UTC().Format("2006-01-02T15:04:05"), testRunResult.name)
+		fmt.Fprintf(out, "%s (%s) %s %q\n\n", style(TestSucceeded, "passed:"), testRunResult.duration(), testRunResult.end.UTC().Format("2006-01-02T15:04:05"), testRunResult.name)
 	case TestSkipped:
 		if includeSuccessfulOutput {
 			out.Write(testRunResult.testOutputBytes)
@@ -230,11 +325,21 @@ func recordTestResultInLog(testRunResult *testRunResultHandle, out io.Writer, in
 				fmt.Fprintln(out)
 			}
 		}
-		fmt.Fprintf(out, "skipped: (%s) %s %q\n\n", testRunResult.duration(), testRunResult.end.UTC().Format("2006-01-02T15:04:05"), testRunResult.name)
+		fmt.Fprintf(out, "%s (%s) %s %q\n\n", style(TestSkipped, "skipped:"), testRunResult.duration(), testRunResult.end.UTC().Format("2006-01-02T15:04:05"), testRunResult.name)
+	case TestFailedAsExpected:
+		if includeSuccessfulOutput {
+			out.Write(testRunResult.testOutputBytes)
+			fmt.Fprintln(out)
+		}
+		fmt.Fprintf(out, "%s (%s) %s %q\n\n", style(TestFailedAsExpected, "failed as expected:"), testRunResult.duration(), testRunResult.end.UTC().Format("2006-01-02T15:04:05"), testRunResult.name)
+	case TestUnexpectedPass:
+		out.Write(testRunResult.testOutputBytes)
+		fmt.Fprintln(out)
+		fmt.Fprintf(out, "%s (%s) %s %q\n\n", style(TestUnexpectedPass, "unexpected pass:"), testRunResult.duration(), testRunResult.end.UTC().Format("2006-01-02T15:04:05"), testRunResult.name)
 	case TestFailed, TestFailedTimeout:
 		out.Write(testRunResult.testOutputBytes)
 		fmt.Fprintln(out)
-		fmt.Fprintf(out, "failed: (%s) %s %q\n\n", testRunResult.duration(), testRunResult.end.UTC().Format("2006-01-02T15:04:05"), testRunResult.name)
+		fmt.Fprintf(out, "%s (%s) %s %q\n\n", style(testRunResult.testState, "failed:"), testRunResult.duration(), testRunResult.end.UTC().Format("2006-01-02T15:04:05"), testRunResult.name)
 	default:
 		out.Write(testRunResult.testOutputBytes)
 		fmt.Fprintln(out)
@@ -262,6 +367,12 @@ func recordTestResultInMonitor(testRunResult *testRunResultHandle, monitorRecord
 	case TestFailedTimeout:
 		eventMessage = "finishedStatus/Failed  reason/Timeout"
 		eventLevel = monitorapi.Error
+	case TestFailedAsExpected:
+		eventMessage = "finishedStatus/FailedAsExpected"
+		eventLevel = monitorapi.Info
+	case TestUnexpectedPass:
+		eventMessage = "finishedStatus/Failed  reason/UnexpectedPass"
+		eventLevel = monitorapi.Error
 	default:
 		eventMessage = fmt.Sprintf("finishedStatus/Failed  reason/%s", testRunResult.testState)
 		eventLevel = monitorapi.Error
@@ -290,16 +401,35 @@ func (c *commandContext) RunTestInNewProcess(ctx context.Context, test *testCase
 	ret.start = time.Now()
 	command := exec.Command(os.Args[0], "run-test", test.name)
 	command.Env = append(os.Environ(), c.env...)
+	if dir := testArtifactDir(c.artifactDir, test); len(dir) > 0 {
+		if err := os.MkdirAll(dir, 0755); err == nil {
+			command.Env = append(command.Env, fmt.Sprintf("TEST_ARTIFACT_DIR=%s", dir))
+		}
+	}
 
 	timeout := c.timeout
 	if test.testTimeout != 0 {
 		timeout = test.testTimeout
 	}
+	if c.pauseOnFailure > 0 {
+		// the output of the test is only printed once it exits, so the test tells the operator that it
+		// paused on the stderr of this process and reads the choice of the operator from its stdin
+		command.Env = append(command.Env,
+			fmt.Sprintf("%s=%s", runnerapi.PauseOnFailureEnv, c.pauseOnFailure),
+			fmt.Sprintf("%s=3", runnerapi.PauseOutputFDEnv),
+			fmt.Sprintf("%s=4", runnerapi.PauseInputFDEnv),
+		)
+		command.ExtraFiles = []*os.File{os.Stderr, os.Stdin}
+		if timeout > 0 {
+			timeout += c.pauseOnFailure
+		}
+	}
 
-	testOutputBytes, err := runWithTimeout(ctx, command, timeout)
+	testOutputBytes, err := runWithTimeout(ctx, command, timeout, c.deadlineWarning)
 	ret.end = time.Now()
+	ret.resources = processResources(command.ProcessState)
 
-	ret.testOutputBytes = testOutputBytes
+	ret.testOutputBytes = stripStyle(testOutputBytes)
 	if err == nil {
 		ret.testState = TestSucceeded
 		return ret
@@ -311,22 +441,7 @@ func (c *commandContext) RunTestInNewProcess(ctx context.Context, test *testCase
 	}
 
 	if exitErr, ok := err.(*exec.ExitError); ok {
-		switch exitErr.ProcessState.Sys().(syscall.WaitStatus).ExitStatus() {
-		case 1:
-			// failed
-			ret.testState = TestFailed
-		case 2:
-			// timeout (ABRT is an exit code 2)
-			ret.testState = TestFailedTimeout
-		case 3:
-			// skipped
-			ret.testState = TestSkipped
-		case 4:
-			// flaky, do not retry
-			ret.testState = TestFlaked
-		default:
-			ret.testState = TestUnknown
-		}
+		ret.testState = exitStatusTestState(exitErr.ProcessState.Sys().(syscall.WaitStatus).ExitStatus())
 		return ret
 	}
 
@@ -334,30 +449,94 @@ func (c *commandContext) RunTestInNewProcess(ctx context.Context, test *testCase
 	return ret
 }
 
-func runWithTimeout(ctx context.Context, c *exec.Cmd, timeout time.Duration) ([]byte, error) {
-	if timeout > 0 {
-		go func() {
+// exitStatusTestState returns the state of a test from the exit status of the run-test process that
+// ran it.
+func exitStatusTestState(status int) TestState {
+	switch status {
+	case 0:
+		return TestSucceeded
+	case 1:
+		// failed
+		return TestFailed
+	case 2:
+		// timeout (ABRT is an exit code 2)
+		return TestFailedTimeout
+	case 3:
+		// skipped
+		return TestSkipped
+	case 4:
+		// flaky, do not retry
+		return TestFlaked
+	default:
+		return TestUnknown
+	}
+}
+
+// runWithTimeout runs the command and returns its combined output. If timeout is set, the process is
+// interrupted once the timeout expires and aborted if it is still running a minute later. If warning is
+// shorter than the timeout, the process is sent SIGUSR2 that long before the timeout so the test can
+// report its progress. A single timer serves every deadline and is released as soon as the process
+// exits, so no timers or goroutines outlive the test.
+func runWithTimeout(ctx context.Context, c *exec.Cmd, timeout, warning time.Duration) ([]byte, error) {
+	if timeout <= 0 {
+		return c.CombinedOutput()
+	}
+
+	var out bytes.Buffer
+	c.Stdout = &out
+	c.Stderr = &out
+	if err := c.Start(); err != nil {
+		return out.Bytes(), err
+	}
+
+	exited := make(chan struct{})
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		first := timeout
+		if warning > 0 && warning < timeout {
+			first = timeout - warning
+		}
+		deadline := time.NewTimer(first)
+		defer deadline.Stop()
+
+		if first != timeout {
 			select {
-			// interrupt tests after timeout, and abort if they don't complete quick enough
-			case <-time.After(timeout):
-				if c.Process != nil {
-					c.Process.Signal(syscall.SIGINT)
-				}
-				// if the process appears to be hung a significant amount of time after the timeout
-				// send an ABRT so we get a stack dump
-				select {
-				case <-time.After(time.Minute):
-					if c.Process != nil {
-						c.Process.Signal(syscall.SIGABRT)
-					}
-				}
+			case <-exited:
+				return
 			case <-ctx.Done():
-				if c.Process != nil {
-					c.Process.Signal(syscall.SIGINT)
-				}
+				c.Process.Signal(syscall.SIGINT)
+				return
+			// let the test know its deadline is approaching
+			case <-deadline.C:
+				c.Process.Signal(syscall.SIGUSR2)
 			}
+			deadline.Reset(warning)
+		}
 
-		}()
-	}
-	return c.CombinedOutput()
+		select {
+		case <-exited:
+			return
+		case <-ctx.Done():
+			c.Process.Signal(syscall.SIGINT)
+			return
+		// interrupt tests after timeout, and abort if they don't complete quick enough
+		case <-deadline.C:
+			c.Process.Signal(syscall.SIGINT)
+		}
+
+		// if the process appears to be hung a significant amount of time after the timeout
+		// send an ABRT so we get a stack dump
+		deadline.Reset(time.Minute)
+		select {
+		case <-exited:
+		case <-deadline.C:
+			c.Process.Signal(syscall.SIGABRT)
+		}
+	}()
+
+	err := c.Wait()
+	close(exited)
+	<-stopped
+	return out.Bytes(), err
 }
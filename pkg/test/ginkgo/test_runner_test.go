@@ -0,0 +1,61 @@
+package ginkgo
+
+import (
+	"context"
+	"os/exec"
+	"strings"
+	"testing"
+	"time"
+)
+
+func Test_runWithTimeout(t *testing.T) {
+	tests := []struct {
+		name    string
+		command []string
+		timeout time.Duration
+		warning time.Duration
+		wantErr bool
+		want    string
+	}{
+		{name: "no timeout", command: []string{"echo", "hello"}, want: "hello\n"},
+		{name: "completes before timeout", command: []string{"echo", "hello"}, timeout: time.Minute, want: "hello\n"},
+		{name: "interrupted at timeout", command: []string{"sleep", "30"}, timeout: 100 * time.Millisecond, wantErr: true},
+		{name: "warned before timeout", command: []string{"/bin/sh", "-c", "trap 'echo warned; kill $!; exit 0' USR2; sleep 30 & wait"}, timeout: time.Minute, warning: time.Minute - 200*time.Millisecond, want: "warned\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := runWithTimeout(context.Background(), exec.Command(tt.command[0], tt.command[1:]...), tt.timeout, tt.warning)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("runWithTimeout() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && string(out) != tt.want {
+				t.Errorf("runWithTimeout() = %q, want %q", string(out), tt.want)
+			}
+		})
+	}
+}
+
+func Benchmark_runWithTimeout(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		if _, err := runWithTimeout(context.Background(), exec.Command("true"), 15*time.Minute, 0); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func Test_rerunCommands(t *testing.T) {
+	c := newCommandContext([]string{"TEST_SUITE_START_TIME=1"}, time.Minute, 0, "", nil, nil)
+	tests := []*testCase{
+		{name: "[sig-cli] a", failed: true},
+		{name: "[sig-cli] a", failed: true},
+		{name: "[sig-cli] b", success: true},
+	}
+
+	commands := rerunCommands(c, tests)
+	if len(commands) != 1 || !strings.HasPrefix(commands[0], `TEST_SUITE_START_TIME="1" `) || !strings.HasSuffix(commands[0], ` run-test "[sig-cli] a"`) {
+		t.Errorf("unexpected commands %q", commands)
+	}
+	if tests[0].rerunCommand != commands[0] || len(tests[2].rerunCommand) > 0 {
+		t.Errorf("expected only failed tests to record a rerun command: %#v", tests)
+	}
+}
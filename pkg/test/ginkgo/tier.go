@@ -0,0 +1,81 @@
+package ginkgo
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"k8s.io/kubernetes/openshift-hack/e2e/annotate"
+
+	"github.com/openshift/origin/test/extended/util/annotate/rules"
+)
+
+// TierUntiered selects the tests that the annotation rules assign to no stability tier.
+const TierUntiered = "none"
+
+var tierRe = regexp.MustCompile(`\[Tier:([^\]]+)\]`)
+
+// testTier returns the stability tier of a test, such as blocking, from its [Tier:<name>] label,
+// or TierUntiered.
+func testTier(name string) string {
+	if match := tierRe.FindStringSubmatch(name); match != nil {
+		return match[1]
+	}
+	return TierUntiered
+}
+
+// knownTiers returns the tiers assigned by the annotation rules, and TierUntiered.
+func knownTiers() []string {
+	tiers := []string{TierUntiered}
+	for _, testMaps := range []map[string][]string{annotate.TestMaps, rules.TestMaps} {
+		for label := range testMaps {
+			if match := tierRe.FindStringSubmatch(label); match != nil {
+				tiers = append(tiers, match[1])
+			}
+		}
+	}
+	sort.Strings(tiers)
+	return tiers
+}
+
+// validateTiers returns an error if a tier is not assigned by the annotation rules, so a misspelled
+// tier does not silently select no tests.
+func validateTiers(tiers ...[]string) error {
+	known := knownTiers()
+	for _, list := range tiers {
+		for _, tier := range list {
+			if i := sort.SearchStrings(known, tier); i == len(known) || known[i] != tier {
+				return fmt.Errorf("unknown tier %q, must be one of: %s", tier, strings.Join(known, ", "))
+			}
+		}
+	}
+	return nil
+}
+
+// filterByTier returns the tests whose tier is in include, if include is set, and not in exclude.
+func filterByTier(tests []*testCase, include, exclude []string) []*testCase {
+	if len(include) == 0 && len(exclude) == 0 {
+		return tests
+	}
+	has := func(tiers []string, tier string) bool {
+		for _, t := range tiers {
+			if t == tier {
+				return true
+			}
+		}
+		return false
+	}
+	selected := make([]*testCase, 0, len(tests))
+	for _, test := range tests {
+		tier := testTier(test.name)
+		if len(include) > 0 && !has(include, tier) {
+			continue
+		}
+		if has(exclude, tier) {
+			continue
+		}
+		selected = append(selected, test)
+	}
+	return selected
+}
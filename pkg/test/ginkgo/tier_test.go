@@ -0,0 +1,67 @@
+package ginkgo
+
+import (
+	"reflect"
+	"testing"
+)
+
+func Test_filterByTier(t *testing.T) {
+	tests := []*testCase{
+		{name: "[sig-test] blocking [Conformance] [Tier:blocking] [Suite:openshift/conformance/parallel]"},
+		{name: "[sig-test] informing [Tier:informing] [Suite:openshift/conformance/parallel]"},
+		{name: "[sig-test] untiered [Suite:openshift/conformance/parallel]"},
+	}
+	names := func(tests []*testCase) []string {
+		var names []string
+		for _, test := range tests {
+			names = append(names, test.name)
+		}
+		return names
+	}
+
+	for _, tt := range []struct {
+		name             string
+		include, exclude []string
+		want             []string
+	}{
+		{
+			name: "no tiers",
+			want: names(tests),
+		},
+		{
+			name:    "include blocking",
+			include: []string{"blocking"},
+			want:    names(tests[:1]),
+		},
+		{
+			name:    "exclude informing",
+			exclude: []string{"informing"},
+			want:    []string{tests[0].name, tests[2].name},
+		},
+		{
+			name:    "include untiered",
+			include: []string{TierUntiered},
+			want:    names(tests[2:]),
+		},
+		{
+			name:    "include and exclude the same tier",
+			include: []string{"blocking"},
+			exclude: []string{"blocking"},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := names(filterByTier(tests, tt.include, tt.exclude)); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func Test_validateTiers(t *testing.T) {
+	if err := validateTiers([]string{"blocking", TierUntiered}, []string{"informing"}); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if err := validateTiers(nil, []string{"blockng"}); err == nil {
+		t.Error("expected an error for an unknown tier")
+	}
+}
@@ -0,0 +1,96 @@
+package ginkgo
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"time"
+
+	"github.com/onsi/ginkgo/v2/types"
+)
+
+var (
+	// stepLineRe matches the STEP lines ginkgo writes, which end with a timestamp
+	stepLineRe = regexp.MustCompile(`^STEP: (.*) (\d\d/\d\d/\d\d \d\d:\d\d:\d\d(\.\d+)?)$`)
+	// logLineRe matches lines written by the e2e framework log functions, which start with a timestamp
+	logLineRe = regexp.MustCompile(`^([A-Z][a-z]{2} [ \d]\d \d\d:\d\d:\d\d\.\d{3}): (.*)$`)
+)
+
+// timelineEntry is a single line of the merged timeline.
+type timelineEntry struct {
+	time time.Time
+	test string
+	text string
+}
+
+// testTimeline merges the start and end of every test with the timestamped lines of its output into a
+// single chronological list, so interference between tests running in parallel processes can be seen
+// in one place. Output lines without a recognizable timestamp are omitted.
+func testTimeline(tests []*testCase) []timelineEntry {
+	var entries []timelineEntry
+	for _, test := range tests {
+		if test.start.IsZero() {
+			continue
+		}
+		id := test.id()
+		entries = append(entries, timelineEntry{time: test.start, test: id, text: fmt.Sprintf("started %q", test.name)})
+		scanner := bufio.NewScanner(bytes.NewReader(test.testOutputBytes))
+		scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+		for scanner.Scan() {
+			if t, text, ok := parseTimelineLine(scanner.Text(), test.start); ok {
+				entries = append(entries, timelineEntry{time: t, test: id, text: text})
+			}
+		}
+		if !test.end.IsZero() {
+			entries = append(entries, timelineEntry{time: test.end, test: id, text: fmt.Sprintf("%s %q", test.state(), test.name)})
+		}
+	}
+	sort.SliceStable(entries, func(i, j int) bool { return entries[i].time.Before(entries[j].time) })
+	return entries
+}
+
+// parseTimelineLine returns the time and text of an output line that carries a timestamp. Timestamps
+// without a year or zone take them from the start of the test.
+func parseTimelineLine(line string, start time.Time) (time.Time, string, bool) {
+	if m := stepLineRe.FindStringSubmatch(line); m != nil {
+		t, err := time.ParseInLocation(types.GINKGO_TIME_FORMAT, m[2], start.Location())
+		if err != nil {
+			return time.Time{}, "", false
+		}
+		return t, "STEP: " + m[1], true
+	}
+	if m := logLineRe.FindStringSubmatch(line); m != nil {
+		t, err := time.ParseInLocation(time.StampMilli, m[1], start.Location())
+		if err != nil {
+			return time.Time{}, "", false
+		}
+		return t.AddDate(start.Year(), 0, 0), m[2], true
+	}
+	return time.Time{}, "", false
+}
+
+func writeTimeline(out io.Writer, entries []timelineEntry) {
+	for _, entry := range entries {
+		fmt.Fprintf(out, "%s %s %s\n", entry.time.UTC().Format("2006-01-02T15:04:05.000Z"), entry.test, entry.text)
+	}
+}
+
+// writeTimelineToDir writes the merged timeline of the tests to a text file in dir.
+func writeTimelineToDir(dir, fileSuffix string, tests []*testCase) error {
+	f, err := os.Create(filepath.Join(dir, fmt.Sprintf("test-timeline%s.txt", fileSuffix)))
+	if err != nil {
+		return err
+	}
+	w := bufio.NewWriter(f)
+	writeTimeline(w, testTimeline(tests))
+	if err := w.Flush(); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
@@ -0,0 +1,40 @@
+package ginkgo
+
+import (
+	"bytes"
+	"testing"
+	"time"
+)
+
+func Test_testTimeline(t *testing.T) {
+	start := time.Date(2023, 5, 1, 10, 0, 0, 0, time.UTC)
+	a := &testCase{
+		name:  "[sig-cli] a",
+		start: start,
+		end:   start.Add(3 * time.Second),
+		testOutputBytes: []byte("STEP: creating a pod 05/01/23 10:00:02.5\n" +
+			"no timestamp here\n" +
+			"May  1 10:00:00.250: INFO: waiting\n"),
+		success: true,
+	}
+	b := &testCase{
+		name:            "[sig-cli] b",
+		start:           start.Add(time.Second),
+		end:             start.Add(2 * time.Second),
+		testOutputBytes: []byte("fail [b.go:1]: boom\n"),
+		failed:          true,
+	}
+
+	out := &bytes.Buffer{}
+	writeTimeline(out, testTimeline([]*testCase{a, b}))
+	want := "" +
+		"2023-05-01T10:00:00.000Z " + a.id() + " started \"[sig-cli] a\"\n" +
+		"2023-05-01T10:00:00.250Z " + a.id() + " INFO: waiting\n" +
+		"2023-05-01T10:00:01.000Z " + b.id() + " started \"[sig-cli] b\"\n" +
+		"2023-05-01T10:00:02.000Z " + b.id() + " Failed \"[sig-cli] b\"\n" +
+		"2023-05-01T10:00:02.500Z " + a.id() + " STEP: creating a pod\n" +
+		"2023-05-01T10:00:03.000Z " + a.id() + " Success \"[sig-cli] a\"\n"
+	if out.String() != want {
+		t.Errorf("unexpected timeline:\n%s\nwant:\n%s", out.String(), want)
+	}
+}
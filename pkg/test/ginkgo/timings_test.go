@@ -0,0 +1,202 @@
+package ginkgo
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+func TestNewCSVTimingSink(t *testing.T) {
+	start := time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)
+	first := &testCase{name: "[sig-network] a, b", failed: true, start: start, end: start.Add(time.Minute), duration: time.Minute}
+	retry := first.Retry()
+	retry.success = true
+	retry.start, retry.end, retry.duration = start.Add(2*time.Minute), start.Add(2*time.Minute+1500*time.Millisecond), 1500*time.Millisecond
+
+	out := &bytes.Buffer{}
+	if err := NewCSVTimingSink(out).WriteTimings(context.TODO(), testTimings([]*testCase{first, retry})); err != nil {
+		t.Fatal(err)
+	}
+
+	want := "name,id,owner,state,start,end,duration_seconds,attempt\n" +
+		`"[sig-network] a, b",` + first.id() + ",sig-network,Failed,2023-01-02T03:04:05Z,2023-01-02T03:05:05Z,60.000,1\n" +
+		`"[sig-network] a, b",` + first.id() + ",sig-network,Success,2023-01-02T03:06:05Z,2023-01-02T03:06:06.5Z,1.500,2\n"
+	if out.String() != want {
+		t.Errorf("unexpected csv:\n%s\nwant:\n%s", out.String(), want)
+	}
+}
+
+func TestNewClickHouseTimingSink(t *testing.T) {
+	withFastUploadBackoff(t)
+	start := time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)
+	test := &testCase{name: "[sig-network] a", success: true, start: start, end: start.Add(time.Second), duration: time.Second}
+
+	attempts := 0
+	var query, user, password string
+	var rows []map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if attempts++; attempts == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		query = r.URL.Query().Get("query")
+		user, password, _ = r.BasicAuth()
+		decoder := json.NewDecoder(r.Body)
+		for decoder.More() {
+			row := map[string]interface{}{}
+			if err := decoder.Decode(&row); err != nil {
+				t.Error(err)
+			}
+			rows = append(rows, row)
+		}
+	}))
+	defer server.Close()
+
+	sink, err := NewClickHouseTimingSink(strings.Replace(server.URL, "http://", "http://ci:secret@", 1)+"/e2e.test_timings", server.Client())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := sink.WriteTimings(context.TODO(), testTimings([]*testCase{test})); err != nil {
+		t.Fatal(err)
+	}
+	if query != "INSERT INTO e2e.test_timings FORMAT JSONEachRow" || user != "ci" || password != "secret" {
+		t.Errorf("unexpected query %q as %s:%s", query, user, password)
+	}
+	if len(rows) != 1 || rows[0]["name"] != "[sig-network] a" || rows[0]["state"] != "Success" || rows[0]["start"] != "2023-01-02T03:04:05Z" || rows[0]["duration_seconds"] != 1.0 || rows[0]["attempt"] != 1.0 {
+		t.Errorf("unexpected rows %v", rows)
+	}
+
+	for _, endpoint := range []string{"http://host:8123/", "http://host:8123/db.t;DROP", "tcp://host:9000/db.t"} {
+		if _, err := NewClickHouseTimingSink(endpoint, nil); err == nil {
+			t.Errorf("expected %s to be rejected", endpoint)
+		}
+	}
+}
+
+func TestNewBigQueryTimingSink(t *testing.T) {
+	withFastUploadBackoff(t)
+	tests := []struct {
+		name     string
+		response string
+		wantErr  bool
+	}{
+		{name: "inserted", response: `{}`},
+		{name: "rejected rows", response: `{"insertErrors":[{"index":0,"errors":[{"reason":"invalid","message":"no such field: owner"}]}]}`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var batches []bigQueryInsertRequest
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				var insert bigQueryInsertRequest
+				if err := json.NewDecoder(r.Body).Decode(&insert); err != nil {
+					t.Error(err)
+				}
+				batches = append(batches, insert)
+				w.Write([]byte(tt.response))
+			}))
+			defer server.Close()
+
+			var tests []*testCase
+			for i := 0; i < bigQueryBatchSize+1; i++ {
+				tests = append(tests, &testCase{name: strings.Repeat("a", i+1), success: true})
+			}
+			err := NewBigQueryTimingSink(server.URL, server.Client()).WriteTimings(context.TODO(), testTimings(tests))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.wantErr {
+				if len(batches) != 1 {
+					t.Errorf("expected rejected rows to stop the export, got %d requests", len(batches))
+				}
+				return
+			}
+			if len(batches) != 2 || len(batches[0].Rows) != bigQueryBatchSize || len(batches[1].Rows) != 1 {
+				t.Fatalf("unexpected batches %d", len(batches))
+			}
+			if row := batches[1].Rows[0]; row.JSON["name"] != tests[bigQueryBatchSize].name || len(row.InsertID) == 0 {
+				t.Errorf("unexpected row %v", row)
+			}
+		})
+	}
+}
+
+func TestNewTimingSink(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "timings.csv")
+	sink, err := NewTimingSink("csv=" + path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := sink.WriteTimings(context.TODO(), nil); err != nil {
+		t.Fatal(err)
+	}
+	if data, err := ioutil.ReadFile(path); err != nil || !strings.HasPrefix(string(data), "name,id,") {
+		t.Errorf("unexpected csv %q: %v", string(data), err)
+	}
+	// later calls append rows to the same file
+	for _, name := range []string{"a", "b"} {
+		if err := sink.WriteTimings(context.TODO(), []TestTiming{{Name: name, State: TestSucceeded}}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if data, err := ioutil.ReadFile(path); err != nil || strings.Count(string(data), "name,id,") != 1 || !strings.Contains(string(data), "\na,") || !strings.Contains(string(data), "\nb,") {
+		t.Errorf("unexpected csv %q: %v", string(data), err)
+	}
+
+	for _, spec := range []string{"csv", "csv=", "parquet=/tmp/t", "bigquery=dataset.table", "clickhouse=http://host/"} {
+		if _, err := NewTimingSink(spec); err == nil {
+			t.Errorf("expected %s to be rejected", spec)
+		}
+	}
+}
+
+func TestTimingStream(t *testing.T) {
+	var lock sync.Mutex
+	var written []string
+	release := make(chan struct{})
+	sink := TimingSinkFunc(func(ctx context.Context, timings []TestTiming) error {
+		<-release
+		lock.Lock()
+		defer lock.Unlock()
+		for _, timing := range timings {
+			written = append(written, timing.Name)
+		}
+		return nil
+	})
+
+	errOut := &bytes.Buffer{}
+	stream := newTimingStream([]TimingSink{sink}, errOut)
+	stream.observe(SuiteWillBegin{Suite: "suite"})
+	stream.observe(TestDidRun{Test: "a", Timing: TestTiming{Name: "a"}})
+	stream.observe(TestDidRun{Test: "b", Timing: TestTiming{Name: "b"}})
+	close(release)
+
+	// the first rows are written without waiting for the end of the suite
+	if err := wait.PollImmediate(10*time.Millisecond, wait.ForeverTestTimeout, func() (bool, error) {
+		lock.Lock()
+		defer lock.Unlock()
+		return len(written) == 2, nil
+	}); err != nil {
+		t.Fatalf("the timings were not streamed: %v", written)
+	}
+
+	stream.observe(TestDidRun{Test: "c", Timing: TestTiming{Name: "c"}})
+	stream.Flush(context.TODO())
+	stream.observe(TestDidRun{Test: "d", Timing: TestTiming{Name: "d"}})
+	if want := []string{"a", "b", "c"}; !reflect.DeepEqual(written, want) {
+		t.Errorf("expected %v, got %v", want, written)
+	}
+	if errOut.Len() > 0 {
+		t.Errorf("unexpected errors: %s", errOut.String())
+	}
+}
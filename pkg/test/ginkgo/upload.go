@@ -0,0 +1,290 @@
+package ginkgo
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/session"
+	v4 "github.com/aws/aws-sdk-go/aws/signer/v4"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/util/retry"
+
+	"github.com/openshift/origin/pkg/test/ginkgo/junitapi"
+)
+
+// ResultUploader publishes the results of a suite run once all reports have been written to the
+// junit dir. Implementations must tolerate being invoked with an empty junit dir.
+type ResultUploader interface {
+	UploadResults(ctx context.Context, junitDir string, results *junitapi.JUnitTestSuite) error
+}
+
+// ResultUploaderFunc converts a function into the ResultUploader interface.
+type ResultUploaderFunc func(ctx context.Context, junitDir string, results *junitapi.JUnitTestSuite) error
+
+func (fn ResultUploaderFunc) UploadResults(ctx context.Context, junitDir string, results *junitapi.JUnitTestSuite) error {
+	return fn(ctx, junitDir, results)
+}
+
+// dryRunResultUploader is an uploader defined by a function, with the dry run of a DryRunner.
+type dryRunResultUploader struct {
+	ResultUploaderFunc
+	dryRun func(ctx context.Context) (string, error)
+}
+
+func (u dryRunResultUploader) DryRun(ctx context.Context) (string, error) {
+	return u.dryRun(ctx)
+}
+
+// uploadBackoff retries an upload that failed with a temporary error four times, waiting 2s, 4s,
+// 8s, and 16s in between, and uploadTimeout bounds each attempt.
+var (
+	uploadBackoff = wait.Backoff{Steps: 5, Duration: 2 * time.Second, Factor: 2, Jitter: 0.1}
+	uploadTimeout = 2 * time.Minute
+)
+
+// uploadError is an upload that failed in a way that retrying will not fix, such as a rejected request.
+type uploadError struct {
+	err error
+}
+
+func (e uploadError) Error() string {
+	return e.err.Error()
+}
+
+// uploadWithRetry calls upload until it succeeds, it fails with an uploadError, ctx is done, or
+// uploadBackoff is exhausted. Each attempt is bounded by uploadTimeout.
+func uploadWithRetry(ctx context.Context, upload func(ctx context.Context) error) error {
+	return retry.OnError(uploadBackoff, func(err error) bool {
+		return ctx.Err() == nil && !errors.As(err, &uploadError{})
+	}, func() error {
+		attemptCtx, cancel := context.WithTimeout(ctx, uploadTimeout)
+		defer cancel()
+		return upload(attemptCtx)
+	})
+}
+
+// doUpload sends req and returns an uploadError if the server rejected it. Server errors and throttling
+// are returned as temporary errors.
+func doUpload(client *http.Client, req *http.Request) error {
+	_, err := doUploadRequest(client, req)
+	return err
+}
+
+// doUploadRequest is doUpload that returns the body of a successful response.
+func doUploadRequest(client *http.Client, req *http.Request) ([]byte, error) {
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return ioutil.ReadAll(resp.Body)
+	}
+	body, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 1024))
+	err = fmt.Errorf("%s %s returned %s: %s", req.Method, req.URL.Redacted(), resp.Status, strings.TrimSpace(string(body)))
+	if resp.StatusCode >= 500 || resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusRequestTimeout {
+		return nil, err
+	}
+	return nil, uploadError{err: err}
+}
+
+// NewResultUploader returns the uploader for destination: an http or https URL the results are posted
+// to, or an s3://bucket/prefix URL the reports are written under. s3Endpoint, if set, is the URL of
+// S3-compatible object storage to use instead of AWS.
+func NewResultUploader(destination, s3Endpoint string) (ResultUploader, error) {
+	u, err := url.Parse(destination)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse the result upload destination: %v", err)
+	}
+	switch u.Scheme {
+	case "http", "https":
+		return NewHTTPResultUploader(destination, http.DefaultClient), nil
+	case "s3":
+		if len(u.Host) == 0 {
+			return nil, fmt.Errorf("the result upload destination %s has no bucket", destination)
+		}
+		return NewS3ResultUploader(u.Host, strings.Trim(u.Path, "/"), s3Endpoint)
+	default:
+		return nil, fmt.Errorf("the result upload destination %s must be an http, https, or s3 URL", destination)
+	}
+}
+
+// NewHTTPResultUploader returns an uploader that posts the results of the suite to destination as a
+// JUnit XML report. Requests that fail with a network error, a server error, or throttling are retried
+// with a backoff.
+func NewHTTPResultUploader(destination string, client *http.Client) ResultUploader {
+	upload := ResultUploaderFunc(func(ctx context.Context, _ string, results *junitapi.JUnitTestSuite) error {
+		if results == nil {
+			return nil
+		}
+		data, err := xml.Marshal(results)
+		if err != nil {
+			return err
+		}
+		return uploadWithRetry(ctx, func(ctx context.Context) error {
+			req, err := http.NewRequestWithContext(ctx, http.MethodPost, destination, bytes.NewReader(data))
+			if err != nil {
+				return uploadError{err: err}
+			}
+			req.Header.Set("Content-Type", "application/xml")
+			return doUpload(client, req)
+		})
+	})
+	return dryRunResultUploader{ResultUploaderFunc: upload, dryRun: func(context.Context) (string, error) {
+		u, err := url.Parse(destination)
+		if err != nil {
+			return "", fmt.Errorf("unable to parse the result upload destination: %v", err)
+		}
+		if (u.Scheme != "http" && u.Scheme != "https") || len(u.Host) == 0 {
+			return "", fmt.Errorf("the result upload destination %s must be an http or https URL", u.Redacted())
+		}
+		return fmt.Sprintf("post the JUnit XML results to %s", u.Redacted()), nil
+	}}
+}
+
+// s3ResultUploader writes the top level files of the junit dir to a bucket with path-style requests
+// signed with AWS Signature Version 4, which AWS and S3-compatible object storage accept.
+type s3ResultUploader struct {
+	bucket   string
+	prefix   string
+	endpoint string
+	region   string
+	signer   *v4.Signer
+	client   *http.Client
+}
+
+// NewS3ResultUploader returns an uploader that writes the top level files of the junit dir, such as
+// the JUnit XML and run summaries, to the bucket under prefix. Credentials and the region are read
+// like the AWS CLI does, from the environment, the shared configuration, or the instance role.
+// endpoint defaults to the regional AWS endpoint. Failed writes are retried with a backoff.
+func NewS3ResultUploader(bucket, prefix, endpoint string) (ResultUploader, error) {
+	sess, err := session.NewSessionWithOptions(session.Options{SharedConfigState: session.SharedConfigEnable})
+	if err != nil {
+		return nil, fmt.Errorf("unable to load the credentials to upload results to s3: %v", err)
+	}
+	region := "us-east-1"
+	if sess.Config.Region != nil && len(*sess.Config.Region) > 0 {
+		region = *sess.Config.Region
+	}
+	if len(endpoint) == 0 {
+		endpoint = fmt.Sprintf("https://s3.%s.amazonaws.com", region)
+	}
+	return &s3ResultUploader{
+		bucket:   bucket,
+		prefix:   prefix,
+		endpoint: strings.TrimSuffix(endpoint, "/"),
+		region:   region,
+		signer:   v4.NewSigner(sess.Config.Credentials),
+		client:   http.DefaultClient,
+	}, nil
+}
+
+func (u *s3ResultUploader) UploadResults(ctx context.Context, junitDir string, _ *junitapi.JUnitTestSuite) error {
+	if len(junitDir) == 0 {
+		return nil
+	}
+	entries, err := os.ReadDir(junitDir)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		if !entry.Type().IsRegular() {
+			continue
+		}
+		data, err := ioutil.ReadFile(filepath.Join(junitDir, entry.Name()))
+		if err != nil {
+			return err
+		}
+		key := path.Join(u.prefix, entry.Name())
+		if err := uploadWithRetry(ctx, func(ctx context.Context) error {
+			return u.put(ctx, key, data)
+		}); err != nil {
+			return fmt.Errorf("unable to upload %s: %v", entry.Name(), err)
+		}
+	}
+	return nil
+}
+
+// DryRun checks that the credentials can be loaded.
+func (u *s3ResultUploader) DryRun(ctx context.Context) (string, error) {
+	if _, err := u.signer.Credentials.GetWithContext(ctx); err != nil {
+		return "", fmt.Errorf("unable to load the credentials to upload results to s3: %v", err)
+	}
+	return fmt.Sprintf("put the files of the junit dir to s3://%s/ through %s in %s", path.Join(u.bucket, u.prefix), u.endpoint, u.region), nil
+}
+
+// put uploads data as the object key. S3 rejects PUTs without a Content-Length, so the body is sent
+// from memory rather than streamed.
+func (u *s3ResultUploader) put(ctx context.Context, key string, data []byte) error {
+	body := bytes.NewReader(data)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("%s/%s/%s", u.endpoint, u.bucket, key), body)
+	if err != nil {
+		return uploadError{err: err}
+	}
+	req.ContentLength = int64(len(data))
+	if _, err := u.signer.Sign(req, body, "s3", u.region, time.Now()); err != nil {
+		return uploadError{err: err}
+	}
+	return doUpload(u.client, req)
+}
+
+// NewDirectoryResultUploader returns an uploader that copies the top level files of the junit dir,
+// such as the JUnit XML and run summaries, into destination.
+func NewDirectoryResultUploader(destination string) ResultUploader {
+	upload := ResultUploaderFunc(func(ctx context.Context, junitDir string, _ *junitapi.JUnitTestSuite) error {
+		if len(junitDir) == 0 {
+			return nil
+		}
+		if err := os.MkdirAll(destination, 0755); err != nil {
+			return err
+		}
+		entries, err := os.ReadDir(junitDir)
+		if err != nil {
+			return err
+		}
+		for _, entry := range entries {
+			if !entry.Type().IsRegular() {
+				continue
+			}
+			if err := copyFile(filepath.Join(junitDir, entry.Name()), filepath.Join(destination, entry.Name())); err != nil {
+				return fmt.Errorf("unable to copy %s: %v", entry.Name(), err)
+			}
+		}
+		return nil
+	})
+	return dryRunResultUploader{ResultUploaderFunc: upload, dryRun: func(context.Context) (string, error) {
+		if info, err := os.Stat(destination); err == nil && !info.IsDir() {
+			return "", fmt.Errorf("the result copy destination %s is not a directory", destination)
+		}
+		return fmt.Sprintf("copy the files of the junit dir to %s", destination), nil
+	}}
+}
+
+func copyFile(from, to string) error {
+	in, err := os.Open(from)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	out, err := os.OpenFile(to, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
@@ -0,0 +1,157 @@
+package ginkgo
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"github.com/openshift/origin/pkg/test/ginkgo/junitapi"
+	"github.com/openshift/origin/pkg/test/ginkgo/runnerapi"
+)
+
+func TestNewDirectoryResultUploader(t *testing.T) {
+	junitDir, destination := t.TempDir(), filepath.Join(t.TempDir(), "results")
+	if err := ioutil.WriteFile(filepath.Join(junitDir, "junit_e2e.xml"), []byte("<testsuite/>"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Mkdir(filepath.Join(junitDir, runnerapi.TestArtifactsDir), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := NewDirectoryResultUploader(destination).UploadResults(context.TODO(), junitDir, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := ioutil.ReadFile(filepath.Join(destination, "junit_e2e.xml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "<testsuite/>" {
+		t.Errorf("unexpected content %q", string(data))
+	}
+	if _, err := os.Stat(filepath.Join(destination, runnerapi.TestArtifactsDir)); !os.IsNotExist(err) {
+		t.Errorf("expected directories to be skipped, got %v", err)
+	}
+}
+
+func withFastUploadBackoff(t *testing.T) {
+	backoff := uploadBackoff
+	uploadBackoff = wait.Backoff{Steps: 3, Duration: time.Millisecond}
+	t.Cleanup(func() { uploadBackoff = backoff })
+}
+
+func TestNewHTTPResultUploader(t *testing.T) {
+	withFastUploadBackoff(t)
+	tests := []struct {
+		name     string
+		statuses []int
+		requests int
+		wantErr  bool
+	}{
+		{name: "succeeds", statuses: []int{http.StatusOK}, requests: 1},
+		{name: "server errors are retried", statuses: []int{http.StatusInternalServerError, http.StatusTooManyRequests, http.StatusCreated}, requests: 3},
+		{name: "retries are bounded", statuses: []int{http.StatusBadGateway, http.StatusBadGateway, http.StatusBadGateway, http.StatusOK}, requests: 3, wantErr: true},
+		{name: "rejected uploads are not retried", statuses: []int{http.StatusBadRequest, http.StatusOK}, requests: 1, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var lock sync.Mutex
+			var bodies []string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				lock.Lock()
+				defer lock.Unlock()
+				if r.Method != http.MethodPost || r.Header.Get("Content-Type") != "application/xml" {
+					t.Errorf("unexpected request %s with content type %q", r.Method, r.Header.Get("Content-Type"))
+				}
+				data, _ := ioutil.ReadAll(r.Body)
+				bodies = append(bodies, string(data))
+				w.WriteHeader(tt.statuses[len(bodies)-1])
+			}))
+			defer server.Close()
+
+			results := &junitapi.JUnitTestSuite{Name: "openshift-tests", NumTests: 1, TestCases: []*junitapi.JUnitTestCase{{Name: "[sig-cli] works"}}}
+			err := NewHTTPResultUploader(server.URL, server.Client()).UploadResults(context.TODO(), "", results)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(bodies) != tt.requests {
+				t.Fatalf("expected %d requests, got %d", tt.requests, len(bodies))
+			}
+			for _, body := range bodies {
+				if !strings.Contains(body, `<testcase name="[sig-cli] works"`) {
+					t.Errorf("unexpected body %s", body)
+				}
+			}
+		})
+	}
+}
+
+func TestS3ResultUploader(t *testing.T) {
+	withFastUploadBackoff(t)
+	t.Setenv("AWS_ACCESS_KEY_ID", "access")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "secret")
+	t.Setenv("AWS_REGION", "us-east-2")
+
+	junitDir := t.TempDir()
+	if err := ioutil.WriteFile(filepath.Join(junitDir, "junit_e2e.xml"), []byte("<testsuite/>"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Mkdir(filepath.Join(junitDir, runnerapi.TestArtifactsDir), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	var lock sync.Mutex
+	uploaded := map[string]string{}
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+		if attempts++; attempts == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		if r.Method != http.MethodPut {
+			t.Errorf("unexpected method %s", r.Method)
+		}
+		if auth := r.Header.Get("Authorization"); !strings.Contains(auth, "Credential=access/") || !strings.Contains(auth, "/us-east-2/s3/aws4_request") {
+			t.Errorf("unexpected authorization %q", auth)
+		}
+		if len(r.Header.Get("X-Amz-Content-Sha256")) == 0 {
+			t.Errorf("the request has no content hash")
+		}
+		data, _ := ioutil.ReadAll(r.Body)
+		if r.ContentLength != int64(len(data)) || len(r.TransferEncoding) > 0 {
+			t.Errorf("expected a Content-Length of %d without a transfer encoding, got %d %v", len(data), r.ContentLength, r.TransferEncoding)
+		}
+		uploaded[r.URL.Path] = string(data)
+	}))
+	defer server.Close()
+
+	uploader, err := NewResultUploader("s3://results/runs/1234", server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := uploader.UploadResults(context.TODO(), junitDir, nil); err != nil {
+		t.Fatal(err)
+	}
+	if len(uploaded) != 1 || uploaded["/results/runs/1234/junit_e2e.xml"] != "<testsuite/>" {
+		t.Errorf("unexpected uploads %v", uploaded)
+	}
+}
+
+func TestNewResultUploaderRejectsDestinations(t *testing.T) {
+	for _, destination := range []string{"/results", "ftp://results", "s3:///prefix"} {
+		if _, err := NewResultUploader(destination, ""); err == nil {
+			t.Errorf("expected %s to be rejected", destination)
+		}
+	}
+}
@@ -0,0 +1,247 @@
+package ginkgo
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// WatchOptions rebuilds the test binary whenever Go sources in the watched directories change and
+// runs only the tests with a code location in a changed file. It is an inner loop for test authors
+// working against a development cluster.
+type WatchOptions struct {
+	// Dirs are searched recursively for .go files
+	Dirs []string
+	// Interval is how often the directories are checked for changes
+	Interval time.Duration
+	// BuildCommand is run with a shell to rebuild Binary after a change. When both are empty,
+	// openshift-tests is built into a temporary directory instead of the working directory.
+	BuildCommand string
+	// Binary is the rebuilt openshift-tests binary used to list and run tests
+	Binary string
+	// Provider, if set, selects the tests for the cluster and is passed to every test as
+	// TEST_PROVIDER, like the run command does
+	Provider string
+
+	Out, ErrOut io.Writer
+}
+
+// Run watches for changes until ctx is cancelled. Only impacted tests that belong to suite are run.
+func (opt *WatchOptions) Run(ctx context.Context, suite string) error {
+	if len(opt.Dirs) == 0 {
+		return fmt.Errorf("at least one directory must be watched")
+	}
+	interval := opt.Interval
+	if interval == 0 {
+		interval = 2 * time.Second
+	}
+	cleanup, err := opt.defaultBinary()
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	summary := map[string]TestState{}
+	before, err := goSourceModTimes(opt.Dirs)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(opt.Out, "Watching %d Go files in %s\n", len(before), strings.Join(opt.Dirs, ", "))
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		after, err := goSourceModTimes(opt.Dirs)
+		if err != nil {
+			return err
+		}
+		changed := changedFiles(before, after)
+		before = after
+		if len(changed) == 0 {
+			continue
+		}
+		fmt.Fprintf(opt.Out, "\nChanged:\n  %s\n", strings.Join(changed, "\n  "))
+		if err := opt.runImpacted(ctx, suite, changed, summary); err != nil {
+			fmt.Fprintf(opt.ErrOut, "error: %v\n", err)
+			continue
+		}
+		writeWatchSummary(opt.Out, summary)
+	}
+}
+
+func (opt *WatchOptions) runImpacted(ctx context.Context, suite string, changed []string, summary map[string]TestState) error {
+	build := exec.CommandContext(ctx, "/bin/sh", "-c", opt.BuildCommand)
+	build.Stdout, build.Stderr = opt.ErrOut, opt.ErrOut
+	if err := build.Run(); err != nil {
+		return fmt.Errorf("build failed: %v", err)
+	}
+
+	list := opt.listCommand(ctx, suite)
+	list.Stderr = ioutil.Discard
+	data, err := list.Output()
+	if err != nil {
+		return fmt.Errorf("unable to list tests: %v", err)
+	}
+	listing := &dryRunListing{}
+	if err := json.Unmarshal(data, listing); err != nil {
+		return fmt.Errorf("unable to parse the list of tests: %v", err)
+	}
+
+	tests := impactedTests(listing, changed)
+	fmt.Fprintf(opt.Out, "Running %d impacted tests\n", len(tests))
+	for _, name := range tests {
+		if ctx.Err() != nil {
+			return nil
+		}
+		out := &bytes.Buffer{}
+		cmd := opt.testCommand(ctx, name)
+		cmd.Stdout, cmd.Stderr = out, out
+		state := TestSucceeded
+		if err := cmd.Run(); err != nil {
+			state = TestFailed
+			if exitErr, ok := err.(*exec.ExitError); ok {
+				state = exitStatusTestState(exitErr.ExitCode())
+			}
+		}
+		summary[name] = state
+		fmt.Fprintf(opt.Out, "%s: %q\n", state, name)
+		if state != TestSucceeded && state != TestSkipped {
+			fmt.Fprintf(opt.Out, "%s\n", lastLinesUntil(out.String(), 100, "fail ["))
+		}
+	}
+	return nil
+}
+
+// defaultBinary builds openshift-tests into a temporary directory when neither a binary nor a build
+// command is set, so the rebuilds do not write into the repository, and returns a function that
+// removes the directory.
+func (opt *WatchOptions) defaultBinary() (func(), error) {
+	switch {
+	case len(opt.Binary) > 0 && len(opt.BuildCommand) > 0:
+		return func() {}, nil
+	case len(opt.Binary) > 0:
+		return nil, fmt.Errorf("the command that builds %s must be set", opt.Binary)
+	case len(opt.BuildCommand) > 0:
+		return nil, fmt.Errorf("the binary built by %q must be set", opt.BuildCommand)
+	}
+	dir, err := ioutil.TempDir("", "openshift-tests-watch")
+	if err != nil {
+		return nil, err
+	}
+	opt.Binary = filepath.Join(dir, "openshift-tests")
+	opt.BuildCommand = fmt.Sprintf("go build -o %q ./cmd/openshift-tests", opt.Binary)
+	return func() { os.RemoveAll(dir) }, nil
+}
+
+// listCommand lists the tests of suite that would run against the cluster.
+func (opt *WatchOptions) listCommand(ctx context.Context, suite string) *exec.Cmd {
+	args := []string{"run", suite, "--dry-run", "--dry-run-format", DryRunFormatJSON}
+	if len(opt.Provider) > 0 {
+		args = append(args, "--provider", opt.Provider)
+	}
+	return exec.CommandContext(ctx, opt.Binary, args...)
+}
+
+// testCommand runs a single test with the provider of the cluster.
+func (opt *WatchOptions) testCommand(ctx context.Context, name string) *exec.Cmd {
+	cmd := exec.CommandContext(ctx, opt.Binary, "run-test", name)
+	if len(opt.Provider) > 0 {
+		cmd.Env = append(os.Environ(), fmt.Sprintf("TEST_PROVIDER=%s", opt.Provider))
+	}
+	return cmd
+}
+
+// goSourceModTimes returns the modification time of every .go file under dirs.
+func goSourceModTimes(dirs []string) (map[string]time.Time, error) {
+	files := map[string]time.Time{}
+	for _, dir := range dirs {
+		err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			if info.IsDir() || filepath.Ext(path) != ".go" {
+				return nil
+			}
+			abs, err := filepath.Abs(path)
+			if err != nil {
+				return err
+			}
+			files[abs] = info.ModTime()
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return files, nil
+}
+
+// changedFiles returns the files that were added or modified between two snapshots.
+func changedFiles(before, after map[string]time.Time) []string {
+	var changed []string
+	for path, modTime := range after {
+		if previous, ok := before[path]; !ok || !previous.Equal(modTime) {
+			changed = append(changed, path)
+		}
+	}
+	sort.Strings(changed)
+	return changed
+}
+
+// impactedTests returns the tests with a container or test body declared in one of the changed files.
+func impactedTests(listing *dryRunListing, changed []string) []string {
+	var names []string
+	for _, test := range listing.Tests {
+		if test.Skipped {
+			continue
+		}
+	locations:
+		for _, location := range test.Locations {
+			file := location
+			if i := strings.LastIndex(location, ":"); i > 0 {
+				file = location[:i]
+			}
+			for _, path := range changed {
+				if file == path || strings.HasSuffix(path, string(filepath.Separator)+file) {
+					names = append(names, test.Name)
+					break locations
+				}
+			}
+		}
+	}
+	return uniqueStrings(names)
+}
+
+func writeWatchSummary(out io.Writer, summary map[string]TestState) {
+	names := make([]string, 0, len(summary))
+	counts := map[TestState]int{}
+	for name, state := range summary {
+		names = append(names, name)
+		counts[state]++
+	}
+	sort.Strings(names)
+	failed := counts[TestFailed] + counts[TestFailedTimeout] + counts[TestUnknown]
+	fmt.Fprintf(out, "\nSummary: %d passed, %d flaked, %d failed, %d skipped\n", counts[TestSucceeded], counts[TestFlaked], failed, counts[TestSkipped])
+	for _, name := range names {
+		switch summary[name] {
+		case TestFlaked:
+			fmt.Fprintf(out, "  flaky: %q\n", name)
+		case TestFailed, TestFailedTimeout, TestUnknown:
+			fmt.Fprintf(out, "  failing: %q\n", name)
+		}
+	}
+}
@@ -0,0 +1,107 @@
+package ginkgo
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func Test_changedFiles(t *testing.T) {
+	now := time.Now()
+	before := map[string]time.Time{"/src/a.go": now, "/src/b.go": now, "/src/c.go": now}
+	after := map[string]time.Time{"/src/a.go": now, "/src/b.go": now.Add(time.Second), "/src/d.go": now}
+	if got, want := changedFiles(before, after), []string{"/src/b.go", "/src/d.go"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("changedFiles() = %v, want %v", got, want)
+	}
+}
+
+func Test_impactedTests(t *testing.T) {
+	listing := &dryRunListing{Tests: []dryRunTest{
+		{Name: "a", Locations: []string{"/src/test/extended/cli/cli.go:10", "/src/test/extended/cli/cli.go:20"}},
+		{Name: "b", Locations: []string{"/src/test/extended/cli/other.go:10"}},
+		{Name: "c", Locations: []string{"test/extended/cli/cli.go:30"}},
+		{Name: "d", Locations: []string{"/src/test/extended/cli/cli.go:40"}, Skipped: true},
+	}}
+	if got, want := impactedTests(listing, []string{"/src/test/extended/cli/cli.go"}), []string{"a", "c"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("impactedTests() = %v, want %v", got, want)
+	}
+}
+
+func Test_writeWatchSummary(t *testing.T) {
+	out := &bytes.Buffer{}
+	writeWatchSummary(out, map[string]TestState{"a": TestSucceeded, "b": TestFailed, "c": TestSkipped, "d": TestFlaked, "e": TestFailedTimeout})
+	if want := "\nSummary: 1 passed, 1 flaked, 2 failed, 1 skipped\n  failing: \"b\"\n  flaky: \"d\"\n  failing: \"e\"\n"; out.String() != want {
+		t.Errorf("unexpected summary %q", out.String())
+	}
+	if strings.Contains(out.String(), `"a"`) {
+		t.Error("passing tests should not be listed")
+	}
+}
+
+func Test_watchDefaultBinary(t *testing.T) {
+	opt := &WatchOptions{}
+	cleanup, err := opt.defaultBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir := filepath.Dir(opt.Binary)
+	if !strings.HasPrefix(dir, os.TempDir()) || !strings.Contains(opt.BuildCommand, "-o \""+opt.Binary+"\"") {
+		t.Errorf("expected to build into a temporary directory, got %q", opt.BuildCommand)
+	}
+	cleanup()
+	if _, err := os.Stat(dir); !os.IsNotExist(err) {
+		t.Errorf("expected the temporary directory to be removed, got %v", err)
+	}
+
+	for _, opt := range []*WatchOptions{{Binary: "./openshift-tests"}, {BuildCommand: "make"}} {
+		if _, err := opt.defaultBinary(); err == nil {
+			t.Errorf("expected an error for %#v", opt)
+		}
+	}
+}
+
+func Test_watchCommandsPassProvider(t *testing.T) {
+	opt := &WatchOptions{Binary: "openshift-tests", Provider: `{"type":"aws"}`}
+	list := opt.listCommand(context.Background(), "all")
+	if got := strings.Join(list.Args[1:], " "); got != `run all --dry-run --dry-run-format json --provider {"type":"aws"}` {
+		t.Errorf("unexpected list arguments %q", got)
+	}
+	test := opt.testCommand(context.Background(), "a")
+	if len(test.Env) == 0 || test.Env[len(test.Env)-1] != `TEST_PROVIDER={"type":"aws"}` {
+		t.Errorf("expected the test to receive the provider, got %v", test.Env)
+	}
+}
+
+func Test_runImpacted(t *testing.T) {
+	binary := filepath.Join(t.TempDir(), "openshift-tests")
+	script := `#!/bin/sh
+case "$1" in
+run) echo '{"tests":[{"name":"flaky","locations":["/src/a.go:1"]},{"name":"timeout","locations":["/src/a.go:2"]},{"name":"skipped","locations":["/src/a.go:3"]},{"name":"passes","locations":["/src/a.go:4"]}]}' ;;
+run-test)
+	case "$2" in
+	flaky) exit 4 ;;
+	timeout) exit 2 ;;
+	skipped) exit 3 ;;
+	esac ;;
+esac
+`
+	if err := os.WriteFile(binary, []byte(script), 0755); err != nil {
+		t.Fatal(err)
+	}
+	out := &bytes.Buffer{}
+	opt := &WatchOptions{BuildCommand: "true", Binary: binary, Out: out, ErrOut: out}
+	summary := map[string]TestState{}
+	if err := opt.runImpacted(context.Background(), "suite", []string{"/src/a.go"}, summary); err != nil {
+		t.Fatal(err)
+	}
+	// the states match those the suite runner reports for the same exit codes
+	want := map[string]TestState{"flaky": TestFlaked, "timeout": TestFailedTimeout, "skipped": TestSkipped, "passes": TestSucceeded}
+	if !reflect.DeepEqual(summary, want) {
+		t.Errorf("runImpacted() summary = %v, want %v\n%s", summary, want, out.String())
+	}
+}
@@ -0,0 +1,293 @@
+package upgrade
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	configv1 "github.com/openshift/api/config/v1"
+	configv1client "github.com/openshift/client-go/config/clientset/versioned"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/yaml"
+
+	"github.com/openshift/origin/pkg/monitor"
+	"github.com/openshift/origin/pkg/monitor/monitorapi"
+	"github.com/openshift/origin/test/extended/util/disruption/controlplane"
+)
+
+// HealthGateType is the condition a health gate watches for during an upgrade.
+type HealthGateType string
+
+const (
+	// HealthGateClusterOperatorDegraded fires when a ClusterOperator reports Degraded=True for
+	// longer than the gate duration.
+	HealthGateClusterOperatorDegraded HealthGateType = "ClusterOperatorDegraded"
+	// HealthGateNodeNotReady fires when a node is not Ready for longer than the gate duration.
+	HealthGateNodeNotReady HealthGateType = "NodeNotReady"
+	// HealthGateAPIDisruption fires when the kube-apiserver backend sampler has recorded more
+	// disruption than the gate duration in total since the upgrade started.
+	HealthGateAPIDisruption HealthGateType = "APIDisruption"
+)
+
+// HealthGateAction is what the upgrade test does when a health gate fires.
+type HealthGateAction string
+
+const (
+	// HealthGateAbort returns the cluster to the version it ran before the upgrade.
+	HealthGateAbort HealthGateAction = "Abort"
+	// HealthGatePause pauses every MachineConfigPool so no more nodes are updated, then stops
+	// waiting for the upgrade and fails it, leaving the cluster in its current state for
+	// inspection. Operators already updating by the time the gate fires are not stopped.
+	HealthGatePause HealthGateAction = "Pause"
+)
+
+// HealthGate is a condition that ends an upgrade before the upgrade timeout when it holds.
+type HealthGate struct {
+	// Name identifies the gate in the test reports. Defaults to the type.
+	Name string         `json:"name,omitempty"`
+	Type HealthGateType `json:"type"`
+	// Duration is how long the condition must hold before the gate fires.
+	Duration metav1.Duration `json:"duration"`
+	// Action defaults to Abort.
+	Action HealthGateAction `json:"action,omitempty"`
+}
+
+// LoadHealthGates reads a YAML list of health gates from path.
+func LoadHealthGates(path string) ([]HealthGate, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	gates, err := ParseHealthGates(data)
+	if err != nil {
+		return nil, fmt.Errorf("invalid health gates in %s: %v", path, err)
+	}
+	return gates, nil
+}
+
+// ParseHealthGates parses and defaults a YAML list of health gates.
+func ParseHealthGates(data []byte) ([]HealthGate, error) {
+	var gates []HealthGate
+	if err := yaml.UnmarshalStrict(data, &gates); err != nil {
+		return nil, err
+	}
+	names := map[string]bool{}
+	for i := range gates {
+		gate := &gates[i]
+		switch gate.Type {
+		case HealthGateClusterOperatorDegraded, HealthGateNodeNotReady, HealthGateAPIDisruption:
+		default:
+			return nil, fmt.Errorf("gate %d: type must be one of %s, %s or %s", i, HealthGateClusterOperatorDegraded, HealthGateNodeNotReady, HealthGateAPIDisruption)
+		}
+		switch gate.Action {
+		case "":
+			gate.Action = HealthGateAbort
+		case HealthGateAbort, HealthGatePause:
+		default:
+			return nil, fmt.Errorf("gate %d: action must be %s or %s", i, HealthGateAbort, HealthGatePause)
+		}
+		if gate.Duration.Duration <= 0 {
+			return nil, fmt.Errorf("gate %d: duration must be greater than zero", i)
+		}
+		if len(gate.Name) == 0 {
+			gate.Name = string(gate.Type)
+		}
+		if names[gate.Name] {
+			return nil, fmt.Errorf("gate %d: name %q is used by another gate", i, gate.Name)
+		}
+		names[gate.Name] = true
+	}
+	return gates, nil
+}
+
+// SetHealthGates sets the health gates checked while each upgrade is in progress.
+func SetHealthGates(gates []HealthGate) {
+	upgradeHealthGates = gates
+}
+
+// healthGateTestName reports whether a gate fired during an upgrade.
+func healthGateTestName(gate HealthGate) string {
+	return fmt.Sprintf("[sig-cluster-lifecycle] upgrade health gate %s does not fire", gate.Name)
+}
+
+func healthGateActionString(action HealthGateAction) string {
+	if action == HealthGatePause {
+		return "paused"
+	}
+	return "aborted"
+}
+
+// healthObservation is the state of the cluster the health gates are evaluated against.
+type healthObservation struct {
+	// apiDisruption is the total disruption of the kube-apiserver recorded by the backend
+	// sampler since the upgrade started.
+	apiDisruption time.Duration
+	// listed is false if the ClusterOperators or nodes could not be listed, in which case
+	// the fields below are not set.
+	listed bool
+	// degradedOperators are the ClusterOperators reporting Degraded=True.
+	degradedOperators []string
+	// notReadyNodes are the nodes that are not Ready.
+	notReadyNodes []string
+}
+
+// healthGates tracks how long the condition of each gate has held.
+type healthGates struct {
+	gates []HealthGate
+
+	// since is when the condition of a gate began to hold for a subject, keyed by gate and subject
+	since map[string]time.Time
+}
+
+func newHealthGates(gates []HealthGate) *healthGates {
+	return &healthGates{gates: gates, since: map[string]time.Time{}}
+}
+
+// Observe records the state of the cluster at now and returns the first gate that fired, with a
+// description of why, or nil.
+func (h *healthGates) Observe(now time.Time, observation healthObservation) (*HealthGate, string) {
+	for i := range h.gates {
+		gate := &h.gates[i]
+		switch gate.Type {
+		case HealthGateAPIDisruption:
+			if observation.apiDisruption > gate.Duration.Duration {
+				return gate, fmt.Sprintf("the kube-apiserver was unavailable for %s, more than %s", observation.apiDisruption.Round(time.Second), gate.Duration.Duration)
+			}
+		case HealthGateClusterOperatorDegraded:
+			if !observation.listed {
+				continue
+			}
+			if subject, held := h.held(now, gate, observation.degradedOperators); len(subject) > 0 {
+				return gate, fmt.Sprintf("clusteroperator/%s was degraded for %s, more than %s", subject, held.Round(time.Second), gate.Duration.Duration)
+			}
+		case HealthGateNodeNotReady:
+			if !observation.listed {
+				continue
+			}
+			if subject, held := h.held(now, gate, observation.notReadyNodes); len(subject) > 0 {
+				return gate, fmt.Sprintf("node/%s was not ready for %s, more than %s", subject, held.Round(time.Second), gate.Duration.Duration)
+			}
+		}
+	}
+	return nil, ""
+}
+
+// held records the subjects for which the condition of gate holds at now, forgets the others, and
+// returns the first subject it has held for longer than the gate duration.
+func (h *healthGates) held(now time.Time, gate *HealthGate, subjects []string) (string, time.Duration) {
+	prefix := gate.Name + "/"
+	current := map[string]bool{}
+	for _, subject := range subjects {
+		current[prefix+subject] = true
+		if _, ok := h.since[prefix+subject]; !ok {
+			h.since[prefix+subject] = now
+		}
+	}
+	var keys []string
+	for key := range h.since {
+		if !strings.HasPrefix(key, prefix) {
+			continue
+		}
+		if !current[key] {
+			delete(h.since, key)
+			continue
+		}
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if held := now.Sub(h.since[key]); held > gate.Duration.Duration {
+			return strings.TrimPrefix(key, prefix), held
+		}
+	}
+	return "", 0
+}
+
+// observeClusterHealth gathers the state of the cluster checked by the health gates. The
+// kube-apiserver disruption is taken from the intervals recorded by the backend sampler.
+func observeClusterHealth(ctx context.Context, c configv1client.Interface, kubeClient kubernetes.Interface, disruption monitorapi.Intervals) healthObservation {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	observation := healthObservation{apiDisruption: apiDisruption(disruption, time.Now())}
+	operators, err := c.ConfigV1().ClusterOperators().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return observation
+	}
+	nodes, err := kubeClient.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return observation
+	}
+	observation.listed = true
+	for _, operator := range operators.Items {
+		if degraded := findCondition(operator.Status.Conditions, configv1.OperatorDegraded); degraded != nil && degraded.Status == configv1.ConditionTrue {
+			observation.degradedOperators = append(observation.degradedOperators, operator.Name)
+		}
+	}
+	for _, node := range nodes.Items {
+		ready := false
+		for _, condition := range node.Status.Conditions {
+			if condition.Type == corev1.NodeReady {
+				ready = condition.Status == corev1.ConditionTrue
+			}
+		}
+		if !ready {
+			observation.notReadyNodes = append(observation.notReadyNodes, node.Name)
+		}
+	}
+	return observation
+}
+
+// apiDisruption sums the disruption intervals recorded by the backend sampler, counting a
+// disruption that is still going on up to now. Like the disruption tests, every failed sample
+// counts for at least a second.
+func apiDisruption(intervals monitorapi.Intervals, now time.Time) time.Duration {
+	disruption := intervals.Filter(monitorapi.IsErrorEvent)
+	disruption.Clamp(time.Time{}, now)
+	return disruption.Duration(time.Second)
+}
+
+// startAPIDisruptionSampler samples the kube-apiserver until ctx is done and returns a function
+// listing the disruption intervals recorded so far.
+func startAPIDisruptionSampler(ctx context.Context, config *rest.Config) (func() monitorapi.Intervals, error) {
+	sampler, err := controlplane.NewKubeAPIWithNewConnectionsBackendSampler(config)
+	if err != nil {
+		return nil, err
+	}
+	recorder := monitor.NewMonitorWithInterval(time.Second)
+	if err := sampler.StartEndpointMonitoring(ctx, recorder, nil); err != nil {
+		return nil, err
+	}
+	return func() monitorapi.Intervals {
+		return recorder.Intervals(time.Time{}, time.Time{})
+	}, nil
+}
+
+// pauseMachineConfigPools pauses every MachineConfigPool so the MCO stops updating nodes.
+func pauseMachineConfigPools(ctx context.Context, dc dynamic.Interface) error {
+	mcps := dc.Resource(schema.GroupVersionResource{
+		Group:    "machineconfiguration.openshift.io",
+		Version:  "v1",
+		Resource: "machineconfigpools",
+	})
+	pools, err := mcps.List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	var errs []error
+	for _, pool := range pools.Items {
+		if _, err := mcps.Patch(ctx, pool.GetName(), types.MergePatchType, []byte(`{"spec":{"paused":true}}`), metav1.PatchOptions{}); err != nil {
+			errs = append(errs, fmt.Errorf("pause machineconfigpool/%s: %v", pool.GetName(), err))
+		}
+	}
+	return utilerrors.NewAggregate(errs)
+}
@@ -0,0 +1,159 @@
+package upgrade
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/openshift/origin/pkg/monitor/monitorapi"
+)
+
+func TestParseHealthGates(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    []HealthGate
+		wantErr string
+	}{
+		{
+			name: "defaults name and action",
+			data: "- type: NodeNotReady\n  duration: 20m\n- name: api\n  type: APIDisruption\n  duration: 1m\n  action: Pause\n",
+			want: []HealthGate{
+				{Name: "NodeNotReady", Type: HealthGateNodeNotReady, Action: HealthGateAbort},
+				{Name: "api", Type: HealthGateAPIDisruption, Action: HealthGatePause},
+			},
+		},
+		{name: "unknown type", data: "- type: Unknown\n  duration: 1m\n", wantErr: "type must be one of"},
+		{name: "unknown action", data: "- type: NodeNotReady\n  duration: 1m\n  action: Retry\n", wantErr: "action must be"},
+		{name: "missing duration", data: "- type: NodeNotReady\n", wantErr: "duration must be greater than zero"},
+		{name: "duplicate name", data: "- type: NodeNotReady\n  duration: 1m\n- type: NodeNotReady\n  duration: 2m\n", wantErr: "is used by another gate"},
+		{name: "unknown field", data: "- type: NodeNotReady\n  duration: 1m\n  threshold: 2\n", wantErr: "threshold"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			gates, err := ParseHealthGates([]byte(test.data))
+			if len(test.wantErr) > 0 {
+				if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", test.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(gates) != len(test.want) {
+				t.Fatalf("expected %d gates, got %#v", len(test.want), gates)
+			}
+			for i, gate := range gates {
+				want := test.want[i]
+				if gate.Name != want.Name || gate.Type != want.Type || gate.Action != want.Action {
+					t.Errorf("expected gate %#v, got %#v", want, gate)
+				}
+			}
+		})
+	}
+}
+
+func TestHealthGatesObserve(t *testing.T) {
+	gates, err := ParseHealthGates([]byte(`
+- type: ClusterOperatorDegraded
+  duration: 5m
+- type: NodeNotReady
+  duration: 10m
+- type: APIDisruption
+  duration: 1m
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name         string
+		observations []healthObservation
+		wantGate     string
+		wantMessage  string
+	}{
+		{
+			name: "operator recovers before the gate duration",
+			observations: []healthObservation{
+				{listed: true, degradedOperators: []string{"dns"}},
+				{listed: true, degradedOperators: []string{"dns"}},
+				{listed: true},
+				{listed: true, degradedOperators: []string{"dns"}},
+				{listed: true, degradedOperators: []string{"dns"}},
+			},
+		},
+		{
+			name: "operator degraded longer than the gate duration",
+			observations: []healthObservation{
+				{listed: true, degradedOperators: []string{"dns"}},
+				{listed: true, degradedOperators: []string{"dns", "network"}},
+				{listed: true, degradedOperators: []string{"dns"}},
+			},
+			wantGate:    "ClusterOperatorDegraded",
+			wantMessage: "clusteroperator/dns was degraded for 6m0s",
+		},
+		{
+			name: "node not ready longer than the gate duration",
+			observations: []healthObservation{
+				{listed: true, notReadyNodes: []string{"master-0"}},
+				{listed: true, notReadyNodes: []string{"master-0"}},
+				{listed: true, notReadyNodes: []string{"master-0"}},
+				{listed: true, notReadyNodes: []string{"master-0"}},
+				{listed: true, notReadyNodes: []string{"master-0"}},
+			},
+			wantGate:    "NodeNotReady",
+			wantMessage: "node/master-0 was not ready for 12m0s",
+		},
+		{
+			name: "api unavailable in total longer than the gate duration",
+			observations: []healthObservation{
+				{listed: true},
+				{apiDisruption: 30 * time.Second},
+				{apiDisruption: 90 * time.Second, listed: true},
+			},
+			wantGate:    "APIDisruption",
+			wantMessage: "the kube-apiserver was unavailable for 1m30s",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			h := newHealthGates(gates)
+			var fired *HealthGate
+			var message string
+			for i, observation := range test.observations {
+				fired, message = h.Observe(start.Add(time.Duration(i)*3*time.Minute), observation)
+				if fired != nil {
+					break
+				}
+			}
+			switch {
+			case len(test.wantGate) == 0 && fired != nil:
+				t.Fatalf("unexpected gate %s fired: %s", fired.Name, message)
+			case len(test.wantGate) > 0 && fired == nil:
+				t.Fatalf("expected gate %s to fire", test.wantGate)
+			case fired != nil && (fired.Name != test.wantGate || !strings.Contains(message, test.wantMessage)):
+				t.Fatalf("expected gate %s with message %q, got %s: %s", test.wantGate, test.wantMessage, fired.Name, message)
+			}
+		})
+	}
+}
+
+func TestAPIDisruption(t *testing.T) {
+	start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	intervals := monitorapi.Intervals{
+		{Condition: monitorapi.Condition{Level: monitorapi.Info}, From: start, To: start.Add(time.Minute)},
+		// a failed sample counts for at least a second
+		{Condition: monitorapi.Condition{Level: monitorapi.Error}, From: start.Add(time.Minute), To: start.Add(time.Minute + 100*time.Millisecond)},
+		{Condition: monitorapi.Condition{Level: monitorapi.Error}, From: start.Add(2 * time.Minute), To: start.Add(2*time.Minute + 20*time.Second)},
+		// still disrupted
+		{Condition: monitorapi.Condition{Level: monitorapi.Error}, From: start.Add(3 * time.Minute)},
+	}
+	if got, want := apiDisruption(intervals, start.Add(4*time.Minute)), 81*time.Second; got != want {
+		t.Fatalf("expected %s of disruption, got %s", want, got)
+	}
+	if intervals[3].To != (time.Time{}) {
+		t.Fatalf("the recorded intervals were modified")
+	}
+}
@@ -0,0 +1,77 @@
+package upgrade
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	configv1 "github.com/openshift/api/config/v1"
+	configv1client "github.com/openshift/client-go/config/clientset/versioned"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/kubernetes/test/e2e/framework"
+
+	"github.com/openshift/origin/test/extended/util/operator"
+)
+
+// RollbackTimeout is how long a rollback after a failed upgrade may take, matching the longest an
+// upgrade may take on most platforms.
+const RollbackTimeout = 150 * time.Minute
+
+// CurrentVersion returns the version the cluster last completed an update to, which a rollback
+// returns the cluster to.
+func CurrentVersion(c configv1client.Interface) (*configv1.Update, error) {
+	cv, err := c.ConfigV1().ClusterVersions().Get(context.Background(), "version", metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	current, ok := latestCompleted(cv.Status.History)
+	if !ok {
+		return nil, fmt.Errorf("cluster has not rolled out a version yet")
+	}
+	return current, nil
+}
+
+// Rollback instructs the cluster to return to the original version, the way an aborted upgrade
+// does, and waits until the cluster completes the update and its operators settle.
+func Rollback(ctx context.Context, c configv1client.Interface, original configv1.Update, timeout time.Duration) error {
+	framework.Logf("Instructing the cluster to return to %s", versionString(original))
+	desired := configv1.Update{
+		Image: original.Image,
+		Force: true,
+	}
+	var updated *configv1.ClusterVersion
+	if err := retry.RetryOnConflict(wait.Backoff{Steps: 10, Duration: time.Second}, func() error {
+		cv, err := c.ConfigV1().ClusterVersions().Get(ctx, "version", metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		cv.Spec.DesiredUpdate = &desired
+		updated, err = c.ConfigV1().ClusterVersions().Update(ctx, cv, metav1.UpdateOptions{})
+		return err
+	}); err != nil {
+		return fmt.Errorf("unable to request a rollback to %s: %v", versionString(original), err)
+	}
+
+	monitor := versionMonitor{client: c}
+	var lastMessage string
+	if err := wait.PollImmediateWithContext(ctx, 10*time.Second, timeout, func(ctx context.Context) (bool, error) {
+		cv, msg, err := monitor.Check(updated.Generation, desired)
+		if msg != "" {
+			lastMessage = msg
+		}
+		if err != nil || cv == nil {
+			return false, err
+		}
+		return monitor.Reached(cv, desired)
+	}); err != nil {
+		if lastMessage != "" {
+			return fmt.Errorf("cluster did not complete rollback to %s: %v: %s", versionString(original), err, lastMessage)
+		}
+		return fmt.Errorf("cluster did not complete rollback to %s: %v", versionString(original), err)
+	}
+	framework.Logf("Completed rollback to %s", versionString(original))
+
+	return operator.WaitForOperatorsToSettle(ctx, c)
+}
@@ -32,6 +32,7 @@ import (
 	"k8s.io/kubernetes/test/e2e/upgrades/apps"
 	"k8s.io/kubernetes/test/e2e/upgrades/node"
 
+	"github.com/openshift/origin/pkg/monitor/monitorapi"
 	"github.com/openshift/origin/pkg/synthetictests/platformidentification"
 	"github.com/openshift/origin/test/e2e/upgrade/adminack"
 	"github.com/openshift/origin/test/e2e/upgrade/alert"
@@ -83,6 +84,7 @@ var (
 	upgradeTests               = []upgrades.Test{}
 	upgradeAbortAt             int
 	upgradeDisruptRebootPolicy string
+	upgradeHealthGates         []HealthGate
 )
 
 // upgradeAbortAtRandom is a special value indicating the abort should happen at a random percentage
@@ -167,10 +169,12 @@ var _ = g.Describe("[sig-arch][Feature:ClusterUpgrade]", func() {
 			},
 			upgradeTests,
 			func() {
+				chain := newUpgradeChain(f, len(upgCtx.Versions)-1)
 				for i := 1; i < len(upgCtx.Versions); i++ {
-					framework.ExpectNoError(
-						clusterUpgrade(f, client, dynamicClient, config, upgCtx.Versions[i]),
-						fmt.Sprintf("during upgrade to %s", upgCtx.Versions[i].NodeImage))
+					start := time.Now()
+					err := clusterUpgrade(f, client, dynamicClient, config, upgCtx.Versions[i])
+					chain.RecordHop(i, upgCtx.Versions[i], time.Since(start), err)
+					framework.ExpectNoError(err, fmt.Sprintf("during upgrade to %s", upgCtx.Versions[i].NodeImage))
 				}
 			},
 		)
@@ -185,6 +189,41 @@ var _ = g.Describe("[sig-arch][Feature:ClusterUpgrade]", func() {
 	})
 })
 
+// upgradeChain reports each upgrade of a run that upgrades through several versions as its own
+// test, and whether the whole chain completed. A run with a single upgrade reports nothing.
+type upgradeChain struct {
+	f     *framework.Framework
+	hops  int
+	start time.Time
+}
+
+func newUpgradeChain(f *framework.Framework, hops int) *upgradeChain {
+	return &upgradeChain{f: f, hops: hops, start: time.Now()}
+}
+
+// RecordHop records the result of the upgrade to the hop-th version of the chain, and the result
+// of the chain once the last upgrade completes or any upgrade fails.
+func (c *upgradeChain) RecordHop(hop int, version upgrades.VersionContext, duration time.Duration, err error) {
+	if c.hops < 2 {
+		return
+	}
+	var failure string
+	if err != nil {
+		failure = fmt.Sprintf("upgrade %d of %d to %s failed: %v", hop, c.hops, versionContextString(version), err)
+	}
+	disruption.RecordJUnitResult(c.f, fmt.Sprintf("[sig-cluster-lifecycle] cluster completes upgrade %d of %d in the upgrade chain", hop, c.hops), duration, failure)
+	if err != nil || hop == c.hops {
+		disruption.RecordJUnitResult(c.f, "[sig-cluster-lifecycle] cluster completes every upgrade in the upgrade chain", time.Since(c.start), failure)
+	}
+}
+
+func versionContextString(version upgrades.VersionContext) string {
+	if len(version.NodeImage) > 0 {
+		return version.NodeImage
+	}
+	return version.Version.String()
+}
+
 func latestHistory(history []configv1.UpdateHistory) *configv1.UpdateHistory {
 	if len(history) > 0 {
 		return &history[0]
@@ -401,6 +440,15 @@ func clusterUpgrade(f *framework.Framework, c configv1client.Interface, dc dynam
 	framework.Logf("Starting upgrade to version=%s image=%s attempt=%s", version.Version.String(), version.NodeImage, uid)
 	recordClusterEvent(kubeClient, uid, "Upgrade", "UpgradeStarted", fmt.Sprintf("version/%s image/%s", version.Version.String(), version.NodeImage), false)
 
+	// the API disruption gate counts the disruption of the kube-apiserver backend sampler
+	apiDisruption := func() monitorapi.Intervals { return nil }
+	if len(upgradeHealthGates) > 0 {
+		samplerCtx, samplerCancel := context.WithCancel(context.Background())
+		defer samplerCancel()
+		apiDisruption, err = startAPIDisruptionSampler(samplerCtx, config)
+		framework.ExpectNoError(err)
+	}
+
 	// decide whether to abort at a percent
 	abortAt := upgradeAbortAt
 	switch abortAt {
@@ -509,7 +557,10 @@ func clusterUpgrade(f *framework.Framework, c configv1client.Interface, dc dynam
 	go monitor.Disrupt(ctx, kubeClient, upgradeDisruptRebootPolicy)
 
 	// observe the upgrade, taking action as necessary
-	if err := disruption.RecordJUnit(
+	gates := newHealthGates(upgradeHealthGates)
+	var firedGate *HealthGate
+	var firedGateMessage string
+	observeErr := disruption.RecordJUnit(
 		f,
 		clusterCompletesUpgradeTestName,
 		func() (error, bool) {
@@ -520,6 +571,21 @@ func clusterUpgrade(f *framework.Framework, c configv1client.Interface, dc dynam
 			upgradeStarted := time.Now()
 
 			if err := wait.PollImmediate(10*time.Second, maximumDuration, func() (bool, error) {
+				if firedGate == nil && len(upgradeHealthGates) > 0 {
+					firedGate, firedGateMessage = gates.Observe(time.Now(), observeClusterHealth(context.Background(), c, kubeClient, apiDisruption()))
+					if firedGate != nil {
+						framework.Logf("Upgrade health gate %s fired: %s", firedGate.Name, firedGateMessage)
+						recordClusterEvent(kubeClient, uid, "Upgrade", "UpgradeHealthGate", fmt.Sprintf("gate/%s action/%s %s", firedGate.Name, firedGate.Action, firedGateMessage), true)
+						if firedGate.Action == HealthGatePause {
+							if err := pauseMachineConfigPools(context.Background(), dc); err != nil {
+								return false, fmt.Errorf("upgrade health gate %s fired: %s, and the machine config pools could not be paused: %v", firedGate.Name, firedGateMessage, err)
+							}
+							recordClusterEvent(kubeClient, uid, "Upgrade", "UpgradePaused", fmt.Sprintf("gate/%s paused the machine config pools", firedGate.Name), true)
+							return false, fmt.Errorf("upgrade health gate %s fired: %s, the machine config pools were paused", firedGate.Name, firedGateMessage)
+						}
+					}
+				}
+
 				cv, msg, err := monitor.Check(updated.Generation, desired)
 				if msg != "" {
 					lastMessage = msg
@@ -528,7 +594,7 @@ func clusterUpgrade(f *framework.Framework, c configv1client.Interface, dc dynam
 					return false, err
 				}
 
-				if !aborted && monitor.ShouldUpgradeAbort(abortAt) {
+				if !aborted && (firedGate != nil || monitor.ShouldUpgradeAbort(abortAt)) {
 					framework.Logf("Instructing the cluster to return to %s / %s", original.Status.Desired.Version, original.Status.Desired.Image)
 					desired = configv1.Update{
 						Image: original.Status.Desired.Image,
@@ -578,9 +644,21 @@ func clusterUpgrade(f *framework.Framework, c configv1client.Interface, dc dynam
 
 			return nil, false
 		},
-	); err != nil {
-		recordClusterEvent(kubeClient, uid, "Upgrade", "UpgradeFailed", fmt.Sprintf("failed to reach cluster version: %v", err), true)
-		return err
+	)
+	for _, gate := range upgradeHealthGates {
+		var failure string
+		if firedGate != nil && firedGate.Name == gate.Name {
+			failure = fmt.Sprintf("%s during the upgrade to %s, the upgrade was %s", firedGateMessage, versionContextString(version), healthGateActionString(gate.Action))
+		}
+		disruption.RecordJUnitResult(f, healthGateTestName(gate), 0, failure)
+	}
+	if observeErr != nil {
+		recordClusterEvent(kubeClient, uid, "Upgrade", "UpgradeFailed", fmt.Sprintf("failed to reach cluster version: %v", observeErr), true)
+		return observeErr
+	}
+	if firedGate != nil {
+		recordClusterEvent(kubeClient, uid, "Upgrade", "UpgradeFailed", fmt.Sprintf("%s by health gate %s", healthGateActionString(firedGate.Action), firedGate.Name), true)
+		return fmt.Errorf("upgrade %s by health gate %s: %s", healthGateActionString(firedGate.Action), firedGate.Name, firedGateMessage)
 	}
 
 	var errMasterUpdating error
@@ -53232,6 +53232,8 @@ var _e2echartNonSpyglassE2eChartTemplateHtml = []byte(`<html lang="en">
 
 <script>
     var eventIntervals = EVENT_INTERVAL_JSON_GOES_HERE
+    // the chart spec the rows are grouped by, see pkg/monitor/intervalcreation/chart_spec.go, or null
+    var chartSpec = CHART_SPEC_GOES_HERE
 </script>
 
 <script>
@@ -53289,6 +53291,52 @@ var _e2echartNonSpyglassE2eChartTemplateHtml = []byte(`<html lang="en">
         return event.key != "Enter";
     });
 
+    function annotationsFromMessage(message) {
+        var annotations = {};
+        _.forEach(message.split(" "), function(token) {
+            if (token.includes("/")) {
+                annotations[token.split("/")[0]] = token.split("/")[1];
+            }
+        });
+        return annotations;
+    }
+
+    // chartGroupMatcher mirrors ChartGroup.matcher in pkg/monitor/intervalcreation/chart_spec.go
+    function chartGroupMatcher(group) {
+        var locator = group.locator ? new RegExp(group.locator) : null;
+        var excludeLocator = group.excludeLocator ? new RegExp(group.excludeLocator) : null;
+        var message = group.message ? new RegExp(group.message) : null;
+        var annotations = _.mapValues(group.annotations || {}, function(expr) { return new RegExp(expr); });
+        return function(eventInterval) {
+            if (group.source && !(group.source in eventInterval.locatorObj)) {
+                return false;
+            }
+            if (locator && !locator.test(eventInterval.locator)) {
+                return false;
+            }
+            if (excludeLocator && excludeLocator.test(eventInterval.locator)) {
+                return false;
+            }
+            if (message && !message.test(eventInterval.message)) {
+                return false;
+            }
+            if (group.level && eventInterval.level != group.level) {
+                return false;
+            }
+            if (!_.isEmpty(annotations)) {
+                var messageAnnotations = annotationsFromMessage(eventInterval.message);
+                for (var key in annotations) {
+                    if (!(key in messageAnnotations) || !annotations[key].test(messageAnnotations[key])) {
+                        return false;
+                    }
+                }
+            }
+            return true;
+        };
+    }
+
+    var chartGroupMatchers = chartSpec ? _.map(chartSpec.groups, chartGroupMatcher) : [];
+
     // Structure the locator data and then categorize the event
     _.forEach(eventIntervals.items, function(eventInterval) {
         eventInterval.locatorObj = {};
@@ -53325,6 +53373,11 @@ var _e2echartNonSpyglassE2eChartTemplateHtml = []byte(`<html lang="en">
         eventInterval.categories.e2e_test_flaked = isE2EFlaked(eventInterval);
         eventInterval.categories.e2e_test_passed = isE2EPassed(eventInterval);
         eventInterval.categories.endpoint_availability = isEndpointConnectivity(eventInterval);
+        if (chartSpec) {
+            _.forEach(chartGroupMatchers, function(matches, i) {
+                eventInterval.categories["chart_group_" + i] = matches(eventInterval);
+            });
+        }
         eventInterval.categories.uncategorized = !_.some(eventInterval.categories); // will save time later during filtering and re-rendering since we don't render any uncategorized events
     });
 
@@ -53772,60 +53825,78 @@ var _e2echartNonSpyglassE2eChartTemplateHtml = []byte(`<html lang="en">
         }
 
         var timelineGroups = [];
-        timelineGroups.push({group: "operator-unavailable", data: []});
-        createTimelineData("OperatorUnavailable", timelineGroups[timelineGroups.length - 1].data, filteredEvents, "operator_unavailable");
-
-        timelineGroups.push({group: "operator-degraded", data: []});
-        createTimelineData("OperatorDegraded", timelineGroups[timelineGroups.length - 1].data, filteredEvents, "operator_degraded");
-
-        timelineGroups.push({group: "operator-progressing", data: []});
-        createTimelineData("OperatorProgressing", timelineGroups[timelineGroups.length - 1].data, filteredEvents, "operator_progressing");
+        if (chartSpec) {
+            _.forEach(chartSpec.groups, function(group, i) {
+                timelineGroups.push({group: group.name, data: []});
+                createTimelineData(function(item) {
+                    var label = item.locator;
+                    if (group.rowBy && item.locatorObj[group.rowBy]) {
+                        label = group.rowBy + "/" + item.locatorObj[group.rowBy];
+                    }
+                    return [label, "", item.level];
+                }, timelineGroups[timelineGroups.length - 1].data, filteredEvents, "chart_group_" + i);
+                if (group.order == "label") {
+                    timelineGroups[timelineGroups.length - 1].data.sort(function (e1, e2){
+                        return e1.label < e2.label ? -1 : e1.label > e2.label;
+                    });
+                }
+            });
+        } else {
+            timelineGroups.push({group: "operator-unavailable", data: []});
+            createTimelineData("OperatorUnavailable", timelineGroups[timelineGroups.length - 1].data, filteredEvents, "operator_unavailable");
 
-        timelineGroups.push({group: "pods", data: []});
-        createTimelineData(podStateValue, timelineGroups[timelineGroups.length - 1].data, filteredEvents, "pods");
-        timelineGroups[timelineGroups.length - 1].data.sort(function (e1 ,e2){
-            // I think I really want ordering by time in each of a few categories
-            return e1.label < e2.label ? -1 : e1.label > e2.label;
-        });
+            timelineGroups.push({group: "operator-degraded", data: []});
+            createTimelineData("OperatorDegraded", timelineGroups[timelineGroups.length - 1].data, filteredEvents, "operator_degraded");
 
-        timelineGroups.push({group: "pod-logs", data: []});
-        createTimelineData(podLogs, timelineGroups[timelineGroups.length - 1].data, filteredEvents, "pod_logs");
+            timelineGroups.push({group: "operator-progressing", data: []});
+            createTimelineData("OperatorProgressing", timelineGroups[timelineGroups.length - 1].data, filteredEvents, "operator_progressing");
 
-        timelineGroups.push({group: "alerts", data: []});
-        createTimelineData(alertSeverity, timelineGroups[timelineGroups.length - 1].data, filteredEvents, "alerts");
-        // leaving this for posterity so future me (or someone else) can try it, but I think ordering by name makes the
-        // patterns shown by timing hide and timing appears more relevant to my eyes.
-        // sort alerts alphabetically for display purposes, but keep the json itself ordered by time.
-        // timelineGroups[timelineGroups.length - 1].data.sort(function (e1 ,e2){
-        //     if (e1.label.includes("alert") && e2.label.includes("alert")) {
-        //         return e1.label < e2.label ? -1 : e1.label > e2.label;
-        //     }
-        //     return 0
-        // })
+            timelineGroups.push({group: "pods", data: []});
+            createTimelineData(podStateValue, timelineGroups[timelineGroups.length - 1].data, filteredEvents, "pods");
+            timelineGroups[timelineGroups.length - 1].data.sort(function (e1 ,e2){
+                // I think I really want ordering by time in each of a few categories
+                return e1.label < e2.label ? -1 : e1.label > e2.label;
+            });
 
-        timelineGroups.push({group: "node-state", data: []});
-        createTimelineData(nodeStateValue, timelineGroups[timelineGroups.length - 1].data, filteredEvents, "node_state");
-        timelineGroups[timelineGroups.length - 1].data.sort(function (e1 ,e2){
-            if (e1.label.includes("master") && e2.label.includes("worker")) {
-                return -1
-            }
-            return 0
-        });
+            timelineGroups.push({group: "pod-logs", data: []});
+            createTimelineData(podLogs, timelineGroups[timelineGroups.length - 1].data, filteredEvents, "pod_logs");
+
+            timelineGroups.push({group: "alerts", data: []});
+            createTimelineData(alertSeverity, timelineGroups[timelineGroups.length - 1].data, filteredEvents, "alerts");
+            // leaving this for posterity so future me (or someone else) can try it, but I think ordering by name makes the
+            // patterns shown by timing hide and timing appears more relevant to my eyes.
+            // sort alerts alphabetically for display purposes, but keep the json itself ordered by time.
+            // timelineGroups[timelineGroups.length - 1].data.sort(function (e1 ,e2){
+            //     if (e1.label.includes("alert") && e2.label.includes("alert")) {
+            //         return e1.label < e2.label ? -1 : e1.label > e2.label;
+            //     }
+            //     return 0
+            // })
+
+            timelineGroups.push({group: "node-state", data: []});
+            createTimelineData(nodeStateValue, timelineGroups[timelineGroups.length - 1].data, filteredEvents, "node_state");
+            timelineGroups[timelineGroups.length - 1].data.sort(function (e1 ,e2){
+                if (e1.label.includes("master") && e2.label.includes("worker")) {
+                    return -1
+                }
+                return 0
+            });
 
-        timelineGroups.push({group: "endpoint-availability", data: []});
-        createTimelineData(disruptionValue, timelineGroups[timelineGroups.length - 1].data, filteredEvents, "endpoint_availability");
+            timelineGroups.push({group: "endpoint-availability", data: []});
+            createTimelineData(disruptionValue, timelineGroups[timelineGroups.length - 1].data, filteredEvents, "endpoint_availability");
 
-        timelineGroups.push({group: "e2e-test-failed", data: []});
-        createTimelineData("Failed", timelineGroups[timelineGroups.length - 1].data, filteredEvents, "e2e_test_failed");
+            timelineGroups.push({group: "e2e-test-failed", data: []});
+            createTimelineData("Failed", timelineGroups[timelineGroups.length - 1].data, filteredEvents, "e2e_test_failed");
 
-        timelineGroups.push({group: "e2e-test-flaked", data: []});
-        createTimelineData("Flaked", timelineGroups[timelineGroups.length - 1].data, filteredEvents, "e2e_test_flaked");
+            timelineGroups.push({group: "e2e-test-flaked", data: []});
+            createTimelineData("Flaked", timelineGroups[timelineGroups.length - 1].data, filteredEvents, "e2e_test_flaked");
 
-        timelineGroups.push({group: "e2e-test-passed", data: []});
-        createTimelineData("Passed", timelineGroups[timelineGroups.length - 1].data, filteredEvents, "e2e_test_passed");
+            timelineGroups.push({group: "e2e-test-passed", data: []});
+            createTimelineData("Passed", timelineGroups[timelineGroups.length - 1].data, filteredEvents, "e2e_test_passed");
 
-        timelineGroups.push({group: "interesting-events", data: []});
-        createTimelineData(interestingEvents, timelineGroups[timelineGroups.length - 1].data, filteredEvents, "interesting_events");
+            timelineGroups.push({group: "interesting-events", data: []});
+            createTimelineData(interestingEvents, timelineGroups[timelineGroups.length - 1].data, filteredEvents, "interesting_events");
+        }
 
         var segmentFunc = function (segment) {
             // for (var i in data) {
@@ -53861,7 +53932,8 @@ var _e2echartNonSpyglassE2eChartTemplateHtml = []byte(`<html lang="en">
                 'PodCreated', 'PodScheduled', 'PodTerminating','ContainerWait', 'ContainerStart', 'ContainerNotReady', 'ContainerReady', 'ContainerReadinessFailed', 'ContainerReadinessErrored',  'StartupProbeFailed', // pods
                 'CIClusterDisruption', 'Disruption', // disruption
                 'Degraded', 'Upgradeable', 'False', 'Unknown',
-                'PodLogInfo', 'PodLogWarning', 'PodLogError'])
+                'PodLogInfo', 'PodLogWarning', 'PodLogError',
+                'Info', 'Warning', 'Error']) // interval levels of chart spec rows
             .range([
                 '#6E6E6E', '#0000ff', '#d0312d', // pathological and interesting events
                 '#fada5e','#fada5e','#ffa500', '#d0312d',  // alerts
@@ -53871,7 +53943,8 @@ var _e2echartNonSpyglassE2eChartTemplateHtml = []byte(`<html lang="en">
                 '#96cbff', '#1e7bd9', '#ffa500', '#ca8dfd', '#9300ff', '#fada5e','#3cb043', '#d0312d', '#d0312d', '#c90076', // pods
                 '#96cbff', '#d0312d', // disruption
                 '#b65049', '#32b8b6', '#ffffff', '#bbbbbb',
-                '#96cbff', '#fada5e', '#d0312d']);
+                '#96cbff', '#fada5e', '#d0312d',
+                '#1e7bd9', '#ffa500', '#d0312d']);
         myChart.
         data(timelineGroups).
         useUtc(true).
@@ -7,6 +7,8 @@ import (
 	"k8s.io/kubernetes/openshift-hack/e2e/annotate"
 
 	_ "github.com/openshift/origin/test/extended"
+	"github.com/openshift/origin/test/extended/util/annotate/rules"
+	"github.com/openshift/origin/test/extended/util/annotate/skips"
 )
 
 // mergeMaps updates an existing map of string slices with the
@@ -32,7 +34,12 @@ func mergeMaps(existingMap, newMap map[string][]string) error {
 func init() {
 	// Merge the local rules with the rules for the kube e2e tests
 	// inherited from openshift/kubernetes.
-	err := mergeMaps(annotate.TestMaps, testMaps)
+	err := mergeMaps(annotate.TestMaps, rules.TestMaps)
+	if err != nil {
+		panic(fmt.Sprintf("Error updating annotate.TestMaps: %v", err))
+	}
+	// Merge the skip rules, which are kept as data.
+	err = mergeMaps(annotate.TestMaps, skips.Default().Skips)
 	if err != nil {
 		panic(fmt.Sprintf("Error updating annotate.TestMaps: %v", err))
 	}
@@ -0,0 +1,133 @@
+package rules
+
+// Rules defined here are additive to the rules already defined for
+// kube e2e tests in openshift/kubernetes. The kube rules are
+// vendored via the following file:
+//
+//   vendor/k8s.io/kubernetes/openshift-hack/e2e/annotate/rules.go
+//
+// Rules that are needed to pass the upstream e2e test suite in a
+// "default OCP CI" configuration (eg, AWS or GCP, openshift-sdn) must
+// be added to openshift/kubernetes to allow CI to pass there, and
+// then vendored back into origin. Rules that only apply to
+// "non-default" configurations (other clouds, other network
+// providers) should be added here, or to
+// test/extended/util/annotate/skips/skips.yaml for [Skipped:*] labels.
+
+var (
+	// TestMaps are the patterns of the names of the tests that receive each label. The annotation
+	// generator merges them with the rules for the kube e2e tests.
+	TestMaps = map[string][]string{
+		// tests that require a local host
+		"[Local]": {
+			// Doesn't work on scaled up clusters
+			`\[Feature:ImagePrune\]`,
+		},
+		// alpha features that are not gated
+		"[Disabled:Alpha]": {},
+		// tests for features that are not implemented in openshift
+		"[Disabled:Unimplemented]": {},
+		// tests that rely on special configuration that we do not yet support
+		"[Disabled:SpecialConfig]": {},
+		// tests that are known broken and need to be fixed upstream or in openshift
+		// always add an issue here
+		"[Disabled:Broken]": {
+			`should idle the service and DeploymentConfig properly`,       // idling with a single service and DeploymentConfig
+			`should answer endpoint and wildcard queries for the cluster`, // currently not supported by dns operator https://github.com/openshift/cluster-dns-operator/issues/43
+
+			// https://bugzilla.redhat.com/show_bug.cgi?id=1988272
+			`\[sig-network\] Networking should provide Internet connection for containers \[Feature:Networking-IPv6\]`,
+			`\[sig-network\] Networking should provider Internet connection for containers using DNS`,
+
+			// https://bugzilla.redhat.com/show_bug.cgi?id=1908645
+			`\[sig-network\] Networking Granular Checks: Services should function for service endpoints using hostNetwork`,
+			`\[sig-network\] Networking Granular Checks: Services should function for pod-Service\(hostNetwork\)`,
+
+			// https://bugzilla.redhat.com/show_bug.cgi?id=1952460
+			`\[sig-network\] Firewall rule control plane should not expose well-known ports`,
+
+			// https://bugzilla.redhat.com/show_bug.cgi?id=1952457
+			`\[sig-node\] crictl should be able to run crictl on the node`,
+
+			// https://bugzilla.redhat.com/show_bug.cgi?id=1945091
+			`\[Feature:GenericEphemeralVolume\]`,
+
+			// https://bugzilla.redhat.com/show_bug.cgi?id=1953478
+			`\[sig-storage\] Dynamic Provisioning Invalid AWS KMS key should report an error and create no PV`,
+
+			// https://bugzilla.redhat.com/show_bug.cgi?id=1957894
+			`\[sig-node\] Container Runtime blackbox test when running a container with a new image should be able to pull from private registry with secret`,
+
+			// The new NetworkPolicy test suite is extremely resource
+			// intensive and causes itself and other concurrently-running
+			// tests to be flaky.
+			// https://bugzilla.redhat.com/show_bug.cgi?id=1980141
+			`\[sig-network\] Netpol `,
+
+			// https://bugzilla.redhat.com/show_bug.cgi?id=1996128
+			`\[sig-network\] \[Feature:IPv6DualStack\] should have ipv4 and ipv6 node podCIDRs`,
+
+			// https://bugzilla.redhat.com/show_bug.cgi?id=2004074
+			`\[sig-network-edge\]\[Feature:Idling\] Unidling \[apigroup:apps.openshift.io\]\[apigroup:route.openshift.io\] should work with TCP \(while idling\)`,
+
+			// https://bugzilla.redhat.com/show_bug.cgi?id=2070929
+			`\[sig-network\]\[Feature:EgressIP\]\[apigroup:operator.openshift.io\] \[internal-targets\]`,
+
+			// https://issues.redhat.com/browse/OCPBUGS-967
+			`\[sig-network\] IngressClass \[Feature:Ingress\] should prevent Ingress creation if more than 1 IngressClass marked as default`,
+
+			// https://issues.redhat.com/browse/OCPBUGS-3339
+			`\[sig-devex\]\[Feature:ImageEcosystem\]\[mysql\]\[Slow\] openshift mysql image Creating from a template should instantiate the template`,
+			`\[sig-devex\]\[Feature:ImageEcosystem\]\[mariadb\]\[Slow\] openshift mariadb image Creating from a template should instantiate the template`,
+
+			// https://github.com/openshift/kubernetes/pull/1465
+			`\[sig-node\] Security Context when if the container's primary UID belongs to some groups in the image \[LinuxOnly\] should add pod.Spec.SecurityContext.SupplementalGroups to them \[LinuxOnly\] in resultant supplementary groups for the container processes`,
+
+			// https://issues.redhat.com/browse/OCPBUGS-7125
+			`\[sig-network\] LoadBalancers should be able to preserve UDP traffic when server pod cycles for a LoadBalancer service on different nodes`,
+			`\[sig-network\] LoadBalancers should be able to preserve UDP traffic when server pod cycles for a LoadBalancer service on the same nodes`,
+
+			// https://issues.redhat.com/browse/OCPBUGS-11652
+			`\[sig-cli\] oc adm node-logs`,
+		},
+		// tests that may work, but we don't support them
+		"[Disabled:Unsupported]": {
+			// Skip vSphere-specific storage tests. The standard in-tree storage tests for vSphere
+			// (prefixed with `In-tree Volumes [Driver: vsphere]`) are enough for testing this plugin.
+			// https://bugzilla.redhat.com/show_bug.cgi?id=2019115
+			`\[sig-storage\].*\[Feature:vsphere\]`,
+			// Also, our CI doesn't support topology, so disable those tests
+			`\[sig-storage\] In-tree Volumes \[Driver: vsphere\] \[Testpattern: Dynamic PV \(delayed binding\)\] topology should fail to schedule a pod which has topologies that conflict with AllowedTopologies`,
+			`\[sig-storage\] In-tree Volumes \[Driver: vsphere\] \[Testpattern: Dynamic PV \(delayed binding\)\] topology should provision a volume and schedule a pod with AllowedTopologies`,
+			`\[sig-storage\] In-tree Volumes \[Driver: vsphere\] \[Testpattern: Dynamic PV \(immediate binding\)\] topology should fail to schedule a pod which has topologies that conflict with AllowedTopologies`,
+			`\[sig-storage\] In-tree Volumes \[Driver: vsphere\] \[Testpattern: Dynamic PV \(immediate binding\)\] topology should provision a volume and schedule a pod with AllowedTopologies`,
+		},
+		// tests too slow to be part of conformance
+		"[Slow]": {},
+		// tests that are known flaky
+		"[Flaky]": {
+			`openshift mongodb replication creating from a template`, // flaking on deployment
+		},
+		// tests that must be run without competition
+		"[Serial]": {
+			`\[sig-network\]\[Feature:EgressIP\]`,
+		},
+		// tests that can't be run in parallel with a copy of itself
+		"[Serial:Self]": {
+			`\[sig-network\] HostPort validates that there is no conflict between pods with same hostPort but different hostIP and protocol`,
+		},
+
+		// tests whose failures block a release, see --include-tier and --exclude-tier of openshift-tests
+		"[Tier:blocking]": {
+			`\[Conformance\]`,
+		},
+		// tests whose failures are reported but do not block a release
+		"[Tier:informing]": {
+			`\[Flaky\]`,
+		},
+
+		"[Feature:Networking-IPv4]": {
+			`\[sig-network\]\[Feature:Router\]\[apigroup:route.openshift.io\] when FIPS is disabled the HAProxy router should serve routes when configured with a 1024-bit RSA key`,
+		},
+	}
+)
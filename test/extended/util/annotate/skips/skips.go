@@ -0,0 +1,160 @@
+// Package skips holds the rules that skip tests on a platform, network plugin, or topology as data.
+// The default rules are embedded from skips.yaml and can be overridden when openshift-tests runs
+// with rules from a file or a ConfigMap, so that a new provider gets the right skips without a
+// rebuild.
+package skips
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"io/ioutil"
+	"regexp"
+	"sort"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/yaml"
+)
+
+// ConfigMapKey is the key of the ConfigMap that holds the skip rules, in the format of skips.yaml.
+const ConfigMapKey = "skips.yaml"
+
+//go:embed skips.yaml
+var defaultRules []byte
+
+// Rules map each skip label, such as [Skipped:aws], to the patterns of the names of the tests that
+// receive it.
+type Rules struct {
+	Skips map[string][]string `json:"skips"`
+
+	compiled map[string][]*regexp.Regexp
+	// replaced holds the embedded patterns of the labels an override replaced, which added the
+	// label to the names of the tests they match when the binary was built
+	replaced map[string][]*regexp.Regexp
+}
+
+// Default returns the rules embedded in the binary.
+func Default() *Rules {
+	rules, err := Parse(defaultRules)
+	if err != nil {
+		panic(fmt.Sprintf("the embedded skip rules are invalid: %v", err))
+	}
+	return rules
+}
+
+// Parse reads rules in the format of skips.yaml. Every label must have the form [Skipped:<name>]
+// and every pattern must be a valid regular expression.
+func Parse(data []byte) (*Rules, error) {
+	rules := &Rules{}
+	if err := yaml.UnmarshalStrict(data, rules); err != nil {
+		return nil, err
+	}
+	rules.compiled = map[string][]*regexp.Regexp{}
+	for label, patterns := range rules.Skips {
+		if !strings.HasPrefix(label, "[Skipped:") || !strings.HasSuffix(label, "]") {
+			return nil, fmt.Errorf("label %q must have the form [Skipped:<name>]", label)
+		}
+		for _, pattern := range patterns {
+			re, err := regexp.Compile(pattern)
+			if err != nil {
+				return nil, fmt.Errorf("pattern %q of %s is invalid: %v", pattern, label, err)
+			}
+			rules.compiled[label] = append(rules.compiled[label], re)
+		}
+	}
+	return rules, nil
+}
+
+// LoadFile reads rules from a file in the format of skips.yaml.
+func LoadFile(path string) (*Rules, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	rules, err := Parse(data)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse skip rules %s: %v", path, err)
+	}
+	return rules, nil
+}
+
+// LoadConfigMap reads rules from the ConfigMapKey of a ConfigMap.
+func LoadConfigMap(ctx context.Context, client kubernetes.Interface, namespace, name string) (*Rules, error) {
+	cm, err := client.CoreV1().ConfigMaps(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	data, ok := cm.Data[ConfigMapKey]
+	if !ok {
+		return nil, fmt.Errorf("configmap %s/%s has no %s key", namespace, name, ConfigMapKey)
+	}
+	rules, err := Parse([]byte(data))
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse skip rules in configmap %s/%s: %v", namespace, name, err)
+	}
+	return rules, nil
+}
+
+// Override returns the rules with the labels of override replacing the same labels of r. Labels
+// that only one of them defines are kept.
+func (r *Rules) Override(override *Rules) *Rules {
+	merged := &Rules{Skips: map[string][]string{}, compiled: map[string][]*regexp.Regexp{}, replaced: map[string][]*regexp.Regexp{}}
+	for label, patterns := range r.replaced {
+		merged.replaced[label] = patterns
+	}
+	for label := range override.Skips {
+		if _, ok := merged.replaced[label]; !ok {
+			merged.replaced[label] = r.compiled[label]
+		}
+	}
+	for _, rules := range []*Rules{r, override} {
+		for label, patterns := range rules.Skips {
+			merged.Skips[label] = patterns
+			merged.compiled[label] = rules.compiled[label]
+		}
+	}
+	return merged
+}
+
+// Labels returns the sorted skip labels whose patterns match the name of a test.
+func (r *Rules) Labels(testName string) []string {
+	var labels []string
+	for label, patterns := range r.compiled {
+		for _, re := range patterns {
+			if re.MatchString(testName) {
+				labels = append(labels, label)
+				break
+			}
+		}
+	}
+	sort.Strings(labels)
+	return labels
+}
+
+// Annotate appends the skip labels that match the name of a test and are not already part of it. A
+// label of the name is removed if it was added by the embedded patterns of a label that was
+// overridden and the patterns that replaced them do not match the test.
+func (r *Rules) Annotate(testName string) string {
+	for label, patterns := range r.replaced {
+		if strings.Contains(testName, " "+label) && matchesAny(patterns, testName) && !matchesAny(r.compiled[label], testName) {
+			testName = strings.Replace(testName, " "+label, "", 1)
+		}
+	}
+	for _, label := range r.Labels(testName) {
+		if !strings.Contains(testName, label) {
+			testName += " " + label
+		}
+	}
+	return testName
+}
+
+func matchesAny(patterns []*regexp.Regexp, testName string) bool {
+	for _, re := range patterns {
+		if re.MatchString(testName) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,88 @@
+package skips
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestDefault(t *testing.T) {
+	rules := Default()
+	if len(rules.Skips["[Skipped:Proxy]"]) == 0 {
+		t.Fatalf("expected the embedded rules to skip tests on proxied clusters")
+	}
+	if got := rules.Labels("[sig-network] DNS should provide DNS for the cluster [Conformance]"); !reflect.DeepEqual([]string{"[Skipped:Proxy]"}, got) {
+		t.Errorf("unexpected labels %v", got)
+	}
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		wantErr string
+	}{
+		{
+			name: "valid",
+			data: "skips:\n  \"[Skipped:newcloud]\":\n  - 'foo'\n",
+		},
+		{
+			name:    "not a skip label",
+			data:    "skips:\n  \"[Disabled:Broken]\":\n  - 'foo'\n",
+			wantErr: "must have the form [Skipped:<name>]",
+		},
+		{
+			name:    "invalid pattern",
+			data:    "skips:\n  \"[Skipped:newcloud]\":\n  - '[foo'\n",
+			wantErr: "is invalid",
+		},
+		{
+			name:    "unknown field",
+			data:    "skip:\n  \"[Skipped:newcloud]\": []\n",
+			wantErr: "unknown field",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse([]byte(tt.data))
+			if len(tt.wantErr) == 0 {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestOverrideAndAnnotate(t *testing.T) {
+	defaults, err := Parse([]byte("skips:\n  \"[Skipped:aws]\":\n  - 'volume'\n  \"[Skipped:gce]\":\n  - 'volume'\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	override, err := Parse([]byte("skips:\n  \"[Skipped:gce]\": []\n  \"[Skipped:newcloud]\":\n  - 'volume'\n  - 'load balancer'\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	rules := defaults.Override(override)
+
+	tests := []struct {
+		name string
+		want string
+	}{
+		{name: "[sig-storage] volume should mount", want: "[sig-storage] volume should mount [Skipped:aws] [Skipped:newcloud]"},
+		{name: "[sig-storage] volume should mount [Skipped:aws]", want: "[sig-storage] volume should mount [Skipped:aws] [Skipped:newcloud]"},
+		{name: "[sig-network] load balancer should work", want: "[sig-network] load balancer should work [Skipped:newcloud]"},
+		{name: "[sig-cli] oc should work", want: "[sig-cli] oc should work"},
+		{name: "[sig-storage] volume should mount [Skipped:gce]", want: "[sig-storage] volume should mount [Skipped:aws] [Skipped:newcloud]"},
+		{name: "[sig-cli] oc should work [Skipped:gce]", want: "[sig-cli] oc should work [Skipped:gce]"},
+	}
+	for _, tt := range tests {
+		if got := rules.Annotate(tt.name); got != tt.want {
+			t.Errorf("expected %q, got %q", tt.want, got)
+		}
+	}
+}
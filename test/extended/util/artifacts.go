@@ -0,0 +1,98 @@
+package util
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	g "github.com/onsi/ginkgo/v2"
+	"k8s.io/kubernetes/test/e2e/framework"
+
+	"github.com/openshift/origin/pkg/test/ginkgo/runnerapi"
+	"github.com/openshift/origin/test/extended/util/annotate/generated"
+)
+
+// TestArtifactDirPath returns the directory the current test should write its own artifacts to.
+// openshift-tests provides a separate directory to every test through TEST_ARTIFACT_DIR and lists
+// its contents in test-artifacts/index.json. When the variable is not set, each spec gets the same
+// layout, a directory named by its spec ID under test-artifacts in ARTIFACT_DIR, and code running
+// outside a spec uses ARTIFACT_DIR.
+func TestArtifactDirPath() string {
+	if path := os.Getenv("TEST_ARTIFACT_DIR"); len(path) > 0 {
+		return path
+	}
+	return specArtifactDir(ArtifactDirPath(), g.CurrentSpecReport().FullText())
+}
+
+// specArtifactDir returns the directory of the artifacts of the spec with the given text under the
+// artifact dir.
+func specArtifactDir(artifactDir, specText string) string {
+	if len(specText) == 0 {
+		return artifactDir
+	}
+	return filepath.Join(artifactDir, runnerapi.TestArtifactsDir, runnerapi.SpecID(specNameWithoutAnnotations(specText)))
+}
+
+// specAnnotations maps the names of specs to the labels the generated annotation rules append to them.
+var specAnnotations = generated.Annotations
+
+// specNameWithoutAnnotations returns the name of the spec with the given text, without the labels
+// appended by the generated annotation rules and by the annotators that run after them, which is the
+// name the test runner identifies the spec by.
+func specNameWithoutAnnotations(text string) string {
+	if _, ok := specAnnotations[text]; ok {
+		return text
+	}
+	for i := strings.Index(text, " ["); i >= 0; {
+		if annotation, ok := specAnnotations[text[:i]]; ok && len(annotation) > 0 && strings.HasPrefix(text[i:], annotation) {
+			return text[:i]
+		}
+		next := strings.Index(text[i+1:], " [")
+		if next < 0 {
+			break
+		}
+		i += next + 1
+	}
+	return text
+}
+
+// ArtifactPath returns the path of the artifact named by elem in the artifact directory of the current
+// spec, and records it as an attachment of the spec. The directory of the artifact is created; the
+// artifact itself is written by the caller.
+func ArtifactPath(elem ...string) string {
+	name := filepath.Join(elem...)
+	if len(name) == 0 || filepath.IsAbs(name) || name == ".." || strings.HasPrefix(name, ".."+string(filepath.Separator)) {
+		panic(fmt.Sprintf("artifact path %q must be relative to the artifact directory of the spec", name))
+	}
+	path := filepath.Join(TestArtifactDirPath(), name)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		framework.Logf("Unable to create the directory of artifact %s: %v", name, err)
+	}
+	recordArtifact(name)
+	return path
+}
+
+// AttachArtifact writes the contents of r to the named artifact of the current spec, as returned by
+// ArtifactPath, and records it as an attachment of the spec.
+func AttachArtifact(name string, r io.Reader) error {
+	f, err := os.Create(ArtifactPath(name))
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(f, r); err != nil {
+		f.Close()
+		return fmt.Errorf("unable to write artifact %s: %v", name, err)
+	}
+	return f.Close()
+}
+
+// recordArtifact adds the artifact to the report of the current spec. Artifacts written outside a
+// spec are not attached to anything.
+func recordArtifact(name string) {
+	if len(g.CurrentSpecReport().FullText()) == 0 {
+		return
+	}
+	g.AddReportEntry(runnerapi.ArtifactReportEntry, filepath.ToSlash(name), g.ReportEntryVisibilityNever)
+}
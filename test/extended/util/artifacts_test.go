@@ -0,0 +1,67 @@
+package util
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/openshift/origin/pkg/test/ginkgo/runnerapi"
+	"github.com/openshift/origin/test/extended/util/annotate/generated"
+)
+
+func TestSpecArtifactDir(t *testing.T) {
+	if got := specArtifactDir("/tmp/artifacts", ""); got != "/tmp/artifacts" {
+		t.Errorf("expected the artifact dir outside a spec, got %q", got)
+	}
+	specAnnotations = map[string]string{
+		"[sig-cli] oc works":                   " [Suite:openshift/conformance/parallel]",
+		"[sig-cli] oc works [Serial]":          " [Suite:openshift/conformance/serial]",
+		"[sig-cli] oc works without labels":    "",
+		"[sig-cli] oc works [apigroup:foo.io]": " [Skipped:Disconnected] [Suite:openshift/conformance/parallel]",
+	}
+	defer func() { specAnnotations = generated.Annotations }()
+	for text, name := range map[string]string{
+		"[sig-cli] oc works": "[sig-cli] oc works",
+		"[sig-cli] oc works [Suite:openshift/conformance/parallel]":                                                          "[sig-cli] oc works",
+		"[sig-cli] oc works [Serial] [Suite:openshift/conformance/serial]":                                                   "[sig-cli] oc works [Serial]",
+		"[sig-cli] oc works [apigroup:foo.io] [Skipped:Disconnected] [Suite:openshift/conformance/parallel] [Tier:blocking]": "[sig-cli] oc works [apigroup:foo.io]",
+		"[sig-cli] oc works without labels":                                                                                  "[sig-cli] oc works without labels",
+		"[sig-cli] oc is not annotated [Late]":                                                                               "[sig-cli] oc is not annotated [Late]",
+	} {
+		want := "/tmp/artifacts/test-artifacts/" + runnerapi.SpecID(name)
+		if got := specArtifactDir("/tmp/artifacts", text); got != want {
+			t.Errorf("expected %q for %q, got %q", want, text, got)
+		}
+	}
+}
+
+func TestAttachArtifact(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("TEST_ARTIFACT_DIR", dir)
+
+	if err := AttachArtifact(filepath.Join("routes", "route.yaml"), strings.NewReader("kind: Route")); err != nil {
+		t.Fatal(err)
+	}
+	data, err := os.ReadFile(filepath.Join(dir, "routes", "route.yaml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "kind: Route" {
+		t.Errorf("unexpected artifact contents %q", data)
+	}
+}
+
+func TestArtifactPathOutsideTheSpecDir(t *testing.T) {
+	t.Setenv("TEST_ARTIFACT_DIR", t.TempDir())
+	for _, name := range []string{"", "/etc/passwd", "..", "../other"} {
+		t.Run(name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("expected %q to be rejected", name)
+				}
+			}()
+			ArtifactPath(name)
+		})
+	}
+}
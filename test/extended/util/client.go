@@ -102,6 +102,9 @@ type CLI struct {
 	withManagedNamespace bool
 	kubeFramework        *framework.Framework
 
+	// the namespace claimed from the namespace pool for the current spec, if any
+	pooledNamespace string
+
 	// read from a static manifest directory (set through STATIC_CONFIG_MANIFEST_DIR env)
 	configObjects     []runtime.Object
 	resourcesToDelete []resourceRef
@@ -304,6 +307,7 @@ func (c *CLI) setupProject() string {
 	o.Expect(err).NotTo(o.HaveOccurred())
 
 	c.kubeFramework.AddNamespacesToDelete(&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: newNamespace}})
+	recordNamespace(newNamespace)
 
 	framework.Logf("Waiting on permissions in project %q ...", newNamespace)
 	err = WaitForSelfSAR(1*time.Second, 60*time.Second, c.KubeClient(), kubeauthorizationv1.SelfSubjectAccessReviewSpec{
@@ -399,6 +403,7 @@ func (c *CLI) setupNamespace() string {
 	_, err := c.AdminKubeClient().CoreV1().Namespaces().Create(context.Background(), nsObject, metav1.CreateOptions{})
 	o.Expect(err).NotTo(o.HaveOccurred())
 	c.kubeFramework.AddNamespacesToDelete(nsObject)
+	recordNamespace(newNamespace)
 
 	framework.Logf("Waiting for ServiceAccount %q to be provisioned...", serviceAccountName)
 	err = WaitForServiceAccount(c.AdminKubeClient().CoreV1().ServiceAccounts(newNamespace), serviceAccountName)
@@ -582,11 +587,24 @@ func (c *CLI) TeardownProject() {
 	if len(c.Namespace()) > 0 && g.CurrentSpecReport().Failed() && framework.TestContext.DumpLogsOnFailure {
 		e2edebug.DumpAllNamespaceInfo(c.kubeFramework.ClientSet, c.Namespace())
 	}
+	if len(c.Namespace()) > 0 && g.CurrentSpecReport().Failed() {
+		if dir := failedSpecSnapshotDir(g.CurrentSpecReport().FullText()); len(dir) > 0 {
+			if err := snapshotNamespace(context.Background(), c.AdminKubeClient(), c.AdminDynamicClient(), c.Namespace(), dir); err != nil {
+				framework.Logf("Unable to write the failed spec snapshot of %s: %v", c.Namespace(), err)
+			} else {
+				framework.Logf("Wrote the failed spec snapshot of %s to %s", c.Namespace(), dir)
+			}
+		}
+	}
 
 	if len(c.configPath) > 0 {
 		os.Remove(c.configPath)
 	}
 
+	if skipCleanupOfFailedSpec && g.CurrentSpecReport().Failed() {
+		framework.Logf("Keeping the resources of the failed test, as chosen when it paused")
+		return
+	}
 	dynamicClient := c.AdminDynamicClient()
 	for _, resource := range c.resourcesToDelete {
 		err := dynamicClient.Resource(resource.Resource).Namespace(resource.Namespace).Delete(context.Background(), resource.Name, metav1.DeleteOptions{})
@@ -0,0 +1,203 @@
+package util
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	g "github.com/onsi/ginkgo/v2"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// DefaultCLIRetryBackoff is the backoff used by Eventually and RunWithRetry when
+// no other backoff is set. It covers roughly a minute of apiserver disruption.
+var DefaultCLIRetryBackoff = wait.Backoff{
+	Duration: time.Second,
+	Factor:   2,
+	Jitter:   0.2,
+	Steps:    6,
+	Cap:      30 * time.Second,
+}
+
+var (
+	transientCLIErrorSubstrings = []string{
+		// conflicts
+		"the object has been modified",
+		"(conflict)",
+		// timeouts
+		"(timeout)",
+		"i/o timeout",
+		"tls handshake timeout",
+		"context deadline exceeded",
+		"client.timeout exceeded",
+		"etcdserver: request timed out",
+		// apiserver unavailable or restarting
+		"connection refused",
+		"connection reset by peer",
+		"http2: client connection lost",
+		"the server is currently unable to handle the request",
+		"(serviceunavailable)",
+		"(toomanyrequests)",
+	}
+	transientCLIErrorEOF = regexp.MustCompile(`\b(unexpected )?EOF\b`)
+)
+
+// IsTransientCLIError returns true if the stderr or error of an oc invocation
+// indicates a conflict, a timeout, or a dropped connection to the apiserver,
+// all of which are worth retrying.
+func IsTransientCLIError(stdErr string, err error) bool {
+	if err == nil {
+		return false
+	}
+	msg := stdErr + "\n" + err.Error()
+	if transientCLIErrorEOF.MatchString(msg) {
+		return true
+	}
+	msg = strings.ToLower(msg)
+	for _, s := range transientCLIErrorSubstrings {
+		if strings.Contains(msg, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// EventuallyCLI runs oc commands, retrying them with backoff while they fail
+// with a transient error. Create one with CLI.Eventually.
+type EventuallyCLI struct {
+	cli         *CLI
+	backoff     wait.Backoff
+	isRetryable func(stdErr string, err error) bool
+}
+
+// Eventually returns a wrapper around the CLI whose commands are retried with
+// DefaultCLIRetryBackoff while they fail with a transient error:
+//
+//	out, err := oc.Eventually().Run("get").Args("pods").Output()
+func (c *CLI) Eventually() *EventuallyCLI {
+	return &EventuallyCLI{
+		cli:         c,
+		backoff:     DefaultCLIRetryBackoff,
+		isRetryable: IsTransientCLIError,
+	}
+}
+
+// RunWithRetry is a shortcut for c.Eventually().Run(commands...).
+func (c *CLI) RunWithRetry(commands ...string) *RetryingCommand {
+	return c.Eventually().Run(commands...)
+}
+
+// WithBackoff sets the backoff between attempts. Steps is the maximum number of
+// attempts.
+func (e *EventuallyCLI) WithBackoff(backoff wait.Backoff) *EventuallyCLI {
+	e.backoff = backoff
+	return e
+}
+
+// WithRetryOn replaces IsTransientCLIError as the check deciding whether a
+// failed attempt is retried.
+func (e *EventuallyCLI) WithRetryOn(isRetryable func(stdErr string, err error) bool) *EventuallyCLI {
+	e.isRetryable = isRetryable
+	return e
+}
+
+// Run prepares the given OpenShift CLI command verb (iow. "oc <verb>"). Nothing
+// is executed until Output, Outputs or Execute are called.
+func (e *EventuallyCLI) Run(commands ...string) *RetryingCommand {
+	return &RetryingCommand{
+		eventually: e,
+		commands:   commands,
+	}
+}
+
+// RetryingCommand is an oc invocation that is retried until it succeeds, fails
+// with an error that is not retryable, or runs out of attempts.
+type RetryingCommand struct {
+	eventually *EventuallyCLI
+	commands   []string
+	args       []string
+	input      *string
+}
+
+// Args sets the additional arguments for the OpenShift CLI command
+func (r *RetryingCommand) Args(args ...string) *RetryingCommand {
+	r.args = args
+	return r
+}
+
+// InputString sets the input passed to every attempt of the command
+func (r *RetryingCommand) InputString(input string) *RetryingCommand {
+	r.input = &input
+	return r
+}
+
+// Output executes the command and returns stdout/stderr combined into one string
+func (r *RetryingCommand) Output() (string, error) {
+	var out string
+	err := r.retry(func(cmd *CLI) (string, error) {
+		var err error
+		out, err = cmd.Output()
+		return out, err
+	})
+	return out, err
+}
+
+// Outputs executes the command and returns the stdout/stderr output as separate strings
+func (r *RetryingCommand) Outputs() (string, string, error) {
+	var stdOut, stdErr string
+	err := r.retry(func(cmd *CLI) (string, error) {
+		var err error
+		stdOut, stdErr, err = cmd.Outputs()
+		return stdErr, err
+	})
+	return stdOut, stdErr, err
+}
+
+// Execute executes the command and returns an error if the last attempt failed.
+// The output of every attempt is written to the Ginkgo writer.
+func (r *RetryingCommand) Execute() error {
+	_, err := r.Output()
+	return err
+}
+
+// retry calls attempt with a freshly prepared command until it succeeds or the
+// error is not retryable, logging every attempt to the Ginkgo writer.
+func (r *RetryingCommand) retry(attempt func(cmd *CLI) (string, error)) error {
+	e := r.eventually
+	backoff := e.backoff
+	var lastErr error
+	for i := 1; ; i++ {
+		cmd := e.cli.Run(r.commands...).Args(r.args...)
+		if r.input != nil {
+			cmd.InputString(*r.input)
+		}
+		start := time.Now()
+		stdErr, err := attempt(cmd)
+		fmt.Fprintf(g.GinkgoWriter, "Attempt %d of 'oc %s' finished after %s: %s\n", i, cmd.printCmd(), time.Since(start).Round(time.Millisecond), attemptResult(stdErr, err))
+		if err == nil {
+			return nil
+		}
+		lastErr = err
+		if !e.isRetryable(stdErr, err) {
+			return lastErr
+		}
+		if backoff.Steps <= 1 {
+			return fmt.Errorf("giving up on 'oc %s' after %d attempts: %w", cmd.printCmd(), i, lastErr)
+		}
+		delay := backoff.Step()
+		fmt.Fprintf(g.GinkgoWriter, "Retrying 'oc %s' after transient error in %s\n", cmd.printCmd(), delay.Round(time.Millisecond))
+		time.Sleep(delay)
+	}
+}
+
+func attemptResult(output string, err error) string {
+	if err != nil {
+		return fmt.Sprintf("error: %v", err)
+	}
+	if len(output) == 0 {
+		return "ok"
+	}
+	return fmt.Sprintf("ok\n%s", output)
+}
@@ -0,0 +1,37 @@
+package util
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestIsTransientCLIError(t *testing.T) {
+	exitErr := errors.New("exit status 1")
+	tests := []struct {
+		name   string
+		stdErr string
+		err    error
+		want   bool
+	}{
+		{name: "success"},
+		{name: "not found", stdErr: `Error from server (NotFound): pods "foo" not found`, err: exitErr},
+		{name: "forbidden", stdErr: `Error from server (Forbidden): pods is forbidden`, err: exitErr},
+		{name: "conflict", stdErr: `Error from server (Conflict): Operation cannot be fulfilled on configmaps "foo": the object has been modified; please apply your changes to the latest version and try again`, err: exitErr, want: true},
+		{name: "server timeout", stdErr: `Error from server (Timeout): the server was unable to return a response in the time allotted`, err: exitErr, want: true},
+		{name: "i/o timeout", stdErr: `Unable to connect to the server: dial tcp 10.0.0.1:6443: i/o timeout`, err: exitErr, want: true},
+		{name: "tls handshake timeout", stdErr: `Unable to connect to the server: net/http: TLS handshake timeout`, err: exitErr, want: true},
+		{name: "eof", stdErr: `error: Get "https://api:6443/api/v1/pods": EOF`, err: exitErr, want: true},
+		{name: "unexpected eof", stdErr: `error: unexpected EOF`, err: exitErr, want: true},
+		{name: "eof in a name", stdErr: `Error from server (NotFound): configmaps "EOFMARKER" not found`, err: exitErr},
+		{name: "connection refused", stdErr: `The connection to the server api:6443 was refused - did you specify the right host or port?: connection refused`, err: exitErr, want: true},
+		{name: "service unavailable", stdErr: `Error from server (ServiceUnavailable): the server is currently unable to handle the request`, err: exitErr, want: true},
+		{name: "transient text in error", err: errors.New("context deadline exceeded"), want: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := IsTransientCLIError(test.stdErr, test.err); got != test.want {
+				t.Errorf("expected %t, got %t", test.want, got)
+			}
+		})
+	}
+}
@@ -7,11 +7,13 @@ import (
 	"io/ioutil"
 	"net/http"
 	"net/url"
+	"regexp"
 	"strings"
 
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	corev1 "k8s.io/api/core/v1"
+	kapierrs "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
 	clientset "k8s.io/client-go/kubernetes"
@@ -68,6 +70,20 @@ type ClusterConfiguration struct {
 
 	// IsNoOptionalCapabilities indicates the cluster has no optional capabilities enabled
 	HasNoOptionalCapabilities bool
+
+	// DisabledCapabilities are the known optional capabilities that are not enabled. Tests labeled
+	// [Requires:Capability<name>] with one of them are skipped. Capabilities are not checked when the
+	// cluster does not report the capabilities it knows.
+	DisabledCapabilities []string `json:",omitempty"`
+	// EnabledFeatureGates are the feature gates enabled in the cluster. Tests labeled
+	// [Requires:FeatureGate=<name>] with another gate are skipped. Feature gates are not checked when
+	// it is empty, as when the cluster does not report the feature gates of its version.
+	EnabledFeatureGates []string `json:",omitempty"`
+
+	// Profile is set for topologies that lack parts of a standalone cluster. Tests labeled
+	// [Skipped:<profile>] are skipped, and the monitor tests and disruption backends of the profile
+	// are disabled, see Profile.Settings.
+	Profile Profile `json:",omitempty"`
 }
 
 func (c *ClusterConfiguration) ToJSONString() string {
@@ -88,6 +104,14 @@ type ClusterState struct {
 	NetworkSpec          *operatorv1.NetworkSpec
 	ControlPlaneTopology *configv1.TopologyMode
 	OptionalCapabilities []configv1.ClusterVersionCapability
+	// KnownCapabilities is empty if the cluster does not report them
+	KnownCapabilities []configv1.ClusterVersionCapability
+	// EnabledFeatureGates are read from the status of the cluster feature gate for the current version,
+	// and are nil if the cluster does not report them
+	EnabledFeatureGates []string
+	// MicroShift is set when the cluster is MicroShift, which does not serve the config and operator
+	// APIs the rest of the state is read from
+	MicroShift bool
 }
 
 // DiscoverClusterState creates a ClusterState based on a live cluster
@@ -113,6 +137,24 @@ func DiscoverClusterState(clientConfig *rest.Config) (*ClusterState, error) {
 	}
 	state.APIURL = url
 
+	state.Masters, err = coreClient.CoreV1().Nodes().List(context.Background(), metav1.ListOptions{
+		LabelSelector: "node-role.kubernetes.io/master=",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	state.NonMasters, err = coreClient.CoreV1().Nodes().List(context.Background(), metav1.ListOptions{
+		LabelSelector: "!node-role.kubernetes.io/master",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := coreClient.CoreV1().ConfigMaps("kube-public").Get(context.Background(), "microshift-version", metav1.GetOptions{}); err == nil {
+		return discoverMicroShiftState(state, coreClient)
+	}
+
 	infra, err := configClient.ConfigV1().Infrastructures().Get(context.Background(), "cluster", metav1.GetOptions{})
 	if err != nil {
 		return nil, err
@@ -126,32 +168,58 @@ func DiscoverClusterState(clientConfig *rest.Config) (*ClusterState, error) {
 		return nil, fmt.Errorf("status.controlPlaneTopology must be set")
 	}
 
-	state.Masters, err = coreClient.CoreV1().Nodes().List(context.Background(), metav1.ListOptions{
-		LabelSelector: "node-role.kubernetes.io/master=",
-	})
+	networkConfig, err := operatorClient.OperatorV1().Networks().Get(context.Background(), "cluster", metav1.GetOptions{})
 	if err != nil {
 		return nil, err
 	}
+	state.NetworkSpec = &networkConfig.Spec
 
-	state.NonMasters, err = coreClient.CoreV1().Nodes().List(context.Background(), metav1.ListOptions{
-		LabelSelector: "!node-role.kubernetes.io/master",
-	})
+	clusterVersion, err := configClient.ConfigV1().ClusterVersions().Get(context.Background(), "version", metav1.GetOptions{})
 	if err != nil {
 		return nil, err
 	}
+	state.OptionalCapabilities = clusterVersion.Status.Capabilities.EnabledCapabilities
+	state.KnownCapabilities = clusterVersion.Status.Capabilities.KnownCapabilities
 
-	networkConfig, err := operatorClient.OperatorV1().Networks().Get(context.Background(), "cluster", metav1.GetOptions{})
-	if err != nil {
+	// the vendored API does not describe the status of feature gates yet, so it is decoded here
+	data, err := configClient.ConfigV1().RESTClient().Get().Resource("featuregates").Name("cluster").DoRaw(context.Background())
+	switch {
+	case err == nil:
+		state.EnabledFeatureGates, err = enabledFeatureGates(data, clusterVersion.Status.Desired.Version)
+		if err != nil {
+			return nil, err
+		}
+	case !kapierrs.IsNotFound(err):
 		return nil, err
 	}
-	state.NetworkSpec = &networkConfig.Spec
 
-	clusterVersion, err := configClient.ConfigV1().ClusterVersions().Get(context.Background(), "version", metav1.GetOptions{})
+	return state, nil
+}
+
+// discoverMicroShiftState completes the state of a MicroShift cluster, which runs a single node on no
+// particular platform with OVN-Kubernetes and without optional capabilities or feature gates.
+func discoverMicroShiftState(state *ClusterState, coreClient clientset.Interface) (*ClusterState, error) {
+	state.MicroShift = true
+	state.PlatformStatus = &configv1.PlatformStatus{Type: configv1.NonePlatformType}
+	topology := configv1.SingleReplicaTopologyMode
+	state.ControlPlaneTopology = &topology
+
+	// only the IP families of the service network are used, and the kubernetes service has an
+	// address of each
+	service, err := coreClient.CoreV1().Services("default").Get(context.Background(), "kubernetes", metav1.GetOptions{})
 	if err != nil {
 		return nil, err
 	}
-	state.OptionalCapabilities = clusterVersion.Status.Capabilities.EnabledCapabilities
-
+	state.NetworkSpec = &operatorv1.NetworkSpec{
+		DefaultNetwork: operatorv1.DefaultNetworkDefinition{Type: operatorv1.NetworkTypeOVNKubernetes},
+	}
+	for _, ip := range service.Spec.ClusterIPs {
+		if utilnet.IsIPv6String(ip) {
+			state.NetworkSpec.ServiceNetwork = append(state.NetworkSpec.ServiceNetwork, ip+"/128")
+		} else {
+			state.NetworkSpec.ServiceNetwork = append(state.NetworkSpec.ServiceNetwork, ip+"/32")
+		}
+	}
 	return state, nil
 }
 
@@ -171,6 +239,8 @@ func LoadConfig(state *ClusterState) (*ClusterConfiguration, error) {
 	}
 
 	config.HasNoOptionalCapabilities = len(state.OptionalCapabilities) == 0
+	config.DisabledCapabilities = disabledCapabilities(state.KnownCapabilities, state.OptionalCapabilities)
+	config.EnabledFeatureGates = state.EnabledFeatureGates
 
 	if zones.Len() > 0 {
 		config.Zone = zones.List()[0]
@@ -216,6 +286,13 @@ func LoadConfig(state *ClusterState) (*ClusterConfiguration, error) {
 		}
 	}
 
+	switch {
+	case state.MicroShift:
+		config.Profile = MicroShiftProfile
+	case *state.ControlPlaneTopology == configv1.ExternalTopologyMode && !config.IsIBMROKS:
+		config.Profile = HyperShiftProfile
+	}
+
 	config.NetworkPlugin = string(state.NetworkSpec.DefaultNetwork.Type)
 	if state.NetworkSpec.DefaultNetwork.OpenShiftSDNConfig != nil && state.NetworkSpec.DefaultNetwork.OpenShiftSDNConfig.Mode != "" {
 		config.NetworkPluginMode = string(state.NetworkSpec.DefaultNetwork.OpenShiftSDNConfig.Mode)
@@ -250,9 +327,69 @@ func LoadConfig(state *ClusterState) (*ClusterConfiguration, error) {
 	return config, nil
 }
 
+// disabledCapabilities returns the known capabilities that are not enabled, in order.
+func disabledCapabilities(known, enabled []configv1.ClusterVersionCapability) []string {
+	enabledSet := sets.NewString()
+	for _, capability := range enabled {
+		enabledSet.Insert(string(capability))
+	}
+	disabled := sets.NewString()
+	for _, capability := range known {
+		if !enabledSet.Has(string(capability)) {
+			disabled.Insert(string(capability))
+		}
+	}
+	return disabled.List()
+}
+
+// featureGateStatus is the status of a feature gate, which lists the feature gates enabled for each
+// version of the cluster.
+type featureGateStatus struct {
+	Status struct {
+		FeatureGates []struct {
+			Version string `json:"version"`
+			Enabled []struct {
+				Name string `json:"name"`
+			} `json:"enabled"`
+		} `json:"featureGates"`
+	} `json:"status"`
+}
+
+// enabledFeatureGates returns the feature gates the status of a feature gate reports enabled for version,
+// in order, or nil if the status does not report them.
+func enabledFeatureGates(data []byte, version string) ([]string, error) {
+	featureGate := &featureGateStatus{}
+	if err := json.Unmarshal(data, featureGate); err != nil {
+		return nil, fmt.Errorf("unable to decode the cluster feature gate: %v", err)
+	}
+	for _, gates := range featureGate.Status.FeatureGates {
+		if gates.Version != version {
+			continue
+		}
+		enabled := sets.NewString()
+		for _, gate := range gates.Enabled {
+			enabled.Insert(gate.Name)
+		}
+		return enabled.List(), nil
+	}
+	return nil, nil
+}
+
+// requirementRe matches the labels of the capabilities and feature gates a test requires.
+var requirementRe = regexp.MustCompile(`\[Requires:(Capability|FeatureGate=)([^\]]+)\]`)
+
 // MatchFn returns a function that tests if a named function should be run based on
 // the cluster configuration
 func (c *ClusterConfiguration) MatchFn() func(string) bool {
+	skipReason := c.SkipReasonFn()
+	return func(name string) bool {
+		return len(skipReason(name)) == 0
+	}
+}
+
+// SkipReasonFn returns a function that returns the label that excludes a named test from
+// the cluster configuration, or an empty string if the test should be run.
+func (c *ClusterConfiguration) SkipReasonFn() func(string) string {
 	var skips []string
 	skips = append(skips, fmt.Sprintf("[Skipped:%s]", c.ProviderName))
 
@@ -297,15 +434,33 @@ func (c *ClusterConfiguration) MatchFn() func(string) bool {
 		skips = append(skips, "[Skipped:NoOptionalCapabilities]")
 	}
 
-	matchFn := func(name string) bool {
+	if len(c.Profile) > 0 {
+		skips = append(skips, fmt.Sprintf("[Skipped:%s]", c.Profile))
+	}
+
+	disabledCapabilities := sets.NewString(c.DisabledCapabilities...)
+	enabledFeatureGates := sets.NewString(c.EnabledFeatureGates...)
+
+	return func(name string) string {
 		for _, skip := range skips {
 			if strings.Contains(name, skip) {
-				return false
+				return skip
+			}
+		}
+		for _, requirement := range requirementRe.FindAllStringSubmatch(name, -1) {
+			switch requirement[1] {
+			case "Capability":
+				if disabledCapabilities.Has(requirement[2]) {
+					return requirement[0]
+				}
+			case "FeatureGate=":
+				if enabledFeatureGates.Len() > 0 && !enabledFeatureGates.Has(requirement[2]) {
+					return requirement[0]
+				}
 			}
 		}
-		return true
+		return ""
 	}
-	return matchFn
 }
 
 func HasCapability(clusterVersion *configv1.ClusterVersion, desiredCapability string) bool {
@@ -0,0 +1,174 @@
+package cluster
+
+import (
+	"net/url"
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+
+	configv1 "github.com/openshift/api/config/v1"
+	operatorv1 "github.com/openshift/api/operator/v1"
+)
+
+func TestDisabledCapabilities(t *testing.T) {
+	known := []configv1.ClusterVersionCapability{"openshift-samples", "Console", "Build"}
+	enabled := []configv1.ClusterVersionCapability{"Console"}
+	if got, want := disabledCapabilities(known, enabled), []string{"Build", "openshift-samples"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+	if got := disabledCapabilities(nil, enabled); len(got) != 0 {
+		t.Errorf("expected no disabled capabilities when none are known, got %v", got)
+	}
+}
+
+func TestEnabledFeatureGates(t *testing.T) {
+	data := []byte(`{"status":{"featureGates":[
+		{"version":"4.14.0","enabled":[{"name":"NodeSwap"},{"name":"APIPriorityAndFairness"}],"disabled":[{"name":"Custom"}]},
+		{"version":"4.13.0","enabled":[{"name":"APIPriorityAndFairness"}]}
+	]}}`)
+	gates, err := enabledFeatureGates(data, "4.14.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"APIPriorityAndFairness", "NodeSwap"}; !reflect.DeepEqual(gates, want) {
+		t.Errorf("expected %v, got %v", want, gates)
+	}
+	if gates, err := enabledFeatureGates(data, "4.15.0"); err != nil || gates != nil {
+		t.Errorf("expected no feature gates for an unreported version, got %v, %v", gates, err)
+	}
+	if gates, err := enabledFeatureGates([]byte(`{"spec":{"featureSet":"TechPreviewNoUpgrade"}}`), "4.14.0"); err != nil || gates != nil {
+		t.Errorf("expected no feature gates without a status, got %v, %v", gates, err)
+	}
+}
+
+func TestSkipReasonFnRequirements(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   ClusterConfiguration
+		test     string
+		expected string
+	}{
+		{
+			name:     "disabled capability",
+			config:   ClusterConfiguration{DisabledCapabilities: []string{"Build"}},
+			test:     "builds [Requires:CapabilityBuild] [Requires:FeatureGate=NodeSwap]",
+			expected: "[Requires:CapabilityBuild]",
+		},
+		{
+			name:   "enabled capability",
+			config: ClusterConfiguration{DisabledCapabilities: []string{"Console"}},
+			test:   "builds [Requires:CapabilityBuild]",
+		},
+		{
+			name:     "disabled feature gate",
+			config:   ClusterConfiguration{EnabledFeatureGates: []string{"APIPriorityAndFairness"}},
+			test:     "swap [Requires:FeatureGate=APIPriorityAndFairness] [Requires:FeatureGate=NodeSwap]",
+			expected: "[Requires:FeatureGate=NodeSwap]",
+		},
+		{
+			name:   "enabled feature gate",
+			config: ClusterConfiguration{EnabledFeatureGates: []string{"NodeSwap"}},
+			test:   "swap [Requires:FeatureGate=NodeSwap]",
+		},
+		{
+			name: "unknown feature gates",
+			test: "swap [Requires:FeatureGate=NodeSwap]",
+		},
+		{
+			name:     "profile",
+			config:   ClusterConfiguration{Profile: HyperShiftProfile},
+			test:     "etcd members [Skipped:HyperShift]",
+			expected: "[Skipped:HyperShift]",
+		},
+		{
+			name:   "other profile",
+			config: ClusterConfiguration{Profile: MicroShiftProfile},
+			test:   "etcd members [Skipped:HyperShift]",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.config.HasIPv4, tt.config.HasIPv6, tt.config.HasSCTP = true, true, true
+			if got := tt.config.SkipReasonFn()(tt.test); got != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestLoadConfigProfile(t *testing.T) {
+	state := func(platform configv1.PlatformStatus, topology configv1.TopologyMode) *ClusterState {
+		return &ClusterState{
+			APIURL:               &url.URL{Scheme: "https", Host: "api.example.com"},
+			PlatformStatus:       &platform,
+			ControlPlaneTopology: &topology,
+			Masters:              &corev1.NodeList{},
+			NonMasters:           &corev1.NodeList{},
+			NetworkSpec:          &operatorv1.NetworkSpec{ServiceNetwork: []string{"172.30.0.0/16"}},
+		}
+	}
+	microShift := state(configv1.PlatformStatus{Type: configv1.NonePlatformType}, configv1.SingleReplicaTopologyMode)
+	microShift.MicroShift = true
+
+	tests := []struct {
+		name     string
+		state    *ClusterState
+		expected Profile
+	}{
+		{
+			name:  "standalone",
+			state: state(configv1.PlatformStatus{AWS: &configv1.AWSPlatformStatus{}}, configv1.HighlyAvailableTopologyMode),
+		},
+		{
+			name:     "hosted control plane",
+			state:    state(configv1.PlatformStatus{AWS: &configv1.AWSPlatformStatus{}}, configv1.ExternalTopologyMode),
+			expected: HyperShiftProfile,
+		},
+		{
+			name:  "managed IBM cloud",
+			state: state(configv1.PlatformStatus{IBMCloud: &configv1.IBMCloudPlatformStatus{}}, configv1.ExternalTopologyMode),
+		},
+		{
+			name:     "microshift",
+			state:    microShift,
+			expected: MicroShiftProfile,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config, err := LoadConfig(tt.state)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if config.Profile != tt.expected {
+				t.Errorf("expected profile %q, got %q", tt.expected, config.Profile)
+			}
+		})
+	}
+}
+
+func TestProfileSettings(t *testing.T) {
+	if settings, err := Profile("").Settings(); err != nil || len(settings.DisabledMonitorTests) > 0 || len(settings.DisabledDisruptionBackends) > 0 {
+		t.Errorf("expected no profile to disable nothing, got %#v, %v", settings, err)
+	}
+	settings, err := MicroShiftProfile.Settings()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !contains(settings.DisabledMonitorTests, "cluster-version-operator") || !contains(settings.DisabledDisruptionBackends, "oauth-api") {
+		t.Errorf("unexpected microshift settings %#v", settings)
+	}
+	if _, err := Profile("Kind").Settings(); err == nil {
+		t.Errorf("expected an unknown profile to be an error")
+	}
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
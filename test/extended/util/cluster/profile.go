@@ -0,0 +1,55 @@
+package cluster
+
+import "fmt"
+
+// Profile identifies a topology that lacks parts of a standalone OpenShift cluster. The tests, monitor
+// tests, and disruption backends that depend on the missing parts are disabled for the profile, so
+// running against the topology does not need a hand-maintained skip expression.
+type Profile string
+
+const (
+	// MicroShiftProfile is a MicroShift cluster. It runs a single node without the cluster version
+	// operator, cluster operators, machine API, or the OpenShift and OAuth API servers.
+	MicroShiftProfile Profile = "MicroShift"
+	// HyperShiftProfile is the guest cluster of a HyperShift hosted control plane. The control plane,
+	// including etcd and the audit logs of the API servers, runs outside the cluster, and the cluster has
+	// no control plane nodes, machine API, or machine config pools.
+	HyperShiftProfile Profile = "HyperShift"
+)
+
+// ProfileSettings are the parts of a run a profile disables. The tests of a profile are skipped by the
+// [Skipped:<profile>] rules of the skip rules.
+type ProfileSettings struct {
+	// DisabledMonitorTests are the names of the monitor tests that do not apply to the topology
+	DisabledMonitorTests []string
+	// DisabledDisruptionBackends are the names of the disruption backends the topology does not serve
+	DisabledDisruptionBackends []string
+}
+
+var profileSettings = map[Profile]ProfileSettings{
+	MicroShiftProfile: {
+		DisabledMonitorTests: []string{"cluster-version-operator"},
+		DisabledDisruptionBackends: []string{
+			"openshift-api",
+			"cache-openshift-api",
+			"oauth-api",
+			"cache-oauth-api",
+		},
+	},
+	HyperShiftProfile: {
+		// the audit logs of the API servers are not available from the guest cluster
+		DisabledMonitorTests: []string{"pod-security-violations"},
+	},
+}
+
+// Settings returns what the profile disables. The empty profile disables nothing.
+func (p Profile) Settings() (ProfileSettings, error) {
+	if len(p) == 0 {
+		return ProfileSettings{}, nil
+	}
+	settings, ok := profileSettings[p]
+	if !ok {
+		return ProfileSettings{}, fmt.Errorf("unknown cluster profile %q, must be one of %s or %s", p, MicroShiftProfile, HyperShiftProfile)
+	}
+	return settings, nil
+}
@@ -0,0 +1,56 @@
+package util
+
+import (
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+
+	e2e "k8s.io/kubernetes/test/e2e/framework"
+)
+
+var (
+	deadlineLock      sync.Mutex
+	deadlineCallbacks []func()
+	deadlineNotified  bool
+)
+
+// OnApproachingDeadline registers fn to be called once when openshift-tests warns that the current
+// test is about to reach its timeout. Long polls can use it to log what they are waiting for or to
+// stop early with a useful failure instead of being interrupted. If the warning was already received,
+// fn is called immediately.
+func OnApproachingDeadline(fn func()) {
+	deadlineLock.Lock()
+	notified := deadlineNotified
+	if !notified {
+		deadlineCallbacks = append(deadlineCallbacks, fn)
+	}
+	deadlineLock.Unlock()
+	if notified {
+		fn()
+	}
+}
+
+// WatchForApproachingDeadline handles the SIGUSR2 that openshift-tests sends a test process shortly
+// before the test times out, by logging a warning and invoking the callbacks registered with
+// OnApproachingDeadline. The warning does not stop the test, which runs until it completes or
+// openshift-tests interrupts it at its timeout. It is called before the test runs, so a warning sent
+// early in the test is not missed.
+func WatchForApproachingDeadline() {
+	ch := make(chan os.Signal, 1)
+	signal.Notify(ch, syscall.SIGUSR2)
+	go func() {
+		<-ch
+		signal.Stop(ch)
+		deadlineLock.Lock()
+		deadlineNotified = true
+		callbacks := deadlineCallbacks
+		deadlineCallbacks = nil
+		deadlineLock.Unlock()
+
+		e2e.Logf("Warning: the test is approaching its timeout and will be interrupted soon")
+		for _, fn := range callbacks {
+			fn()
+		}
+	}()
+}
@@ -146,6 +146,12 @@ func startOAuthAPIMonitoringWithConnectionReuseAgainstAPICache(ctx context.Conte
 	return backendSampler.StartEndpointMonitoring(ctx, m, nil)
 }
 
+// NewKubeAPIWithNewConnectionsBackendSampler returns the sampler of the kube-apiserver that opens a
+// new connection for every request.
+func NewKubeAPIWithNewConnectionsBackendSampler(clusterConfig *rest.Config) (*backenddisruption.BackendSampler, error) {
+	return createKubeAPIMonitoringWithNewConnections(clusterConfig)
+}
+
 func createKubeAPIMonitoringWithNewConnections(clusterConfig *rest.Config) (*backenddisruption.BackendSampler, error) {
 	return createAPIServerBackendSampler(clusterConfig, "kube-api", "/api/v1/namespaces/default", monitorapi.NewConnectionType)
 }
@@ -0,0 +1,18 @@
+package util
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ExpectFailure returns the label that marks a test as expected to fail because of a known bug, to
+// be appended to the name of the test:
+//
+//	g.It("should reject the update"+exutil.ExpectFailure("https://issues.redhat.com/browse/OCPBUGS-1234"), func() {
+//
+// The test keeps running. A failure is reported as failed as expected and counts as a pass, and a pass
+// is reported as an unexpected pass and fails the suite, so the label is removed once the bug is fixed.
+func ExpectFailure(reason string) string {
+	reason = strings.NewReplacer("[", "(", "]", ")").Replace(reason)
+	return fmt.Sprintf(" [ExpectedFailure:%s]", reason)
+}
@@ -0,0 +1,132 @@
+package util
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+
+	g "github.com/onsi/ginkgo/v2"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/kubernetes/test/e2e/framework"
+
+	"github.com/openshift/origin/pkg/test/ginkgo/runnerapi"
+)
+
+// failedSpecSnapshotResources are the namespaced resources written to the snapshot of a failed
+// spec. Secrets are left out so credentials do not end up in the artifacts.
+var failedSpecSnapshotResources = []schema.GroupVersionResource{
+	{Version: "v1", Resource: "pods"},
+	{Version: "v1", Resource: "services"},
+	{Version: "v1", Resource: "endpoints"},
+	{Version: "v1", Resource: "configmaps"},
+	{Version: "v1", Resource: "events"},
+	{Version: "v1", Resource: "persistentvolumeclaims"},
+	{Version: "v1", Resource: "serviceaccounts"},
+	{Group: "apps", Version: "v1", Resource: "deployments"},
+	{Group: "apps", Version: "v1", Resource: "replicasets"},
+	{Group: "apps", Version: "v1", Resource: "statefulsets"},
+	{Group: "apps", Version: "v1", Resource: "daemonsets"},
+	{Group: "batch", Version: "v1", Resource: "jobs"},
+	{Group: "apps.openshift.io", Version: "v1", Resource: "deploymentconfigs"},
+	{Group: "build.openshift.io", Version: "v1", Resource: "builds"},
+	{Group: "build.openshift.io", Version: "v1", Resource: "buildconfigs"},
+	{Group: "image.openshift.io", Version: "v1", Resource: "imagestreams"},
+	{Group: "route.openshift.io", Version: "v1", Resource: "routes"},
+}
+
+var unsafePathCharsRe = regexp.MustCompile(`[^a-zA-Z0-9._-]+`)
+
+// recordNamespace adds the namespace to the report of the current spec.
+func recordNamespace(namespace string) {
+	g.AddReportEntry(runnerapi.NamespaceReportEntry, namespace, g.ReportEntryVisibilityNever)
+}
+
+// failedSpecSnapshotDir returns the directory the snapshot of a failed spec is written to,
+// failed-specs/<test-name> under ARTIFACT_DIR, so the snapshots of a run are found in one place.
+// It returns an empty string when ARTIFACT_DIR is not set.
+func failedSpecSnapshotDir(testName string) string {
+	if path := os.Getenv("ARTIFACT_DIR"); len(path) > 0 {
+		return filepath.Join(path, "failed-specs", safePathSegment(testName))
+	}
+	return ""
+}
+
+// safePathSegment turns a test name into a single, bounded path segment.
+func safePathSegment(name string) string {
+	segment := unsafePathCharsRe.ReplaceAllString(name, "_")
+	if len(segment) > 200 {
+		segment = segment[:200]
+	}
+	return segment
+}
+
+// snapshotNamespace writes the objects, events and pod logs of the namespace to dir/namespace, so a
+// failed spec can be debugged without the must-gather of the whole cluster. Resources that cannot be
+// listed are skipped.
+func snapshotNamespace(ctx context.Context, kubeClient kubernetes.Interface, dynamicClient dynamic.Interface, namespace, dir string) error {
+	dir = filepath.Join(dir, namespace)
+	if err := os.MkdirAll(filepath.Join(dir, "logs"), 0755); err != nil {
+		return err
+	}
+	for _, gvr := range failedSpecSnapshotResources {
+		list, err := dynamicClient.Resource(gvr).Namespace(namespace).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			framework.Logf("Unable to list %s in %s for the failed spec snapshot: %v", gvr.Resource, namespace, err)
+			continue
+		}
+		if len(list.Items) == 0 {
+			continue
+		}
+		data, err := json.MarshalIndent(list, "", "  ")
+		if err != nil {
+			return err
+		}
+		name := gvr.Resource
+		if len(gvr.Group) > 0 {
+			name = fmt.Sprintf("%s.%s", gvr.Resource, gvr.Group)
+		}
+		if err := ioutil.WriteFile(filepath.Join(dir, name+".json"), data, 0644); err != nil {
+			return err
+		}
+	}
+
+	pods, err := kubeClient.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	for _, pod := range pods.Items {
+		var statuses []corev1.ContainerStatus
+		statuses = append(statuses, pod.Status.InitContainerStatuses...)
+		statuses = append(statuses, pod.Status.ContainerStatuses...)
+		for _, status := range statuses {
+			writePodLog(ctx, kubeClient, pod, status.Name, false, dir)
+			if status.RestartCount > 0 {
+				writePodLog(ctx, kubeClient, pod, status.Name, true, dir)
+			}
+		}
+	}
+	return nil
+}
+
+func writePodLog(ctx context.Context, kubeClient kubernetes.Interface, pod corev1.Pod, container string, previous bool, dir string) {
+	name := fmt.Sprintf("%s_%s.log", pod.Name, container)
+	if previous {
+		name = fmt.Sprintf("%s_%s_previous.log", pod.Name, container)
+	}
+	data, err := kubeClient.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, &corev1.PodLogOptions{Container: container, Previous: previous}).DoRaw(ctx)
+	if err != nil {
+		framework.Logf("Unable to get the logs of %s/%s container %s for the failed spec snapshot: %v", pod.Namespace, pod.Name, container, err)
+		return
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "logs", name), data, 0644); err != nil {
+		framework.Logf("Unable to write the logs of %s/%s container %s: %v", pod.Namespace, pod.Name, container, err)
+	}
+}
@@ -0,0 +1,40 @@
+package util
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestFailedSpecSnapshotDir(t *testing.T) {
+	tests := []struct {
+		name            string
+		testArtifactDir string
+		artifactDir     string
+		want            string
+	}{
+		{name: "no artifact dirs"},
+		{name: "test artifact dir", testArtifactDir: "/tmp/test", artifactDir: "/tmp/artifacts", want: "/tmp/artifacts/failed-specs/_sig-cli_oc_works_Suite_openshift_"},
+		{name: "only test artifact dir", testArtifactDir: "/tmp/test"},
+		{name: "artifact dir", artifactDir: "/tmp/artifacts", want: "/tmp/artifacts/failed-specs/_sig-cli_oc_works_Suite_openshift_"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Setenv("TEST_ARTIFACT_DIR", test.testArtifactDir)
+			t.Setenv("ARTIFACT_DIR", test.artifactDir)
+			if got := failedSpecSnapshotDir("[sig-cli] oc works [Suite:openshift]"); got != test.want {
+				t.Errorf("expected %q, got %q", test.want, got)
+			}
+		})
+	}
+}
+
+func TestSafePathSegment(t *testing.T) {
+	segment := safePathSegment(strings.Repeat("a/b ", 100))
+	if len(segment) != 200 {
+		t.Errorf("expected the segment to be truncated to 200 characters, got %d", len(segment))
+	}
+	if filepath.Base(segment) != segment {
+		t.Errorf("expected a single path segment, got %q", segment)
+	}
+}
@@ -0,0 +1,75 @@
+package util
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"text/template"
+
+	g "github.com/onsi/ginkgo/v2"
+
+	utilimage "github.com/openshift/origin/test/extended/util/image"
+)
+
+// fixtureTemplateFuncs are available to every fixture rendered by TemplateFixture.
+// Image references resolve through the test image mirror, like the image values
+// replaced in plain fixtures.
+var fixtureTemplateFuncs = template.FuncMap{
+	"image":             utilimage.LocationFor,
+	"shellImage":        utilimage.ShellImage,
+	"limitedShellImage": utilimage.LimitedShellImage,
+}
+
+// TemplateFixture renders the fixture at fixturePath, usually the result of FixturePath,
+// as a Go template with the provided params and returns the path of the rendered copy.
+// Every call writes to its own directory, which is removed when the test ends, so tests
+// running in parallel never share a rendered fixture. Referencing a parameter that was
+// not provided is an error. In addition to params, templates may call:
+//
+//	{{ image "registry.k8s.io/e2e-test-images/agnhost:2.43" }}
+//	{{ shellImage }}
+//	{{ limitedShellImage }}
+func TemplateFixture(fixturePath string, params map[string]interface{}) (string, error) {
+	requiresTestStart()
+	data, err := os.ReadFile(fixturePath)
+	if err != nil {
+		return "", err
+	}
+	rendered, err := renderFixtureTemplate(filepath.Base(fixturePath), data, params)
+	if err != nil {
+		return "", err
+	}
+	dir, err := os.MkdirTemp("", "fixture-template-")
+	if err != nil {
+		return "", err
+	}
+	g.DeferCleanup(os.RemoveAll, dir)
+	renderedPath := filepath.Join(dir, filepath.Base(fixturePath))
+	if err := os.WriteFile(renderedPath, rendered, 0640); err != nil {
+		return "", err
+	}
+	return renderedPath, nil
+}
+
+// TemplateFixture renders a fixture like TemplateFixture, setting the Namespace parameter
+// to the namespace of the CLI unless params already contains it.
+func (c *CLI) TemplateFixture(fixturePath string, params map[string]interface{}) (string, error) {
+	withNamespace := map[string]interface{}{"Namespace": c.Namespace()}
+	for k, v := range params {
+		withNamespace[k] = v
+	}
+	return TemplateFixture(fixturePath, withNamespace)
+}
+
+func renderFixtureTemplate(name string, data []byte, params map[string]interface{}) ([]byte, error) {
+	tmpl, err := template.New(name).Funcs(fixtureTemplateFuncs).Option("missingkey=error").Parse(string(data))
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse fixture %s: %w", name, err)
+	}
+	var out bytes.Buffer
+	if err := tmpl.Execute(&out, params); err != nil {
+		return nil, fmt.Errorf("unable to render fixture %s: %w", name, err)
+	}
+	return out.Bytes(), nil
+}
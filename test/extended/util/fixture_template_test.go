@@ -0,0 +1,49 @@
+package util
+
+import (
+	"testing"
+)
+
+func TestRenderFixtureTemplate(t *testing.T) {
+	tests := []struct {
+		name    string
+		fixture string
+		params  map[string]interface{}
+		want    string
+		wantErr bool
+	}{
+		{
+			name:    "no parameters",
+			fixture: "kind: Pod\n",
+			want:    "kind: Pod\n",
+		},
+		{
+			name:    "parameters",
+			fixture: "metadata:\n  namespace: {{ .Namespace }}\n  name: {{ .Name }}\n",
+			params:  map[string]interface{}{"Namespace": "e2e-test-foo", "Name": "bar"},
+			want:    "metadata:\n  namespace: e2e-test-foo\n  name: bar\n",
+		},
+		{
+			name:    "missing parameter",
+			fixture: "metadata:\n  namespace: {{ .Namespace }}\n",
+			params:  map[string]interface{}{"Name": "bar"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid template",
+			fixture: "metadata:\n  namespace: {{ .Namespace\n",
+			wantErr: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := renderFixtureTemplate("pod.yaml", []byte(test.fixture), test.params)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("expected error %t, got %v", test.wantErr, err)
+			}
+			if string(got) != test.want {
+				t.Errorf("expected %q, got %q", test.want, string(got))
+			}
+		})
+	}
+}
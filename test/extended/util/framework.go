@@ -1407,12 +1407,6 @@ func ArtifactDirPath() string {
 	return path
 }
 
-// ArtifactPath returns the absolute path to the fix artifact file
-// The path is relative to ARTIFACT_DIR
-func ArtifactPath(elem ...string) string {
-	return filepath.Join(append([]string{ArtifactDirPath()}, elem...)...)
-}
-
 func prefixFixturePath(elem []string) []string {
 	switch {
 	case len(elem) == 0:
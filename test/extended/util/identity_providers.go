@@ -0,0 +1,466 @@
+package util
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	g "github.com/onsi/ginkgo/v2"
+	"golang.org/x/crypto/bcrypt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/apiserver/pkg/storage/names"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/kubernetes/test/e2e/framework"
+	"k8s.io/kubernetes/test/e2e/framework/skipper"
+	"k8s.io/utils/pointer"
+
+	configv1 "github.com/openshift/api/config/v1"
+	userv1 "github.com/openshift/api/user/v1"
+	"github.com/openshift/library-go/pkg/oauth/tokenrequest"
+	"github.com/openshift/library-go/pkg/oauth/tokenrequest/challengehandlers"
+)
+
+const (
+	// identityProviderLockName is the Lease in the openshift-config namespace held by the test that
+	// changes the identity providers of the cluster, because every test runs in its own process.
+	identityProviderLockName = "e2e-identity-providers"
+	// identityProviderLockDuration is how long the lock outlives a test process that died holding it.
+	identityProviderLockDuration = 5 * time.Minute
+
+	oauthServerNamespace  = "openshift-authentication"
+	oauthServerDeployment = "oauth-openshift"
+)
+
+// TestIdentityProvider is an identity provider added to the cluster OAuth configuration for the
+// duration of a test. Only one test changes the identity providers at a time. The original
+// configuration is restored, and the users, identities, and groups the test created are deleted,
+// when the test ends.
+type TestIdentityProvider struct {
+	// Name is the name of the identity provider, which prefixes the names of its identities.
+	Name string
+
+	oc        *CLI
+	passwords map[string]string
+	original  *configv1.OAuthSpec
+	secrets   []string
+	configMap string
+	users     []string
+	groups    []string
+	lock      *identityProviderLock
+}
+
+// InstallHTPasswdIdentityProvider adds an htpasswd identity provider with the given users and
+// passwords to the cluster, and waits for the OAuth server to use it. It skips the test on clusters
+// that do not allow changing their identity providers.
+func InstallHTPasswdIdentityProvider(oc *CLI, passwords map[string]string) (*TestIdentityProvider, error) {
+	var usernames []string
+	for username := range passwords {
+		usernames = append(usernames, username)
+	}
+	sort.Strings(usernames)
+	var htpasswd []string
+	for _, username := range usernames {
+		hash, err := bcrypt.GenerateFromPassword([]byte(passwords[username]), bcrypt.DefaultCost)
+		if err != nil {
+			return nil, err
+		}
+		htpasswd = append(htpasswd, fmt.Sprintf("%s:%s", username, hash))
+	}
+
+	p := newTestIdentityProvider(oc, "e2e-htpasswd-")
+	p.passwords = passwords
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: p.Name},
+		Data:       map[string][]byte{"htpasswd": []byte(strings.Join(htpasswd, "\n") + "\n")},
+	}
+	return p, p.install(configv1.IdentityProvider{
+		Name:          p.Name,
+		MappingMethod: configv1.MappingMethodClaim,
+		IdentityProviderConfig: configv1.IdentityProviderConfig{
+			Type:     configv1.IdentityProviderTypeHTPasswd,
+			HTPasswd: &configv1.HTPasswdIdentityProvider{FileData: configv1.SecretNameReference{Name: secret.Name}},
+		},
+	}, secret, nil)
+}
+
+// InstallOIDCIdentityProvider adds an OpenID Connect identity provider to the cluster and waits for
+// the OAuth server to use it. The client secret and the CA bundle that verifies the issuer, if set,
+// are stored in the openshift-config namespace and referenced by the provider. It skips the test on
+// clusters that do not allow changing their identity providers.
+func InstallOIDCIdentityProvider(oc *CLI, provider configv1.OpenIDIdentityProvider, clientSecret, caBundle string) (*TestIdentityProvider, error) {
+	p := newTestIdentityProvider(oc, "e2e-oidc-")
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: p.Name},
+		Data:       map[string][]byte{"clientSecret": []byte(clientSecret)},
+	}
+	provider.ClientSecret = configv1.SecretNameReference{Name: secret.Name}
+	var configMap *corev1.ConfigMap
+	if len(caBundle) > 0 {
+		configMap = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: p.Name},
+			Data:       map[string]string{"ca.crt": caBundle},
+		}
+		provider.CA = configv1.ConfigMapNameReference{Name: configMap.Name}
+	}
+	return p, p.install(configv1.IdentityProvider{
+		Name:          p.Name,
+		MappingMethod: configv1.MappingMethodClaim,
+		IdentityProviderConfig: configv1.IdentityProviderConfig{
+			Type:   configv1.IdentityProviderTypeOpenID,
+			OpenID: &provider,
+		},
+	}, secret, configMap)
+}
+
+func newTestIdentityProvider(oc *CLI, prefix string) *TestIdentityProvider {
+	return &TestIdentityProvider{
+		Name: names.SimpleNameGenerator.GenerateName(prefix),
+		oc:   oc,
+	}
+}
+
+// install takes the identity provider lock, adds the provider to the cluster OAuth configuration, and
+// registers the cleanup of the test.
+func (p *TestIdentityProvider) install(provider configv1.IdentityProvider, secret *corev1.Secret, configMap *corev1.ConfigMap) error {
+	topology, err := GetControlPlaneTopology(p.oc)
+	if err != nil {
+		return err
+	}
+	if *topology == configv1.ExternalTopologyMode {
+		skipper.Skipf("External clusters do not allow customization of the identity providers of the cluster.")
+	}
+
+	ctx := context.Background()
+	p.lock = newIdentityProviderLock(p.oc, p.Name)
+	if err := p.lock.acquire(ctx); err != nil {
+		return fmt.Errorf("unable to take the identity provider lock: %v", err)
+	}
+	g.DeferCleanup(p.cleanup)
+
+	kubeClient := p.oc.AdminKubeClient()
+	if _, err := kubeClient.CoreV1().Secrets("openshift-config").Create(ctx, secret, metav1.CreateOptions{}); err != nil {
+		return err
+	}
+	p.secrets = append(p.secrets, secret.Name)
+	if configMap != nil {
+		if _, err := kubeClient.CoreV1().ConfigMaps("openshift-config").Create(ctx, configMap, metav1.CreateOptions{}); err != nil {
+			return err
+		}
+		p.configMap = configMap.Name
+	}
+
+	generation, err := oauthServerGeneration(ctx, p.oc)
+	if err != nil {
+		return err
+	}
+	err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		oauth, err := p.oc.AdminConfigClient().ConfigV1().OAuths().Get(ctx, "cluster", metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		if p.original == nil {
+			p.original = oauth.Spec.DeepCopy()
+		}
+		oauth.Spec.IdentityProviders = append(oauth.Spec.IdentityProviders, provider)
+		_, err = p.oc.AdminConfigClient().ConfigV1().OAuths().Update(ctx, oauth, metav1.UpdateOptions{})
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("unable to add identity provider %s: %v", p.Name, err)
+	}
+	framework.Logf("Added identity provider %s, waiting for the OAuth server to roll out", p.Name)
+	return waitForOAuthServerRollout(ctx, p.oc, generation)
+}
+
+// RequestToken logs in as a user of the identity provider with the challenging OAuth client and
+// returns the token. The user and its identities are deleted when the test ends.
+func (p *TestIdentityProvider) RequestToken(username, password string) (string, error) {
+	config := rest.AnonymousClientConfig(p.oc.AdminConfig())
+	token, err := tokenrequest.RequestToken(config, challengehandlers.NewBasicChallengeHandler(config.Host, os.Stdin, os.Stdout, nil, username, password))
+	if err != nil {
+		return "", fmt.Errorf("unable to log in as %s with identity provider %s: %v", username, p.Name, err)
+	}
+	p.addUser(username)
+	return token, nil
+}
+
+// UserToken returns a token for a user of an htpasswd identity provider.
+func (p *TestIdentityProvider) UserToken(username string) (string, error) {
+	password, ok := p.passwords[username]
+	if !ok {
+		return "", fmt.Errorf("identity provider %s has no password for user %s", p.Name, username)
+	}
+	return p.RequestToken(username, password)
+}
+
+// UserConfig returns a client config that authenticates as a user of an htpasswd identity provider.
+func (p *TestIdentityProvider) UserConfig(username string) (*rest.Config, error) {
+	token, err := p.UserToken(username)
+	if err != nil {
+		return nil, err
+	}
+	config := rest.AnonymousClientConfig(p.oc.AdminConfig())
+	config.BearerToken = token
+	return config, nil
+}
+
+// CreateGroup creates a group of users, which is deleted when the test ends.
+func (p *TestIdentityProvider) CreateGroup(name string, users ...string) (*userv1.Group, error) {
+	group, err := p.oc.AdminUserClient().UserV1().Groups().Create(context.Background(), &userv1.Group{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Users:      users,
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return nil, err
+	}
+	p.groups = append(p.groups, name)
+	return group, nil
+}
+
+func (p *TestIdentityProvider) addUser(username string) {
+	for _, user := range p.users {
+		if user == username {
+			return
+		}
+	}
+	p.users = append(p.users, username)
+}
+
+// cleanup deletes the objects the test created and restores the OAuth configuration of the cluster
+// before releasing the identity provider lock.
+func (p *TestIdentityProvider) cleanup() error {
+	ctx := context.Background()
+	var errs []error
+	userClient := p.oc.AdminUserClient().UserV1()
+	for _, name := range p.groups {
+		if err := userClient.Groups().Delete(ctx, name, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			errs = append(errs, err)
+		}
+	}
+	for _, name := range p.users {
+		user, err := userClient.Users().Get(ctx, name, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		for _, identity := range user.Identities {
+			if err := userClient.Identities().Delete(ctx, identity, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+				errs = append(errs, err)
+			}
+		}
+		if err := userClient.Users().Delete(ctx, name, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			errs = append(errs, err)
+		}
+	}
+
+	if p.original != nil {
+		if err := p.restore(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("unable to restore the OAuth configuration of the cluster: %v", err))
+		}
+	}
+
+	kubeClient := p.oc.AdminKubeClient()
+	for _, name := range p.secrets {
+		if err := kubeClient.CoreV1().Secrets("openshift-config").Delete(ctx, name, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			errs = append(errs, err)
+		}
+	}
+	if len(p.configMap) > 0 {
+		if err := kubeClient.CoreV1().ConfigMaps("openshift-config").Delete(ctx, p.configMap, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			errs = append(errs, err)
+		}
+	}
+
+	if err := p.lock.release(ctx); err != nil {
+		errs = append(errs, fmt.Errorf("unable to release the identity provider lock: %v", err))
+	}
+	return utilerrors.NewAggregate(errs)
+}
+
+func (p *TestIdentityProvider) restore(ctx context.Context) error {
+	generation, err := oauthServerGeneration(ctx, p.oc)
+	if err != nil {
+		return err
+	}
+	err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		oauth, err := p.oc.AdminConfigClient().ConfigV1().OAuths().Get(ctx, "cluster", metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		oauth.Spec = *p.original
+		_, err = p.oc.AdminConfigClient().ConfigV1().OAuths().Update(ctx, oauth, metav1.UpdateOptions{})
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	framework.Logf("Removed identity provider %s, waiting for the OAuth server to roll out", p.Name)
+	return waitForOAuthServerRollout(ctx, p.oc, generation)
+}
+
+func oauthServerGeneration(ctx context.Context, oc *CLI) (int64, error) {
+	deployment, err := oc.AdminKubeClient().AppsV1().Deployments(oauthServerNamespace).Get(ctx, oauthServerDeployment, metav1.GetOptions{})
+	if err != nil {
+		return 0, err
+	}
+	return deployment.Generation, nil
+}
+
+// waitForOAuthServerRollout waits for the authentication operator to update the OAuth server
+// deployment past generation and for every replica to run the new configuration.
+func waitForOAuthServerRollout(ctx context.Context, oc *CLI, generation int64) error {
+	var last *appsv1.Deployment
+	err := wait.PollImmediateWithContext(ctx, 5*time.Second, 10*time.Minute, func(ctx context.Context) (bool, error) {
+		deployment, err := oc.AdminKubeClient().AppsV1().Deployments(oauthServerNamespace).Get(ctx, oauthServerDeployment, metav1.GetOptions{})
+		if err != nil {
+			framework.Logf("Unable to get the OAuth server deployment: %v", err)
+			return false, nil
+		}
+		last = deployment
+		return deploymentRolledOutSince(deployment, generation), nil
+	})
+	if err != nil && last != nil {
+		return fmt.Errorf("the OAuth server did not roll out the new configuration: generation %d, observed generation %d, %d updated and %d available of %d replicas: %v",
+			last.Generation, last.Status.ObservedGeneration, last.Status.UpdatedReplicas, last.Status.AvailableReplicas, last.Status.Replicas, err)
+	}
+	return err
+}
+
+// deploymentRolledOutSince returns true if the deployment changed after generation and all of its
+// replicas are updated and available.
+func deploymentRolledOutSince(deployment *appsv1.Deployment, generation int64) bool {
+	if deployment.Generation <= generation || deployment.Status.ObservedGeneration < deployment.Generation {
+		return false
+	}
+	replicas := int32(1)
+	if deployment.Spec.Replicas != nil {
+		replicas = *deployment.Spec.Replicas
+	}
+	status := deployment.Status
+	return status.UpdatedReplicas == replicas && status.AvailableReplicas == replicas && status.Replicas == replicas
+}
+
+// identityProviderLock is a Lease that serializes the tests that change the identity providers of the
+// cluster. The holder renews it while the test runs, and another test may take it once it expires.
+type identityProviderLock struct {
+	oc     *CLI
+	holder string
+	cancel context.CancelFunc
+}
+
+func newIdentityProviderLock(oc *CLI, holder string) *identityProviderLock {
+	return &identityProviderLock{oc: oc, holder: holder}
+}
+
+func (l *identityProviderLock) acquire(ctx context.Context) error {
+	leases := l.oc.AdminKubeClient().CoordinationV1().Leases("openshift-config")
+	err := wait.PollImmediateWithContext(ctx, 5*time.Second, 30*time.Minute, func(ctx context.Context) (bool, error) {
+		now := metav1.NewMicroTime(time.Now())
+		lease, err := leases.Get(ctx, identityProviderLockName, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			_, err := leases.Create(ctx, &coordinationv1.Lease{
+				ObjectMeta: metav1.ObjectMeta{Name: identityProviderLockName},
+				Spec: coordinationv1.LeaseSpec{
+					HolderIdentity:       pointer.String(l.holder),
+					LeaseDurationSeconds: pointer.Int32(int32(identityProviderLockDuration.Seconds())),
+					AcquireTime:          &now,
+					RenewTime:            &now,
+				},
+			}, metav1.CreateOptions{})
+			return err == nil, ignoreConflicts(err)
+		}
+		if err != nil {
+			return false, err
+		}
+		if !leaseExpired(lease, now.Time) {
+			framework.Logf("Waiting for %s to release the identity provider lock", pointer.StringDeref(lease.Spec.HolderIdentity, ""))
+			return false, nil
+		}
+		lease.Spec.HolderIdentity = pointer.String(l.holder)
+		lease.Spec.LeaseDurationSeconds = pointer.Int32(int32(identityProviderLockDuration.Seconds()))
+		lease.Spec.AcquireTime = &now
+		lease.Spec.RenewTime = &now
+		_, err = leases.Update(ctx, lease, metav1.UpdateOptions{})
+		return err == nil, ignoreConflicts(err)
+	})
+	if err != nil {
+		return err
+	}
+
+	renewCtx, cancel := context.WithCancel(context.Background())
+	l.cancel = cancel
+	go wait.UntilWithContext(renewCtx, func(ctx context.Context) {
+		err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+			lease, err := leases.Get(ctx, identityProviderLockName, metav1.GetOptions{})
+			if err != nil {
+				return err
+			}
+			if pointer.StringDeref(lease.Spec.HolderIdentity, "") != l.holder {
+				return fmt.Errorf("the lock is held by %s", pointer.StringDeref(lease.Spec.HolderIdentity, ""))
+			}
+			now := metav1.NewMicroTime(time.Now())
+			lease.Spec.RenewTime = &now
+			_, err = leases.Update(ctx, lease, metav1.UpdateOptions{})
+			return err
+		})
+		if err != nil && ctx.Err() == nil {
+			framework.Logf("Unable to renew the identity provider lock: %v", err)
+		}
+	}, identityProviderLockDuration/5)
+	return nil
+}
+
+func (l *identityProviderLock) release(ctx context.Context) error {
+	if l.cancel == nil {
+		return nil
+	}
+	l.cancel()
+	leases := l.oc.AdminKubeClient().CoordinationV1().Leases("openshift-config")
+	lease, err := leases.Get(ctx, identityProviderLockName, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if pointer.StringDeref(lease.Spec.HolderIdentity, "") != l.holder {
+		return nil
+	}
+	err = leases.Delete(ctx, identityProviderLockName, metav1.DeleteOptions{
+		Preconditions: &metav1.Preconditions{ResourceVersion: &lease.ResourceVersion},
+	})
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+// leaseExpired returns true if the lease has no holder, or its holder did not renew it in time.
+func leaseExpired(lease *coordinationv1.Lease, now time.Time) bool {
+	if len(pointer.StringDeref(lease.Spec.HolderIdentity, "")) == 0 || lease.Spec.RenewTime == nil || lease.Spec.LeaseDurationSeconds == nil {
+		return true
+	}
+	return lease.Spec.RenewTime.Add(time.Duration(*lease.Spec.LeaseDurationSeconds) * time.Second).Before(now)
+}
+
+// ignoreConflicts treats losing a race for the lock as a reason to try again.
+func ignoreConflicts(err error) error {
+	if apierrors.IsConflict(err) || apierrors.IsAlreadyExists(err) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,100 @@
+package util
+
+import (
+	"testing"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+)
+
+func TestLeaseExpired(t *testing.T) {
+	now := time.Date(2023, 5, 1, 10, 0, 0, 0, time.UTC)
+	renewed := func(ago time.Duration) *metav1.MicroTime {
+		t := metav1.NewMicroTime(now.Add(-ago))
+		return &t
+	}
+	tests := []struct {
+		name string
+		spec coordinationv1.LeaseSpec
+		want bool
+	}{
+		{
+			name: "no holder",
+			spec: coordinationv1.LeaseSpec{RenewTime: renewed(time.Minute), LeaseDurationSeconds: pointer.Int32(300)},
+			want: true,
+		},
+		{
+			name: "renewed recently",
+			spec: coordinationv1.LeaseSpec{HolderIdentity: pointer.String("e2e-htpasswd-abcde"), RenewTime: renewed(time.Minute), LeaseDurationSeconds: pointer.Int32(300)},
+		},
+		{
+			name: "not renewed in time",
+			spec: coordinationv1.LeaseSpec{HolderIdentity: pointer.String("e2e-htpasswd-abcde"), RenewTime: renewed(6 * time.Minute), LeaseDurationSeconds: pointer.Int32(300)},
+			want: true,
+		},
+		{
+			name: "never renewed",
+			spec: coordinationv1.LeaseSpec{HolderIdentity: pointer.String("e2e-htpasswd-abcde"), LeaseDurationSeconds: pointer.Int32(300)},
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := leaseExpired(&coordinationv1.Lease{Spec: tt.spec}, now); got != tt.want {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestDeploymentRolledOutSince(t *testing.T) {
+	deployment := func(generation, observed int64, replicas, updated, available, current int32) *appsv1.Deployment {
+		return &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Generation: generation},
+			Spec:       appsv1.DeploymentSpec{Replicas: pointer.Int32(replicas)},
+			Status: appsv1.DeploymentStatus{
+				ObservedGeneration: observed,
+				Replicas:           current,
+				UpdatedReplicas:    updated,
+				AvailableReplicas:  available,
+			},
+		}
+	}
+	tests := []struct {
+		name       string
+		deployment *appsv1.Deployment
+		want       bool
+	}{
+		{
+			name:       "not updated by the operator yet",
+			deployment: deployment(4, 4, 3, 3, 3, 3),
+		},
+		{
+			name:       "not observed by the controller yet",
+			deployment: deployment(5, 4, 3, 3, 3, 3),
+		},
+		{
+			name:       "old replicas still running",
+			deployment: deployment(5, 5, 3, 3, 3, 4),
+		},
+		{
+			name:       "new replicas not available",
+			deployment: deployment(5, 5, 3, 3, 2, 3),
+		},
+		{
+			name:       "rolled out",
+			deployment: deployment(5, 5, 3, 3, 3, 3),
+			want:       true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := deploymentRolledOutSince(tt.deployment, 4); got != tt.want {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,330 @@
+package util
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	g "github.com/onsi/ginkgo/v2"
+	o "github.com/onsi/gomega"
+
+	projectv1 "github.com/openshift/api/project/v1"
+	projectv1client "github.com/openshift/client-go/project/clientset/versioned"
+	kubeauthorizationv1 "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/apiserver/pkg/storage/names"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/kubernetes/test/e2e/framework"
+)
+
+const (
+	// NamespacePoolLabel marks the namespaces of the namespace pool. Its value is
+	// NamespacePoolAvailable or NamespacePoolClaimed.
+	NamespacePoolLabel     = "e2e.openshift.io/namespace-pool"
+	NamespacePoolAvailable = "available"
+	NamespacePoolClaimed   = "claimed"
+
+	// namespacePoolReadyAnnotation records when a pooled namespace finished provisioning.
+	// Objects created after it belong to a spec and are removed when the namespace is recycled.
+	namespacePoolReadyAnnotation = "e2e.openshift.io/namespace-pool-ready"
+)
+
+// FillNamespacePool creates size fully provisioned namespaces, projects when the project API
+// is present, and marks them available to CLIs created by NewCLIWithNamespacePool. It is
+// called by the test runner before the suite starts, because every spec runs in its own
+// process and a pool created in BeforeSuite would only serve a single spec.
+func FillNamespacePool(ctx context.Context, config *rest.Config, size int) error {
+	kubeClient, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return err
+	}
+	projectsExist, err := DoesApiResourceExist(config, "projects", "project.openshift.io")
+	if err != nil {
+		return err
+	}
+	var projectClient projectv1client.Interface
+	if projectsExist {
+		if projectClient, err = projectv1client.NewForConfig(config); err != nil {
+			return err
+		}
+	}
+
+	var lock sync.Mutex
+	var errs []error
+	workqueue.ParallelizeUntil(ctx, 10, size, func(int) {
+		if err := createPooledNamespace(ctx, kubeClient, projectClient); err != nil {
+			lock.Lock()
+			defer lock.Unlock()
+			errs = append(errs, err)
+		}
+	})
+	return utilerrors.NewAggregate(errs)
+}
+
+// DrainNamespacePool deletes every namespace of the pool, whether it is claimed or not.
+func DrainNamespacePool(ctx context.Context, config *rest.Config) error {
+	kubeClient, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return err
+	}
+	list, err := kubeClient.CoreV1().Namespaces().List(ctx, metav1.ListOptions{LabelSelector: NamespacePoolLabel})
+	if err != nil {
+		return err
+	}
+	var errs []error
+	for _, ns := range list.Items {
+		if err := kubeClient.CoreV1().Namespaces().Delete(ctx, ns.Name, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			errs = append(errs, err)
+		}
+	}
+	return utilerrors.NewAggregate(errs)
+}
+
+func createPooledNamespace(ctx context.Context, kubeClient kubernetes.Interface, projectClient projectv1client.Interface) error {
+	name := names.SimpleNameGenerator.GenerateName("e2e-test-pool-")
+	if projectClient != nil {
+		if _, err := projectClient.ProjectV1().ProjectRequests().Create(ctx, &projectv1.ProjectRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+		}, metav1.CreateOptions{}); err != nil {
+			return fmt.Errorf("unable to create project %s: %v", name, err)
+		}
+	} else {
+		if _, err := kubeClient.CoreV1().Namespaces().Create(ctx, &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+		}, metav1.CreateOptions{}); err != nil {
+			return fmt.Errorf("unable to create namespace %s: %v", name, err)
+		}
+	}
+
+	serviceAccounts := []string{"default"}
+	if projectClient != nil {
+		serviceAccounts = append(serviceAccounts, "deployer", "builder")
+	}
+	for _, sa := range serviceAccounts {
+		if err := WaitForServiceAccountWithSecret(kubeClient.CoreV1().ServiceAccounts(name), sa); err != nil {
+			return fmt.Errorf("namespace %s: %v", name, err)
+		}
+	}
+	if projectClient != nil {
+		for _, roleBinding := range []string{"system:image-pullers", "system:image-builders", "system:deployers"} {
+			err := wait.PollImmediate(time.Second, 3*time.Minute, func() (bool, error) {
+				_, err := kubeClient.RbacV1().RoleBindings(name).Get(ctx, roleBinding, metav1.GetOptions{})
+				if apierrors.IsNotFound(err) {
+					return false, nil
+				}
+				return err == nil, err
+			})
+			if err != nil {
+				return fmt.Errorf("namespace %s: role binding %s was not provisioned: %v", name, roleBinding, err)
+			}
+		}
+	}
+	if err := WaitForNamespaceSCCAnnotations(kubeClient.CoreV1(), name); err != nil {
+		return fmt.Errorf("namespace %s: %v", name, err)
+	}
+
+	ns, err := kubeClient.CoreV1().Namespaces().Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	if ns.Labels == nil {
+		ns.Labels = map[string]string{}
+	}
+	if ns.Annotations == nil {
+		ns.Annotations = map[string]string{}
+	}
+	ns.Labels[NamespacePoolLabel] = NamespacePoolAvailable
+	ns.Annotations[namespacePoolReadyAnnotation] = time.Now().UTC().Format(time.RFC3339)
+	_, err = kubeClient.CoreV1().Namespaces().Update(ctx, ns, metav1.UpdateOptions{})
+	return err
+}
+
+// claimPooledNamespace marks an available namespace of the pool as claimed and returns its
+// name, or an empty string when the pool is empty. Concurrent claims of the same namespace
+// are resolved by the resource version of the update.
+func claimPooledNamespace(ctx context.Context, kubeClient kubernetes.Interface) (string, error) {
+	list, err := kubeClient.CoreV1().Namespaces().List(ctx, metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("%s=%s", NamespacePoolLabel, NamespacePoolAvailable),
+	})
+	if err != nil {
+		return "", err
+	}
+	for i := range list.Items {
+		ns := &list.Items[i]
+		if ns.DeletionTimestamp != nil {
+			continue
+		}
+		ns.Labels[NamespacePoolLabel] = NamespacePoolClaimed
+		_, err := kubeClient.CoreV1().Namespaces().Update(ctx, ns, metav1.UpdateOptions{})
+		switch {
+		case apierrors.IsConflict(err), apierrors.IsNotFound(err):
+			continue
+		case err != nil:
+			return "", err
+		}
+		return ns.Name, nil
+	}
+	return "", nil
+}
+
+// releasePooledNamespace deletes the objects created in the namespace since it joined the
+// pool, waits for its pods to be gone, and marks it available again.
+func releasePooledNamespace(ctx context.Context, kubeClient kubernetes.Interface, discoveryClient discovery.DiscoveryInterface, dynamicClient dynamic.Interface, namespace string) error {
+	ns, err := kubeClient.CoreV1().Namespaces().Get(ctx, namespace, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	ready, err := time.Parse(time.RFC3339, ns.Annotations[namespacePoolReadyAnnotation])
+	if err != nil {
+		return fmt.Errorf("namespace %s has no valid %s annotation: %v", namespace, namespacePoolReadyAnnotation, err)
+	}
+
+	resources, err := discoveryClient.ServerPreferredNamespacedResources()
+	if err != nil && !discovery.IsGroupDiscoveryFailedError(err) {
+		return err
+	}
+	resources = discovery.FilteredBy(discovery.SupportsAllVerbs{Verbs: []string{"list", "delete"}}, resources)
+	gvrs, err := discovery.GroupVersionResources(resources)
+	if err != nil {
+		return err
+	}
+	var errs []error
+	for gvr := range gvrs {
+		list, err := dynamicClient.Resource(gvr).Namespace(namespace).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			if !apierrors.IsNotFound(err) && !apierrors.IsMethodNotSupported(err) && !apierrors.IsForbidden(err) {
+				errs = append(errs, fmt.Errorf("unable to list %s: %v", gvr.String(), err))
+			}
+			continue
+		}
+		for _, item := range list.Items {
+			if !createdAfter(item.GetCreationTimestamp(), ready) {
+				continue
+			}
+			err := dynamicClient.Resource(gvr).Namespace(namespace).Delete(ctx, item.GetName(), metav1.DeleteOptions{})
+			if err != nil && !apierrors.IsNotFound(err) {
+				errs = append(errs, fmt.Errorf("unable to delete %s %s: %v", gvr.String(), item.GetName(), err))
+			}
+		}
+	}
+	if len(errs) > 0 {
+		return utilerrors.NewAggregate(errs)
+	}
+
+	err = wait.PollImmediate(2*time.Second, 2*time.Minute, func() (bool, error) {
+		pods, err := kubeClient.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return false, err
+		}
+		return len(pods.Items) == 0, nil
+	})
+	if err != nil {
+		return fmt.Errorf("pods of namespace %s were not deleted: %v", namespace, err)
+	}
+
+	ns, err = kubeClient.CoreV1().Namespaces().Get(ctx, namespace, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	ns.Labels[NamespacePoolLabel] = NamespacePoolAvailable
+	_, err = kubeClient.CoreV1().Namespaces().Update(ctx, ns, metav1.UpdateOptions{})
+	return err
+}
+
+// createdAfter returns true if an object created at created postdates ready. Creation
+// timestamps have a resolution of one second, and the objects provisioned with the
+// namespace are never newer than ready.
+func createdAfter(created metav1.Time, ready time.Time) bool {
+	return created.Time.After(ready)
+}
+
+// NewCLIWithNamespacePool initializes the CLI like NewCLI, except that every spec is handed a
+// namespace from the pool filled by the test runner (see openshift-tests run
+// --namespace-pool-size) instead of waiting for a new project to be provisioned. The namespace
+// is recycled after a passing spec and deleted after a failing one. A new project is created
+// as usual when the pool is empty. Only use it for specs that do not depend on the name of
+// their namespace or on changes to the namespace object itself.
+func NewCLIWithNamespacePool(project string) *CLI {
+	cli := NewCLIWithoutNamespace(project)
+	cli.withoutNamespace = false
+	g.BeforeEach(func() {
+		if !cli.setupPooledNamespace() {
+			cli.SetupProject()
+		}
+	})
+	g.AfterEach(cli.teardownPooledNamespace)
+	return cli
+}
+
+// setupPooledNamespace claims a namespace from the pool and prepares it for the current spec
+// the way setupProject prepares a new project. It returns false when the pool is empty.
+func (c *CLI) setupPooledNamespace() bool {
+	requiresTestStart()
+	namespace, err := claimPooledNamespace(context.Background(), c.AdminKubeClient())
+	o.Expect(err).NotTo(o.HaveOccurred())
+	if len(namespace) == 0 {
+		framework.Logf("The namespace pool is empty, creating a new namespace")
+		return false
+	}
+	framework.Logf("Claimed namespace %q from the namespace pool", namespace)
+	c.pooledNamespace = namespace
+	recordNamespace(namespace)
+
+	username := fmt.Sprintf("%s-user", namespace)
+	c.SetNamespace(namespace).ChangeUser(username)
+	framework.Logf("The user is now %q", c.Username())
+	err = c.setupRoleInNamespace(username)
+	o.Expect(err).NotTo(o.HaveOccurred())
+
+	framework.Logf("Waiting on permissions in namespace %q ...", namespace)
+	err = WaitForSelfSAR(1*time.Second, 60*time.Second, c.KubeClient(), kubeauthorizationv1.SelfSubjectAccessReviewSpec{
+		ResourceAttributes: &kubeauthorizationv1.ResourceAttributes{
+			Namespace: namespace,
+			Verb:      "create",
+			Group:     "",
+			Resource:  "pods",
+		},
+	})
+	o.Expect(err).NotTo(o.HaveOccurred())
+
+	err = c.setupNamespacePodSecurity(namespace)
+	o.Expect(err).NotTo(o.HaveOccurred())
+
+	err = c.setupNamespaceManagedAnnotation(namespace)
+	o.Expect(err).NotTo(o.HaveOccurred())
+	return true
+}
+
+// teardownPooledNamespace returns the namespace claimed by the current spec to the pool, or
+// deletes it when the spec failed or the namespace could not be cleaned.
+func (c *CLI) teardownPooledNamespace() {
+	namespace := c.pooledNamespace
+	if len(namespace) == 0 {
+		return
+	}
+	c.pooledNamespace = ""
+	ctx := context.Background()
+	if !g.CurrentSpecReport().Failed() {
+		discoveryClient, err := discovery.NewDiscoveryClientForConfig(c.AdminConfig())
+		if err == nil {
+			err = releasePooledNamespace(ctx, c.AdminKubeClient(), discoveryClient, c.AdminDynamicClient(), namespace)
+		}
+		if err == nil {
+			framework.Logf("Returned namespace %q to the namespace pool", namespace)
+			return
+		}
+		framework.Logf("Unable to recycle namespace %q, deleting it: %v", namespace, err)
+	}
+	if err := c.AdminKubeClient().CoreV1().Namespaces().Delete(ctx, namespace, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+		framework.Logf("Unable to delete pooled namespace %q: %v", namespace, err)
+	}
+}
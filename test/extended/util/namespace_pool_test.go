@@ -0,0 +1,67 @@
+package util
+
+import (
+	"context"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func pooledNamespace(name, state string) *corev1.Namespace {
+	return &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{NamespacePoolLabel: state}}}
+}
+
+func TestClaimPooledNamespace(t *testing.T) {
+	tests := []struct {
+		name       string
+		namespaces []*corev1.Namespace
+		want       string
+	}{
+		{name: "empty pool"},
+		{
+			name:       "all claimed",
+			namespaces: []*corev1.Namespace{pooledNamespace("e2e-test-pool-a", NamespacePoolClaimed)},
+		},
+		{
+			name: "available",
+			namespaces: []*corev1.Namespace{
+				pooledNamespace("e2e-test-pool-a", NamespacePoolClaimed),
+				pooledNamespace("e2e-test-pool-b", NamespacePoolAvailable),
+				{ObjectMeta: metav1.ObjectMeta{Name: "e2e-test-other"}},
+			},
+			want: "e2e-test-pool-b",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client := fake.NewSimpleClientset()
+			for _, ns := range test.namespaces {
+				if _, err := client.CoreV1().Namespaces().Create(context.Background(), ns, metav1.CreateOptions{}); err != nil {
+					t.Fatal(err)
+				}
+			}
+			got, err := claimPooledNamespace(context.Background(), client)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != test.want {
+				t.Fatalf("expected %q, got %q", test.want, got)
+			}
+			if len(got) == 0 {
+				return
+			}
+			ns, err := client.CoreV1().Namespaces().Get(context.Background(), got, metav1.GetOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if ns.Labels[NamespacePoolLabel] != NamespacePoolClaimed {
+				t.Errorf("expected %s to be claimed, got labels %v", got, ns.Labels)
+			}
+			if again, err := claimPooledNamespace(context.Background(), client); err != nil || len(again) > 0 {
+				t.Errorf("expected the pool to be empty, got %q, %v", again, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,134 @@
+package util
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	g "github.com/onsi/ginkgo/v2"
+	"k8s.io/kubernetes/test/e2e/framework"
+
+	"github.com/openshift/origin/pkg/test/ginkgo/runnerapi"
+)
+
+// skipCleanupOfFailedSpec is set when the operator chose to keep the resources of a failed spec, so
+// TeardownProject leaves them in place.
+var skipCleanupOfFailedSpec bool
+
+// pauseOnFailure runs after the spec and its JustAfterEach nodes, before any cleanup.
+func pauseOnFailure() {
+	pauseOnFailureTimeout, err := time.ParseDuration(os.Getenv(runnerapi.PauseOnFailureEnv))
+	if err != nil || pauseOnFailureTimeout <= 0 {
+		return
+	}
+	report := g.CurrentSpecReport()
+	if !report.Failed() {
+		return
+	}
+	var namespaces []string
+	for _, entry := range report.ReportEntries {
+		if entry.Name == runnerapi.NamespaceReportEntry {
+			namespaces = append(namespaces, entry.Value.String())
+		}
+	}
+	choiceFile := filepath.Join(os.TempDir(), fmt.Sprintf("openshift-tests-continue-%d", os.Getpid()))
+	defer os.Remove(choiceFile)
+
+	out := io.Writer(os.Stderr)
+	if fd, err := strconv.Atoi(os.Getenv(runnerapi.PauseOutputFDEnv)); err == nil {
+		out = os.NewFile(uintptr(fd), "pause-output")
+	}
+	in := io.Reader(os.Stdin)
+	if fd, err := strconv.Atoi(os.Getenv(runnerapi.PauseInputFDEnv)); err == nil {
+		in = os.NewFile(uintptr(fd), "pause-input")
+	}
+	fmt.Fprintf(out, "\nTest %q failed, pausing before its cleanup.\n\n", report.FullText())
+	fmt.Fprintf(out, "  failure:    [%s:%d] %s\n", filepath.Base(report.Failure.Location.FileName), report.Failure.Location.LineNumber, report.Failure.Message)
+	if len(namespaces) > 0 {
+		fmt.Fprintf(out, "  namespaces: %s\n", strings.Join(namespaces, ", "))
+	}
+	if dir := TestArtifactDirPath(); len(dir) > 0 {
+		fmt.Fprintf(out, "  artifacts:  %s\n", dir)
+	}
+	if kubeconfig := KubeConfigPath(); len(kubeconfig) > 0 {
+		fmt.Fprintf(out, "  kubeconfig: %s\n", kubeconfig)
+	}
+	fmt.Fprintf(out, "  process:    %d\n\n", os.Getpid())
+	fmt.Fprintf(out, "Enter [c]ontinue, [s]kip cleanup or [a]bort the suite, or write %s, %s or %s to %s. Continuing in %s.\n",
+		runnerapi.PauseContinue, runnerapi.PauseSkipCleanup, runnerapi.PauseAbort, choiceFile, pauseOnFailureTimeout)
+
+	choice, reason := waitForPauseChoice(in, choiceFile, pauseOnFailureTimeout, time.Second)
+	g.AddReportEntry(runnerapi.PauseChoiceReportEntry, choice, g.ReportEntryVisibilityNever)
+	switch choice {
+	case runnerapi.PauseSkipCleanup:
+		skipCleanupOfFailedSpec = true
+		framework.TestContext.DeleteNamespaceOnFailure = false
+		fmt.Fprintf(out, "Continuing after %s, keeping the namespaces and resources of the test.\n", reason)
+	case runnerapi.PauseAbort:
+		fmt.Fprintf(out, "Aborting the suite after %s, running the cleanup of the test.\n", reason)
+	default:
+		fmt.Fprintf(out, "Continuing after %s, running the cleanup of the test.\n", reason)
+	}
+}
+
+// parsePauseChoice returns the choice named by text, which may be abbreviated to its first letter. An
+// empty text continues.
+func parsePauseChoice(text string) (string, bool) {
+	switch strings.ToLower(strings.TrimSpace(text)) {
+	case "", "c", runnerapi.PauseContinue:
+		return runnerapi.PauseContinue, true
+	case "s", runnerapi.PauseSkipCleanup:
+		return runnerapi.PauseSkipCleanup, true
+	case "a", runnerapi.PauseAbort:
+		return runnerapi.PauseAbort, true
+	}
+	return "", false
+}
+
+// waitForPauseChoice returns the choice of the operator and how it was made: "input" when a valid
+// choice is read from in, "file" when path exists, with the choice as its contents or empty to
+// continue, or "timeout", which continues. The end of in is not a choice, so the pause holds when
+// stdin is not a terminal.
+func waitForPauseChoice(in io.Reader, path string, timeout, interval time.Duration) (string, string) {
+	input := make(chan string)
+	go func() {
+		reader := bufio.NewReader(in)
+		for {
+			line, err := reader.ReadString('\n')
+			if err != nil {
+				return
+			}
+			if choice, ok := parsePauseChoice(line); ok {
+				input <- choice
+				return
+			}
+		}
+	}()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	deadline := time.NewTimer(timeout)
+	defer deadline.Stop()
+	for {
+		select {
+		case choice := <-input:
+			return choice, "input"
+		case <-deadline.C:
+			return runnerapi.PauseContinue, "timeout"
+		case <-ticker.C:
+			data, err := os.ReadFile(path)
+			if err != nil {
+				continue
+			}
+			if choice, ok := parsePauseChoice(string(data)); ok {
+				return choice, "file"
+			}
+		}
+	}
+}
+
+var _ = g.JustAfterEach(pauseOnFailure)
@@ -0,0 +1,54 @@
+package util
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/openshift/origin/pkg/test/ginkgo/runnerapi"
+)
+
+func TestWaitForPauseChoice(t *testing.T) {
+	dir := t.TempDir()
+	missing := filepath.Join(dir, "missing")
+
+	tests := []struct {
+		name       string
+		input      string
+		wantChoice string
+	}{
+		{name: "enter continues", input: "\n", wantChoice: runnerapi.PauseContinue},
+		{name: "skip cleanup", input: "s\n", wantChoice: runnerapi.PauseSkipCleanup},
+		{name: "abort after an unknown answer", input: "x\nabort\n", wantChoice: runnerapi.PauseAbort},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			choice, reason := waitForPauseChoice(strings.NewReader(test.input), missing, time.Minute, 10*time.Millisecond)
+			if choice != test.wantChoice || reason != "input" {
+				t.Errorf("expected %s from input, got %s from %s", test.wantChoice, choice, reason)
+			}
+		})
+	}
+
+	// the end of stdin, as under the test runner, does not continue
+	if choice, reason := waitForPauseChoice(strings.NewReader(""), missing, 50*time.Millisecond, 10*time.Millisecond); choice != runnerapi.PauseContinue || reason != "timeout" {
+		t.Errorf("expected to continue on timeout, got %s from %s", choice, reason)
+	}
+
+	for contents, want := range map[string]string{"": runnerapi.PauseContinue, "abort\n": runnerapi.PauseAbort} {
+		reader, writer := io.Pipe()
+		defer writer.Close()
+		path := filepath.Join(dir, "choice")
+		os.Remove(path)
+		go func() {
+			time.Sleep(20 * time.Millisecond)
+			os.WriteFile(path, []byte(contents), 0644)
+		}()
+		if choice, reason := waitForPauseChoice(reader, path, time.Minute, 10*time.Millisecond); choice != want || reason != "file" {
+			t.Errorf("expected %s from a file containing %q, got %s from %s", want, contents, choice, reason)
+		}
+	}
+}
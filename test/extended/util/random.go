@@ -0,0 +1,82 @@
+package util
+
+import (
+	"fmt"
+	"math/rand"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	g "github.com/onsi/ginkgo/v2"
+	e2e "k8s.io/kubernetes/test/e2e/framework"
+)
+
+// RandomSeedReportEntry names the spec report entry that records the seed of the random inputs of a
+// spec, so the seed is printed with the failure of the spec.
+const RandomSeedReportEntry = "random-seed"
+
+var (
+	testRandomSeedOnce sync.Once
+	testRandomSeed     int64
+	testRandomSeedErr  error
+
+	testRandomSeedLock sync.Mutex
+	// testRandomSeedSpec is the spec whose report the seed was last added to
+	testRandomSeedSpec string
+)
+
+// TestRandomSeed returns the seed for the random inputs of the current test. It is read from
+// TEST_RANDOM_SEED when set, otherwise it is generated once per process. The seed is logged and added
+// to the report of the current spec so a failure caused by a particular input can be reproduced by
+// rerunning the test with the same seed. An error is returned if TEST_RANDOM_SEED is not an integer.
+func TestRandomSeed() (int64, error) {
+	testRandomSeedOnce.Do(func() {
+		testRandomSeed, testRandomSeedErr = parseRandomSeed(os.Getenv("TEST_RANDOM_SEED"), time.Now().UnixNano())
+	})
+	if testRandomSeedErr != nil {
+		return 0, testRandomSeedErr
+	}
+	recordRandomSeed(testRandomSeed)
+	return testRandomSeed, nil
+}
+
+// parseRandomSeed returns the seed in value, or generated when value is empty.
+func parseRandomSeed(value string, generated int64) (int64, error) {
+	if len(value) == 0 {
+		return generated, nil
+	}
+	seed, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("TEST_RANDOM_SEED must be an integer: %v", err)
+	}
+	return seed, nil
+}
+
+// recordRandomSeed logs the seed and adds it to the report of the current spec, once per spec. Outside
+// of a spec the seed is only logged.
+func recordRandomSeed(seed int64) {
+	spec := g.CurrentSpecReport().FullText()
+
+	testRandomSeedLock.Lock()
+	defer testRandomSeedLock.Unlock()
+	if len(spec) > 0 && spec == testRandomSeedSpec {
+		return
+	}
+	testRandomSeedSpec = spec
+	e2e.Logf("Using random seed %d, rerun with TEST_RANDOM_SEED=%d to replay the same inputs", seed, seed)
+	if len(spec) > 0 {
+		g.AddReportEntry(RandomSeedReportEntry, seed, g.ReportEntryVisibilityFailureOrVerbose)
+	}
+}
+
+// TestRand returns a source of random inputs for the current test that is replayable through
+// TEST_RANDOM_SEED. Every call returns a new source that produces the same sequence, so a test should
+// create one and draw all of its random values from it.
+func TestRand() (*rand.Rand, error) {
+	seed, err := TestRandomSeed()
+	if err != nil {
+		return nil, err
+	}
+	return rand.New(rand.NewSource(seed)), nil
+}
@@ -0,0 +1,60 @@
+package util
+
+import (
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestParseRandomSeed(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    int64
+		wantErr string
+	}{
+		{name: "generated", want: 42},
+		{name: "from the environment", value: "-7", want: -7},
+		{name: "not an integer", value: "seven", wantErr: "TEST_RANDOM_SEED must be an integer"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			seed, err := parseRandomSeed(test.value, 42)
+			if len(test.wantErr) > 0 {
+				if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", test.wantErr, err)
+				}
+				return
+			}
+			if err != nil || seed != test.want {
+				t.Errorf("expected %d, got %d, %v", test.want, seed, err)
+			}
+		})
+	}
+}
+
+func TestTestRand(t *testing.T) {
+	defer func() { testRandomSeedOnce = sync.Once{} }()
+
+	testRandomSeedOnce = sync.Once{}
+	t.Setenv("TEST_RANDOM_SEED", "1234")
+	first, err := TestRand()
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := TestRand()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 5; i++ {
+		if a, b := first.Int63(), second.Int63(); a != b {
+			t.Fatalf("expected every source to replay the same sequence, got %d and %d", a, b)
+		}
+	}
+
+	testRandomSeedOnce = sync.Once{}
+	t.Setenv("TEST_RANDOM_SEED", "not-a-seed")
+	if _, err := TestRand(); err == nil {
+		t.Errorf("expected an invalid seed to be an error")
+	}
+}
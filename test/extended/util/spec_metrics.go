@@ -0,0 +1,207 @@
+package util
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	g "github.com/onsi/ginkgo/v2"
+	routev1client "github.com/openshift/client-go/route/clientset/versioned"
+	"github.com/openshift/library-go/test/library/metrics"
+	prometheusv1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	"github.com/prometheus/common/model"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/kubernetes/test/e2e/framework"
+	"sigs.k8s.io/yaml"
+
+	"github.com/openshift/origin/pkg/test/ginkgo/runnerapi"
+)
+
+// SpecMetricQuery is a PromQL query evaluated at the start and the end of every spec when the test
+// runner is passed --spec-metrics.
+type SpecMetricQuery struct {
+	Name  string `json:"name"`
+	Query string `json:"query"`
+}
+
+// DefaultSpecMetricQueries are the queries evaluated when no queries are configured: apiserver
+// latency, etcd fsync latency, and CPU saturation of the control plane.
+var DefaultSpecMetricQueries = []SpecMetricQuery{
+	{
+		Name:  "apiserver-p99-latency-seconds",
+		Query: `histogram_quantile(0.99, sum by (le) (rate(apiserver_request_duration_seconds_bucket{verb!~"WATCH|CONNECT"}[2m])))`,
+	},
+	{
+		Name:  "etcd-p99-fsync-seconds",
+		Query: `histogram_quantile(0.99, sum by (le) (rate(etcd_disk_wal_fsync_duration_seconds_bucket[2m])))`,
+	},
+	{
+		Name:  "control-plane-cpu-utilization",
+		Query: `max(1 - avg by (instance) (rate(node_cpu_seconds_total{mode="idle"}[2m]) * on (instance) group_left() label_replace(kube_node_role{role="master"}, "instance", "$1", "node", "(.+)")))`,
+	},
+}
+
+// LoadSpecMetricQueries reads a YAML or JSON list of spec metric queries.
+func LoadSpecMetricQueries(path string) ([]SpecMetricQuery, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var queries []SpecMetricQuery
+	if err := yaml.UnmarshalStrict(data, &queries); err != nil {
+		return nil, fmt.Errorf("invalid spec metric queries in %s: %v", path, err)
+	}
+	for i, query := range queries {
+		if len(query.Name) == 0 || len(query.Query) == 0 {
+			return nil, fmt.Errorf("invalid spec metric queries in %s: query %d must have a name and a query", path, i)
+		}
+	}
+	return queries, nil
+}
+
+// specMetricsEnv carries the queries from the test runner to the test process.
+const specMetricsEnv = "TEST_SPEC_METRICS"
+
+// SpecMetricQueriesEnv returns the environment variable that enables the queries in the test process.
+func SpecMetricQueriesEnv(queries []SpecMetricQuery) (string, error) {
+	data, err := json.Marshal(queries)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s=%s", specMetricsEnv, data), nil
+}
+
+// SpecMetricsSnapshot is the value of a spec metrics report entry.
+type SpecMetricsSnapshot struct {
+	// Boundary is start or end.
+	Boundary string            `json:"boundary"`
+	Time     time.Time         `json:"time"`
+	Results  []SpecMetricValue `json:"results"`
+}
+
+// SpecMetricValue is the result of a spec metric query.
+type SpecMetricValue struct {
+	Name  string `json:"name"`
+	Value string `json:"value,omitempty"`
+	Error string `json:"error,omitempty"`
+}
+
+func (s SpecMetricsSnapshot) String() string {
+	var parts []string
+	for _, result := range s.Results {
+		if len(result.Error) > 0 {
+			parts = append(parts, fmt.Sprintf("%s=error(%s)", result.Name, result.Error))
+			continue
+		}
+		parts = append(parts, fmt.Sprintf("%s=%s", result.Name, result.Value))
+	}
+	return fmt.Sprintf("%s %s: %s", s.Boundary, s.Time.UTC().Format(time.RFC3339), strings.Join(parts, " "))
+}
+
+// maxSpecMetricSamples bounds the samples of a query that returns a vector shown in a report entry.
+const maxSpecMetricSamples = 5
+
+// formatSpecMetricValue formats the result of an instant query for a report entry.
+func formatSpecMetricValue(value model.Value) string {
+	switch v := value.(type) {
+	case model.Vector:
+		if len(v) == 0 {
+			return "none"
+		}
+		if len(v) == 1 && len(v[0].Metric) == 0 {
+			return v[0].Value.String()
+		}
+		samples := make([]string, 0, len(v))
+		for _, sample := range v {
+			samples = append(samples, fmt.Sprintf("%s %s", sample.Metric, sample.Value))
+		}
+		sort.Strings(samples)
+		if len(samples) > maxSpecMetricSamples {
+			samples = append(samples[:maxSpecMetricSamples], fmt.Sprintf("and %d more", len(samples)-maxSpecMetricSamples))
+		}
+		return "[" + strings.Join(samples, ", ") + "]"
+	case *model.Scalar:
+		return v.Value.String()
+	default:
+		return value.String()
+	}
+}
+
+var (
+	specMetricsOnce    sync.Once
+	specMetricQueries  []SpecMetricQuery
+	specMetricsClient  prometheusv1.API
+	specMetricsInitErr error
+)
+
+// initSpecMetrics reads the queries passed by the test runner and connects to Prometheus once per
+// test process. It returns no queries if the runner did not enable spec metrics.
+func initSpecMetrics() ([]SpecMetricQuery, prometheusv1.API, error) {
+	specMetricsOnce.Do(func() {
+		value := os.Getenv(specMetricsEnv)
+		if len(value) == 0 {
+			return
+		}
+		if err := json.Unmarshal([]byte(value), &specMetricQueries); err != nil {
+			specMetricsInitErr = fmt.Errorf("invalid %s: %v", specMetricsEnv, err)
+			return
+		}
+		config, err := framework.LoadConfig(true)
+		if err != nil {
+			specMetricsInitErr = err
+			return
+		}
+		kubeClient, err := kubernetes.NewForConfig(config)
+		if err != nil {
+			specMetricsInitErr = err
+			return
+		}
+		routeClient, err := routev1client.NewForConfig(config)
+		if err != nil {
+			specMetricsInitErr = err
+			return
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+		specMetricsClient, specMetricsInitErr = metrics.NewPrometheusClient(ctx, kubeClient, routeClient)
+	})
+	return specMetricQueries, specMetricsClient, specMetricsInitErr
+}
+
+// recordSpecMetrics evaluates the spec metric queries and adds the results to the report of the
+// current spec. Errors are recorded in the report and never fail the spec.
+func recordSpecMetrics(boundary string) {
+	queries, client, err := initSpecMetrics()
+	if len(queries) == 0 && err == nil {
+		return
+	}
+	snapshot := SpecMetricsSnapshot{Boundary: boundary, Time: time.Now()}
+	if len(queries) == 0 {
+		snapshot.Results = []SpecMetricValue{{Name: runnerapi.SpecMetricsReportEntry, Error: err.Error()}}
+	}
+	for _, query := range queries {
+		result := SpecMetricValue{Name: query.Name}
+		if err != nil {
+			result.Error = err.Error()
+		} else {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			value, _, queryErr := client.Query(ctx, query.Query, snapshot.Time)
+			cancel()
+			if queryErr != nil {
+				result.Error = queryErr.Error()
+			} else {
+				result.Value = formatSpecMetricValue(value)
+			}
+		}
+		snapshot.Results = append(snapshot.Results, result)
+	}
+	g.AddReportEntry(runnerapi.SpecMetricsReportEntry, snapshot, g.ReportEntryVisibilityFailureOrVerbose)
+}
+
+var _ = g.BeforeEach(func() { recordSpecMetrics("start") })
+var _ = g.AfterEach(func() { recordSpecMetrics("end") })
@@ -0,0 +1,101 @@
+package util
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/prometheus/common/model"
+)
+
+func TestFormatSpecMetricValue(t *testing.T) {
+	tests := []struct {
+		name  string
+		value model.Value
+		want  string
+	}{
+		{
+			name:  "empty vector",
+			value: model.Vector{},
+			want:  "none",
+		},
+		{
+			name:  "aggregated vector",
+			value: model.Vector{{Metric: model.Metric{}, Value: 0.25}},
+			want:  "0.25",
+		},
+		{
+			name: "vector with labels",
+			value: model.Vector{
+				{Metric: model.Metric{"instance": "b"}, Value: 2},
+				{Metric: model.Metric{"instance": "a"}, Value: 1},
+			},
+			want: `[{instance="a"} 1, {instance="b"} 2]`,
+		},
+		{
+			name: "vector over the sample limit",
+			value: model.Vector{
+				{Metric: model.Metric{"instance": "a"}, Value: 1},
+				{Metric: model.Metric{"instance": "b"}, Value: 1},
+				{Metric: model.Metric{"instance": "c"}, Value: 1},
+				{Metric: model.Metric{"instance": "d"}, Value: 1},
+				{Metric: model.Metric{"instance": "e"}, Value: 1},
+				{Metric: model.Metric{"instance": "f"}, Value: 1},
+				{Metric: model.Metric{"instance": "g"}, Value: 1},
+			},
+			want: `[{instance="a"} 1, {instance="b"} 1, {instance="c"} 1, {instance="d"} 1, {instance="e"} 1, and 2 more]`,
+		},
+		{
+			name:  "scalar",
+			value: &model.Scalar{Value: 3},
+			want:  "3",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := formatSpecMetricValue(tt.value); got != tt.want {
+				t.Errorf("expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestSpecMetricsSnapshotString(t *testing.T) {
+	snapshot := SpecMetricsSnapshot{
+		Boundary: "end",
+		Time:     time.Date(2023, 5, 1, 10, 0, 0, 0, time.UTC),
+		Results: []SpecMetricValue{
+			{Name: "apiserver-p99-latency-seconds", Value: "0.5"},
+			{Name: "etcd-p99-fsync-seconds", Error: "timeout"},
+		},
+	}
+	want := "end 2023-05-01T10:00:00Z: apiserver-p99-latency-seconds=0.5 etcd-p99-fsync-seconds=error(timeout)"
+	if got := snapshot.String(); got != want {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+}
+
+func TestLoadSpecMetricQueries(t *testing.T) {
+	dir := t.TempDir()
+	valid := filepath.Join(dir, "valid.yaml")
+	if err := os.WriteFile(valid, []byte("- name: up\n  query: sum(up)\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	queries, err := LoadSpecMetricQueries(valid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []SpecMetricQuery{{Name: "up", Query: "sum(up)"}}; !reflect.DeepEqual(queries, want) {
+		t.Errorf("expected %v, got %v", want, queries)
+	}
+
+	missingQuery := filepath.Join(dir, "missing.yaml")
+	if err := os.WriteFile(missingQuery, []byte("- name: up\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadSpecMetricQueries(missingQuery); err == nil {
+		t.Errorf("expected an error for a query without a query")
+	}
+}